@@ -324,6 +324,10 @@ export default defineConfig({
               text: 'Sounds',
               link: '/developers/sound',
             },
+            {
+              text: 'Dialogs',
+              link: '/developers/dialog',
+            },
           ],
         },
         {
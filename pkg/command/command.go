@@ -117,6 +117,13 @@ func (m *Manager) Has(command string) bool {
 	return ok
 }
 
+// CanUse indicates whether the specified command/alias is registered
+// and its requirements allow the source to use it.
+func (m *Manager) CanUse(ctx context.Context, src Source, command string) bool {
+	node, ok := m.Dispatcher.Root.Children()[strings.ToLower(command)]
+	return ok && node.CanUse(ContextWithSource(ctx, src))
+}
+
 // CompletionSuggestions returns completion suggestions.
 func (m *Manager) CompletionSuggestions(parse *ParseResults) (*brigodier.Suggestions, error) {
 	return m.Dispatcher.CompletionSuggestions((*brigodier.ParseResults)(parse))
@@ -128,3 +128,18 @@ func (m *mockCommandSource) PermissionValue(perm string) permission.TriState {
 	}
 	return permission.False
 }
+
+func TestCanUse(t *testing.T) {
+	var mgr Manager
+	enabled := false
+	mgr.RegisterWithAliases(brigodier.Literal("ban").Requires(Requires(func(c *RequiresContext) bool {
+		return enabled
+	})))
+	src := &mockCommandSource{hasPermissionFunc: func(string) bool { return true }}
+
+	require.True(t, mgr.Has("ban"))
+	require.False(t, mgr.CanUse(context.TODO(), src, "ban"), "requirements must be checked")
+	enabled = true
+	require.True(t, mgr.CanUse(context.TODO(), src, "BAN"))
+	require.False(t, mgr.CanUse(context.TODO(), src, "unknown"))
+}
@@ -0,0 +1,264 @@
+// Package form sends Bedrock Edition forms to Bedrock players joined through Geyser/Floodgate.
+//
+// Forms are native Bedrock dialogs that are far easier to use on touch and gamepad
+// devices than Java inventories. They are sent over the Floodgate form plugin channel
+// that Geyser translates into Bedrock forms, the same way as Floodgate's Cumulus forms.
+// There are three kinds of forms:
+//   - ModalForm: a message with two buttons
+//   - SimpleForm: a message with a list of buttons
+//   - CustomForm: a list of input elements such as text inputs, toggles and sliders
+//
+// Responses are decoded and fired as BedrockFormResponseEvent, see Forms.
+package form
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Type is the type of form. Its value is used in the Floodgate form channel.
+type Type uint8
+
+// The form types in the order of Cumulus' FormType.
+const (
+	TypeSimple Type = iota
+	TypeModal
+	TypeCustom
+)
+
+// String implements fmt.Stringer.
+func (t Type) String() string {
+	switch t {
+	case TypeSimple:
+		return "form"
+	case TypeModal:
+		return "modal"
+	case TypeCustom:
+		return "custom_form"
+	default:
+		return fmt.Sprintf("Type(%d)", uint8(t))
+	}
+}
+
+// Form is a Bedrock form, one of *ModalForm, *SimpleForm or *CustomForm.
+type Form interface {
+	// Type returns the type of the form.
+	Type() Type
+	// parse decodes the response data of a player that submitted the form.
+	parse(data string) (Response, error)
+}
+
+var (
+	_ Form = (*ModalForm)(nil)
+	_ Form = (*SimpleForm)(nil)
+	_ Form = (*CustomForm)(nil)
+)
+
+// ModalForm is a form with a message and two buttons.
+type ModalForm struct {
+	Title   string
+	Content string
+	Button1 string // The first, upper button.
+	Button2 string // The second, lower button.
+}
+
+func (f *ModalForm) Type() Type { return TypeModal }
+
+// MarshalJSON implements json.Marshaler.
+func (f *ModalForm) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    string `json:"type"`
+		Title   string `json:"title"`
+		Content string `json:"content"`
+		Button1 string `json:"button1"`
+		Button2 string `json:"button2"`
+	}{f.Type().String(), f.Title, f.Content, f.Button1, f.Button2})
+}
+
+// SimpleForm is a form with a message and a list of buttons.
+type SimpleForm struct {
+	Title   string
+	Content string
+	Buttons []Button
+}
+
+func (f *SimpleForm) Type() Type { return TypeSimple }
+
+// MarshalJSON implements json.Marshaler.
+func (f *SimpleForm) MarshalJSON() ([]byte, error) {
+	buttons := f.Buttons
+	if buttons == nil {
+		buttons = []Button{}
+	}
+	return json.Marshal(struct {
+		Type    string   `json:"type"`
+		Title   string   `json:"title"`
+		Content string   `json:"content"`
+		Buttons []Button `json:"buttons"`
+	}{f.Type().String(), f.Title, f.Content, buttons})
+}
+
+// Button is a button of a SimpleForm.
+type Button struct {
+	Text  string `json:"text"`
+	Image *Image `json:"image,omitempty"` // Optional
+}
+
+// Image is the image of a Button or the icon of a CustomForm.
+type Image struct {
+	Type ImageType `json:"type"`
+	Data string    `json:"data"` // The URL or path of the image.
+}
+
+// ImageType is the source of an Image.
+type ImageType string
+
+const (
+	ImageTypePath ImageType = "path" // A path of a texture in the resource packs, e.g. "textures/items/apple".
+	ImageTypeURL  ImageType = "url"  // A URL of an image, e.g. "https://example.com/icon.png".
+)
+
+// CustomForm is a form with a list of input elements.
+type CustomForm struct {
+	Title    string
+	Icon     *Image // Optional, shown in the server settings tab.
+	Elements []Element
+}
+
+func (f *CustomForm) Type() Type { return TypeCustom }
+
+// MarshalJSON implements json.Marshaler.
+func (f *CustomForm) MarshalJSON() ([]byte, error) {
+	content := make([]json.RawMessage, 0, len(f.Elements))
+	for i, e := range f.Elements {
+		b, err := e.marshal()
+		if err != nil {
+			return nil, fmt.Errorf("error encoding element %d: %w", i, err)
+		}
+		content = append(content, b)
+	}
+	return json.Marshal(struct {
+		Type    string            `json:"type"`
+		Title   string            `json:"title"`
+		Icon    *Image            `json:"icon,omitempty"`
+		Content []json.RawMessage `json:"content"`
+	}{f.Type().String(), f.Title, f.Icon, content})
+}
+
+// Element is an element of a CustomForm, one of
+// *Label, *Input, *Toggle, *Dropdown, *Slider or *StepSlider.
+type Element interface {
+	marshal() ([]byte, error)
+	// parse decodes the element's value of a response.
+	parse(value json.RawMessage) (any, error)
+}
+
+var (
+	_ Element = (*Label)(nil)
+	_ Element = (*Input)(nil)
+	_ Element = (*Toggle)(nil)
+	_ Element = (*Dropdown)(nil)
+	_ Element = (*Slider)(nil)
+	_ Element = (*StepSlider)(nil)
+)
+
+// Label is a text in a CustomForm. It has no value.
+type Label struct {
+	Text string
+}
+
+// Input is a text input field. Its value is a string.
+type Input struct {
+	Text        string
+	Placeholder string
+	Default     string
+}
+
+// Toggle is an on/off switch. Its value is a bool.
+type Toggle struct {
+	Text    string
+	Default bool
+}
+
+// Dropdown is a selection of one of its options. Its value is the int index of the option.
+type Dropdown struct {
+	Text    string
+	Options []string
+	Default int // Index of the option selected by default.
+}
+
+// Slider is a slider between Min and Max. Its value is a float64.
+type Slider struct {
+	Text    string
+	Min     float64
+	Max     float64
+	Step    float64
+	Default float64
+}
+
+// StepSlider is a slider over its steps. Its value is the int index of the step.
+type StepSlider struct {
+	Text    string
+	Steps   []string
+	Default int // Index of the step selected by default.
+}
+
+func (e *Label) marshal() ([]byte, error) {
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}{"label", e.Text})
+}
+
+func (e *Input) marshal() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string `json:"type"`
+		Text        string `json:"text"`
+		Placeholder string `json:"placeholder"`
+		Default     string `json:"default"`
+	}{"input", e.Text, e.Placeholder, e.Default})
+}
+
+func (e *Toggle) marshal() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    string `json:"type"`
+		Text    string `json:"text"`
+		Default bool   `json:"default"`
+	}{"toggle", e.Text, e.Default})
+}
+
+func (e *Dropdown) marshal() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    string   `json:"type"`
+		Text    string   `json:"text"`
+		Options []string `json:"options"`
+		Default int      `json:"default"`
+	}{"dropdown", e.Text, nonNil(e.Options), e.Default})
+}
+
+func (e *Slider) marshal() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    string  `json:"type"`
+		Text    string  `json:"text"`
+		Min     float64 `json:"min"`
+		Max     float64 `json:"max"`
+		Step    float64 `json:"step"`
+		Default float64 `json:"default"`
+	}{"slider", e.Text, e.Min, e.Max, e.Step, e.Default})
+}
+
+func (e *StepSlider) marshal() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    string   `json:"type"`
+		Text    string   `json:"text"`
+		Steps   []string `json:"steps"`
+		Default int      `json:"default"`
+	}{"step_slider", e.Text, nonNil(e.Steps), e.Default})
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
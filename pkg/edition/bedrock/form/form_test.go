@@ -0,0 +1,107 @@
+package form
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	data, err := encode(0x8001, &ModalForm{Title: "Confirm", Content: "Sure?", Button1: "Yes", Button2: "No"})
+	require.NoError(t, err)
+	require.Equal(t, []byte{byte(TypeModal), 0x80, 0x01}, data[:3])
+	require.JSONEq(t, `{"type":"modal","title":"Confirm","content":"Sure?","button1":"Yes","button2":"No"}`, string(data[3:]))
+
+	data, err = encode(2, &SimpleForm{Title: "Servers", Buttons: []Button{
+		{Text: "Lobby"},
+		{Text: "Survival", Image: &Image{Type: ImageTypePath, Data: "textures/items/apple"}},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, byte(TypeSimple), data[0])
+	require.JSONEq(t, `{"type":"form","title":"Servers","content":"","buttons":[
+		{"text":"Lobby"},
+		{"text":"Survival","image":{"type":"path","data":"textures/items/apple"}}]}`, string(data[3:]))
+
+	b, err := json.Marshal(&CustomForm{Title: "Settings", Elements: []Element{
+		&Label{Text: "Hello"},
+		&Input{Text: "Name", Placeholder: "Steve"},
+		&Toggle{Text: "PvP", Default: true},
+		&Dropdown{Text: "Mode", Options: []string{"a", "b"}, Default: 1},
+		&Slider{Text: "Volume", Min: 0, Max: 10, Step: 1, Default: 5},
+		&StepSlider{Text: "Difficulty", Steps: []string{"easy", "hard"}},
+	}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"custom_form","title":"Settings","content":[
+		{"type":"label","text":"Hello"},
+		{"type":"input","text":"Name","placeholder":"Steve","default":""},
+		{"type":"toggle","text":"PvP","default":true},
+		{"type":"dropdown","text":"Mode","options":["a","b"],"default":1},
+		{"type":"slider","text":"Volume","min":0,"max":10,"step":1,"default":5},
+		{"type":"step_slider","text":"Difficulty","steps":["easy","hard"],"default":0}]}`, string(b))
+}
+
+func TestDecode(t *testing.T) {
+	id, data, err := decode(append([]byte{0x80, 0x02}, "true\n"...))
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x8002), id)
+	require.Equal(t, "true\n", data)
+
+	_, _, err = decode([]byte{0x80})
+	require.Error(t, err)
+}
+
+func TestParseResponse(t *testing.T) {
+	modal := &ModalForm{}
+	r, err := parseResponse(modal, "true\n")
+	require.NoError(t, err)
+	require.Equal(t, &ModalResponse{ClickedButton1: true}, r)
+	r, err = parseResponse(modal, "false")
+	require.NoError(t, err)
+	require.Equal(t, &ModalResponse{ClickedButton1: false}, r)
+
+	simple := &SimpleForm{Buttons: []Button{{Text: "a"}, {Text: "b"}}}
+	r, err = parseResponse(simple, "1")
+	require.NoError(t, err)
+	require.Equal(t, &SimpleResponse{ClickedButton: 1}, r)
+	_, err = parseResponse(simple, "2")
+	require.ErrorIs(t, err, ErrInvalidResponse)
+
+	// Closed forms
+	for _, data := range []string{"", "null", "null\n"} {
+		r, err = parseResponse(simple, data)
+		require.NoError(t, err)
+		require.Nil(t, r)
+	}
+
+	custom := &CustomForm{Elements: []Element{
+		&Label{Text: "Hello"},
+		&Input{Text: "Name"},
+		&Toggle{Text: "PvP"},
+		&Dropdown{Options: []string{"a", "b"}},
+		&Slider{Min: 0, Max: 10},
+		&StepSlider{Steps: []string{"easy", "hard"}},
+	}}
+	want := &CustomResponse{Values: []any{nil, "Alex", true, 1, 2.5, 0}}
+	r, err = parseResponse(custom, `[null,"Alex",true,1,2.5,0]`)
+	require.NoError(t, err)
+	require.Equal(t, want, r)
+	// Without label values
+	r, err = parseResponse(custom, `["Alex",true,1,2.5,0]`)
+	require.NoError(t, err)
+	require.Equal(t, want, r)
+
+	res := r.(*CustomResponse)
+	require.Equal(t, "Alex", res.Input(1))
+	require.True(t, res.Toggle(2))
+	require.Equal(t, 1, res.Dropdown(3))
+	require.Equal(t, 2.5, res.Slider(4))
+	require.Equal(t, 0, res.StepSlider(5))
+	require.Empty(t, res.Input(2), "not an input")
+	require.Empty(t, res.Input(10), "out of range")
+
+	for _, data := range []string{`[null,"Alex",true,2,2.5,0]`, `[null,"Alex",true,1,11,0]`, `["Alex"]`, `{}`} {
+		_, err = parseResponse(custom, data)
+		require.ErrorIs(t, err, ErrInvalidResponse, data)
+	}
+}
@@ -0,0 +1,194 @@
+package form
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/robinbraemer/event"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/edition/java/proxy/message"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// Channel is the Floodgate plugin channel forms are sent over.
+// Geyser translates the plugin messages into Bedrock forms and sends back the responses.
+var Channel message.ChannelIdentifier = &message.MinecraftChannelIdentifier{Key: key.New("floodgate", "form")}
+
+// proxyFormID is the bit Floodgate sets in the IDs of forms sent by proxies.
+// Responses to forms without it are forwarded to the backend server that sent the form.
+const proxyFormID = 0x8000
+
+// ErrNotBedrock is returned when sending a form to a Java Edition player.
+var ErrNotBedrock = errors.New("player is not a Bedrock player")
+
+// nextID is shared by all Forms so the IDs of pending forms don't collide.
+var nextID atomic.Uint32
+
+// Forms sends forms to Bedrock players and fires a BedrockFormResponseEvent
+// on the proxy's event manager for each response.
+//
+// Responses are only received while the player is connected to a backend server,
+// as Gate relays serverbound plugin messages only then.
+type Forms struct {
+	proxy *proxy.Proxy
+
+	mu      sync.Mutex
+	pending map[uint16]*pendingForm // by form ID
+}
+
+type pendingForm struct {
+	player uuid.UUID
+	form   Form
+}
+
+// New returns Forms for the Bedrock players of the proxy and handles their form responses until ctx is canceled.
+// Bedrock support must be enabled with Floodgate, which is the case for the Geyser integration of Gate.
+func New(ctx context.Context, p *proxy.Proxy) *Forms {
+	f := &Forms{
+		proxy:   p,
+		pending: map[uint16]*pendingForm{},
+	}
+	p.ChannelRegistrar().Register(Channel)
+	unsubs := []func(){
+		event.Subscribe(p.Event(), 0, f.onPluginMessage),
+		event.Subscribe(p.Event(), 0, f.onDisconnect),
+	}
+	go func() {
+		<-ctx.Done()
+		for _, unsub := range unsubs {
+			unsub()
+		}
+	}()
+	return f
+}
+
+// Send sends the form to a Bedrock player.
+// The player's response is fired as BedrockFormResponseEvent.
+func (f *Forms) Send(player proxy.Player, form Form) error {
+	if player.BedrockData() == nil {
+		return ErrNotBedrock
+	}
+	id := uint16(nextID.Add(1)) | proxyFormID
+	data, err := encode(id, form)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.pending[id] = &pendingForm{player: player.ID(), form: form}
+	f.mu.Unlock()
+
+	if err = player.SendPluginMessage(Channel, data); err != nil {
+		f.mu.Lock()
+		delete(f.pending, id)
+		f.mu.Unlock()
+		return fmt.Errorf("error sending form: %w", err)
+	}
+	return nil
+}
+
+// Pending returns the forms sent to the player that were not responded to yet.
+func (f *Forms) Pending(player proxy.Player) []Form {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var forms []Form
+	for _, p := range f.pending {
+		if p.player == player.ID() {
+			forms = append(forms, p.form)
+		}
+	}
+	return forms
+}
+
+func (f *Forms) onPluginMessage(e *proxy.PluginMessageEvent) {
+	if e.Identifier().ID() != Channel.ID() {
+		return
+	}
+	player, ok := e.Source().(proxy.Player)
+	if !ok {
+		return // sent by a backend server
+	}
+	id, data, err := decode(e.Data())
+	if err != nil || id&proxyFormID == 0 {
+		return // response to a form of a backend server
+	}
+
+	f.mu.Lock()
+	p, ok := f.pending[id]
+	ok = ok && p.player == player.ID()
+	if ok {
+		delete(f.pending, id)
+	}
+	f.mu.Unlock()
+	if !ok {
+		return // form sent by other Forms
+	}
+	e.SetForward(false)
+
+	res := &BedrockFormResponseEvent{player: player, form: p.form}
+	res.response, res.err = parseResponse(p.form, data)
+	res.closed = res.response == nil && res.err == nil
+	f.proxy.Event().FireParallel(res)
+}
+
+func (f *Forms) onDisconnect(e *proxy.DisconnectEvent) {
+	id := e.Player().ID()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for formID, p := range f.pending {
+		if p.player == id {
+			delete(f.pending, formID)
+		}
+	}
+}
+
+// encode encodes a form as Floodgate form channel data:
+// the form type, the big-endian form ID and the JSON form.
+func encode(id uint16, form Form) ([]byte, error) {
+	b, err := json.Marshal(form)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding form: %w", err)
+	}
+	return append([]byte{byte(form.Type()), byte(id >> 8), byte(id)}, b...), nil
+}
+
+// decode decodes Floodgate form channel response data:
+// the big-endian form ID and the response data.
+func decode(data []byte) (id uint16, response string, err error) {
+	if len(data) < 2 {
+		return 0, "", errors.New("form response too short")
+	}
+	return uint16(data[0])<<8 | uint16(data[1]), string(data[2:]), nil
+}
+
+// BedrockFormResponseEvent is fired when a Bedrock player responded to a form sent with Forms.
+type BedrockFormResponseEvent struct {
+	player   proxy.Player
+	form     Form
+	response Response
+	closed   bool
+	err      error
+}
+
+// Player returns the player that responded to the form.
+func (e *BedrockFormResponseEvent) Player() proxy.Player { return e.player }
+
+// Form returns the form that was responded to, as passed to Forms.Send.
+func (e *BedrockFormResponseEvent) Form() Form { return e.form }
+
+// Response returns the response of the player that submitted the form,
+// *ModalResponse, *SimpleResponse or *CustomResponse depending on the form type.
+// Returns nil if the player closed the form or the response is invalid.
+func (e *BedrockFormResponseEvent) Response() Response { return e.response }
+
+// Closed returns true if the player closed the form without submitting it.
+func (e *BedrockFormResponseEvent) Closed() bool { return e.closed }
+
+// Err returns a non-nil error wrapping ErrInvalidResponse if the response does not match the form.
+func (e *BedrockFormResponseEvent) Err() error { return e.err }
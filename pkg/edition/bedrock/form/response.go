@@ -0,0 +1,182 @@
+package form
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Response is the response of a player that submitted a form,
+// one of *ModalResponse, *SimpleResponse or *CustomResponse.
+type Response interface {
+	isResponse()
+}
+
+// ModalResponse is the response to a ModalForm.
+type ModalResponse struct {
+	ClickedButton1 bool // True if Button1 was clicked, false if Button2 was clicked.
+}
+
+// SimpleResponse is the response to a SimpleForm.
+type SimpleResponse struct {
+	ClickedButton int // Index of the clicked button of SimpleForm.Buttons.
+}
+
+// CustomResponse is the response to a CustomForm.
+type CustomResponse struct {
+	// Values has a value for each of the CustomForm.Elements,
+	// see the Element types for the type of their values.
+	Values []any
+}
+
+func (*ModalResponse) isResponse()  {}
+func (*SimpleResponse) isResponse() {}
+func (*CustomResponse) isResponse() {}
+
+// Input returns the value of the Input element at index i or "" if it isn't an Input.
+func (r *CustomResponse) Input(i int) string { return customValue[string](r, i) }
+
+// Toggle returns the value of the Toggle element at index i or false if it isn't a Toggle.
+func (r *CustomResponse) Toggle(i int) bool { return customValue[bool](r, i) }
+
+// Dropdown returns the option index of the Dropdown element at index i or 0 if it isn't a Dropdown.
+func (r *CustomResponse) Dropdown(i int) int { return customValue[int](r, i) }
+
+// Slider returns the value of the Slider element at index i or 0 if it isn't a Slider.
+func (r *CustomResponse) Slider(i int) float64 { return customValue[float64](r, i) }
+
+// StepSlider returns the step index of the StepSlider element at index i or 0 if it isn't a StepSlider.
+func (r *CustomResponse) StepSlider(i int) int { return customValue[int](r, i) }
+
+func customValue[T any](r *CustomResponse, i int) (v T) {
+	if i < 0 || i >= len(r.Values) {
+		return v
+	}
+	v, _ = r.Values[i].(T)
+	return v
+}
+
+// ErrInvalidResponse is returned for responses that don't match the form, e.g. of a modified client.
+var ErrInvalidResponse = errors.New("invalid form response")
+
+// closed returns true if the response data is of a player that closed the form without submitting it.
+func closed(data string) bool {
+	return data == "" || data == "null"
+}
+
+func invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidResponse, fmt.Sprintf(format, args...))
+}
+
+func (f *ModalForm) parse(data string) (Response, error) {
+	switch data {
+	case "true":
+		return &ModalResponse{ClickedButton1: true}, nil
+	case "false":
+		return &ModalResponse{ClickedButton1: false}, nil
+	default:
+		return nil, invalid("modal form response %q must be true or false", data)
+	}
+}
+
+func (f *SimpleForm) parse(data string) (Response, error) {
+	i, err := strconv.Atoi(data)
+	if err != nil || i < 0 || i >= len(f.Buttons) {
+		return nil, invalid("simple form response %q must be a button index below %d", data, len(f.Buttons))
+	}
+	return &SimpleResponse{ClickedButton: i}, nil
+}
+
+func (f *CustomForm) parse(data string) (Response, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		return nil, invalid("custom form response must be a JSON array: %v", err)
+	}
+	// Newer Bedrock clients omit the values of labels
+	withLabels := len(values) == len(f.Elements)
+	if !withLabels && len(values) != len(f.Elements)-labels(f.Elements) {
+		return nil, invalid("custom form response has %d values for %d elements", len(values), len(f.Elements))
+	}
+	r := &CustomResponse{Values: make([]any, len(f.Elements))}
+	for i, e := range f.Elements {
+		var value json.RawMessage
+		if _, label := e.(*Label); !label || withLabels {
+			value, values = values[0], values[1:]
+		}
+		v, err := e.parse(value)
+		if err != nil {
+			return nil, invalid("element %d: %v", i, err)
+		}
+		r.Values[i] = v
+	}
+	return r, nil
+}
+
+func labels(elements []Element) (n int) {
+	for _, e := range elements {
+		if _, ok := e.(*Label); ok {
+			n++
+		}
+	}
+	return n
+}
+
+func (e *Label) parse(json.RawMessage) (any, error) { return nil, nil }
+
+func (e *Input) parse(value json.RawMessage) (any, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return nil, fmt.Errorf("input value must be a string: %w", err)
+	}
+	return s, nil
+}
+
+func (e *Toggle) parse(value json.RawMessage) (any, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err != nil {
+		return nil, fmt.Errorf("toggle value must be a bool: %w", err)
+	}
+	return b, nil
+}
+
+func (e *Dropdown) parse(value json.RawMessage) (any, error) {
+	return parseIndex(value, len(e.Options))
+}
+
+func (e *Slider) parse(value json.RawMessage) (any, error) {
+	var f float64
+	if err := json.Unmarshal(value, &f); err != nil {
+		return nil, fmt.Errorf("slider value must be a number: %w", err)
+	}
+	if f < e.Min || f > e.Max {
+		return nil, fmt.Errorf("slider value %v must be between %v and %v", f, e.Min, e.Max)
+	}
+	return f, nil
+}
+
+func (e *StepSlider) parse(value json.RawMessage) (any, error) {
+	return parseIndex(value, len(e.Steps))
+}
+
+func parseIndex(value json.RawMessage, n int) (any, error) {
+	var i int
+	if err := json.Unmarshal(value, &i); err != nil {
+		return nil, fmt.Errorf("value must be an index: %w", err)
+	}
+	if i < 0 || i >= n {
+		return nil, fmt.Errorf("index %d must be below %d", i, n)
+	}
+	return i, nil
+}
+
+// parseResponse decodes the response data of a form.
+// The response is nil if the player closed the form.
+func parseResponse(f Form, data string) (Response, error) {
+	data = strings.TrimSpace(data)
+	if closed(data) {
+		return nil, nil
+	}
+	return f.parse(data)
+}
@@ -0,0 +1,23 @@
+package floodgate
+
+import "context"
+
+type contextKey struct{}
+
+// NewContext returns a new context carrying the Bedrock data of a connection.
+// The data is only known once the login hostname was read, so data
+// is called on every lookup and may return nil until then.
+func NewContext(ctx context.Context, data func() *BedrockData) context.Context {
+	return context.WithValue(ctx, contextKey{}, data)
+}
+
+// FromContext returns the Bedrock data carried by the context,
+// or nil if the context is not of a Bedrock connection.
+// It allows packages that can't import geyser to access the data.
+func FromContext(ctx context.Context) *BedrockData {
+	data, ok := ctx.Value(contextKey{}).(func() *BedrockData)
+	if !ok {
+		return nil
+	}
+	return data()
+}
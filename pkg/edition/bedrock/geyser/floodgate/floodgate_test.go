@@ -2,6 +2,7 @@ package floodgate
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -238,3 +239,18 @@ func TestReadHostnameInvalidFormatErrorOmitsHostname(t *testing.T) {
 		}
 	}
 }
+
+func TestContext(t *testing.T) {
+	if FromContext(context.Background()) != nil {
+		t.Fatal("expected no Bedrock data in background context")
+	}
+	var data *BedrockData
+	ctx := NewContext(context.Background(), func() *BedrockData { return data })
+	if FromContext(ctx) != nil {
+		t.Fatal("expected no Bedrock data before it is known")
+	}
+	data = &BedrockData{Username: "Steve"}
+	if got := FromContext(ctx); got != data {
+		t.Fatalf("FromContext = %v, want %v", got, data)
+	}
+}
@@ -85,6 +85,9 @@ type Integration struct {
 	unsubs         []func()
 	unregisterHook func()
 	manager        managedRunner
+
+	errMu sync.Mutex
+	err   error // set if the listener failed
 }
 
 // GeyserConnection represents a connection from Geyser.
@@ -176,6 +179,9 @@ func (i *Integration) Start() error {
 	go func() {
 		if err := i.serve(ln); err != nil {
 			i.log.Error(err, "geyser listener failed")
+			i.errMu.Lock()
+			i.err = err
+			i.errMu.Unlock()
 		}
 	}()
 
@@ -191,6 +197,14 @@ func (i *Integration) Start() error {
 	return nil
 }
 
+// Err returns the error if the Geyser listener failed and
+// no longer accepts connections from Geyser, otherwise nil.
+func (i *Integration) Err() error {
+	i.errMu.Lock()
+	defer i.errMu.Unlock()
+	return i.err
+}
+
 // Stop stops the Geyser integration listener and unsubscribes events.
 func (i *Integration) Stop() {
 	// Cancel listener context
@@ -262,6 +276,7 @@ type bedrockContext struct{}
 var bedrockContextKey = bedrockContext{}
 
 func withBedrockContext(ctx context.Context, geyserConn *GeyserConnection) context.Context {
+	ctx = floodgate.NewContext(ctx, func() *floodgate.BedrockData { return geyserConn.BedrockData })
 	return context.WithValue(ctx, bedrockContextKey, geyserConn)
 }
 
@@ -2,8 +2,10 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/robinbraemer/event"
@@ -58,12 +60,47 @@ type Proxy struct {
 	event  event.Manager
 	config *config.Config
 
+	mu                sync.Mutex // Protects following fields
 	geyserIntegration *geyser.Integration
-	javaProxy         *jproxy.Proxy // Reference to Java proxy for integration
+	failure           error // last error starting the Geyser integration
+
+	javaProxy *jproxy.Proxy // Reference to Java proxy for integration
 }
 
 func (p *Proxy) Event() event.Manager { return p.event }
 
+// errNotRunning is returned by Proxy.Err if the Geyser integration is not running.
+var errNotRunning = errors.New("geyser integration is not running")
+
+// Err returns a non-nil error if the Geyser integration failed
+// or is not running, meaning Bedrock players cannot join.
+func (p *Proxy) Err() error {
+	p.mu.Lock()
+	integration, failure := p.geyserIntegration, p.failure
+	p.mu.Unlock()
+	if failure != nil {
+		return failure
+	}
+	if integration == nil {
+		return errNotRunning
+	}
+	return integration.Err()
+}
+
+// setIntegration sets the running Geyser integration or the error that prevented it from running.
+func (p *Proxy) setIntegration(integration *geyser.Integration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.geyserIntegration = integration
+	p.failure = err
+}
+
+func (p *Proxy) integration() *geyser.Integration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.geyserIntegration
+}
+
 func (p *Proxy) Start(ctx context.Context) error {
 	p.log = logr.FromContextOrDiscard(ctx)
 
@@ -71,16 +108,17 @@ func (p *Proxy) Start(ctx context.Context) error {
 	integration, err := geyser.NewIntegration(ctx, p.javaProxy, p.config)
 	if err != nil {
 		p.log.Error(err, "failed to initialize geyser integration")
+		p.setIntegration(nil, err)
 		return err
 	}
 
-	p.geyserIntegration = integration
-
 	if err := integration.Start(); err != nil {
 		p.log.Error(err, "failed to start geyser integration")
 		integration.Stop()
+		p.setIntegration(nil, err)
 		return err
 	}
+	p.setIntegration(integration, nil)
 
 	// Listen for config reloads and restart Geyser integration when relevant fields change
 	unsubReload := reload.Subscribe(p.event, func(e *bedrockConfigUpdateEvent) {
@@ -96,8 +134,9 @@ func (p *Proxy) Start(ctx context.Context) error {
 	if unsubReload != nil {
 		unsubReload()
 	}
-	if p.geyserIntegration != nil {
-		p.geyserIntegration.Stop()
+	if integration := p.integration(); integration != nil {
+		integration.Stop()
+		p.setIntegration(nil, nil)
 	}
 
 	p.log.Info("bedrock proxy stopped")
@@ -111,32 +150,33 @@ func (p *Proxy) handleConfigUpdate(ctx context.Context, e *bedrockConfigUpdateEv
 	prev := e.PrevConfig
 	curr := e.Config
 	if curr == nil {
-		if p.geyserIntegration != nil {
-			p.geyserIntegration.Stop()
-			p.geyserIntegration = nil
+		if integration := p.integration(); integration != nil {
+			integration.Stop()
+			p.setIntegration(nil, nil)
 		}
 		return
 	}
 
 	if prev == nil || requiresRestart(prev, curr) {
 		p.log.Info("restarting geyser integration due to bedrock config change")
-		if p.geyserIntegration != nil {
-			p.geyserIntegration.Stop()
-			p.geyserIntegration = nil
+		if integration := p.integration(); integration != nil {
+			integration.Stop()
+			p.setIntegration(nil, nil)
 		}
 		p.config = curr
 		integ, err := geyser.NewIntegration(ctx, p.javaProxy, p.config)
 		if err != nil {
 			p.log.Error(err, "failed to re-initialize geyser integration")
+			p.setIntegration(nil, err)
 			return
 		}
-		p.geyserIntegration = integ
 		if err := integ.Start(); err != nil {
 			p.log.Error(err, "failed to restart geyser integration")
 			integ.Stop()
-			p.geyserIntegration = nil
+			p.setIntegration(nil, err)
 			return
 		}
+		p.setIntegration(integ, nil)
 		p.log.Info("geyser integration reloaded")
 		return
 	}
@@ -2,12 +2,15 @@ package config
 
 import (
 	"fmt"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	bconfig "go.minekube.com/gate/pkg/edition/bedrock/config"
 	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/edition/java/ping"
 	"go.minekube.com/gate/pkg/edition/java/proto/version"
 	"go.minekube.com/gate/pkg/util/componentutil"
 	"go.minekube.com/gate/pkg/util/configutil"
@@ -38,13 +41,70 @@ var DefaultConfig = Config{
 		Port:        25577,
 		ShowPlugins: false,
 	},
+	Permissions: Permissions{
+		Enabled: false,
+		File:    "permissions.yml",
+	},
+	Punishments: Punishments{
+		Enabled: false,
+		File:    "punishments.yml",
+	},
+	ServerLinks: ServerLinks{
+		Policy: MergeBackendPolicy,
+		Links:  []ServerLink{},
+	},
+	ReportDetails: ReportDetails{
+		Policy:  MergeBackendPolicy,
+		Details: map[string]string{},
+	},
+	ChatChannels: ChatChannels{
+		Enabled:  false,
+		Channels: map[string]ChatChannel{},
+	},
+	Whitelist: Whitelist{
+		Enabled:     false,
+		File:        "whitelist.yml",
+		Hosts:       []string{},
+		KickMessage: componentText("§cYou are not whitelisted on this server."),
+	},
+	Maintenance: Maintenance{
+		Enabled:          false,
+		Servers:          []string{},
+		Whitelist:        []string{},
+		BypassPermission: "gate.maintenance.bypass",
+		Motd:             componentText("§cThe network is under maintenance.\n§7Please check back later!"),
+		VersionName:      "§cMaintenance",
+		KickMessage:      componentText("§cThe network is under maintenance.\n\n§7Please check back later!"),
+		ServerMessage:    componentText("§cThis server is under maintenance."),
+	},
+	GlobalTabList: GlobalTabList{
+		Enabled:        false,
+		SortByServer:   true,
+		Format:         "§7[{server}] §f{player}",
+		UpdateInterval: configutil.Duration(time.Second),
+	},
 	AnnounceForge:                        false,
 	Servers:                              map[string]string{},
 	Try:                                  []string{},
 	ForcedHosts:                          map[string][]string{},
+	ServerGroups:                         map[string]ServerGroup{},
 	FailoverOnUnexpectedServerDisconnect: true,
 	ConnectionTimeout:                    configutil.Duration(5000 * time.Millisecond),
 	ReadTimeout:                          configutil.Duration(30000 * time.Millisecond),
+	HealthCheck: HealthCheck{
+		Enabled:          false,
+		Interval:         configutil.Duration(10 * time.Second),
+		Timeout:          configutil.Duration(3 * time.Second),
+		DegradedLatency:  configutil.Duration(time.Second),
+		FailureThreshold: 3,
+	},
+	Queue: Queue{
+		Enabled:    false,
+		Interval:   configutil.Duration(2 * time.Second),
+		Priorities: map[string]int{},
+		ActionBar:  true,
+		BossBar:    true,
+	},
 	Quota: Quota{
 		Connections: QuotaSettings{
 			Enabled:    true,
@@ -140,7 +200,12 @@ type Config struct { // TODO use https://github.com/projectdiscovery/yamldoc-go
 	Servers                              map[string]string `yaml:"servers,omitempty" json:"servers,omitempty"` // name:address
 	Try                                  []string          `yaml:"try,omitempty" json:"try,omitempty"`         // Try server names order
 	ForcedHosts                          ForcedHosts       `yaml:"forcedHosts,omitempty" json:"forcedHosts,omitempty"`
+	BedrockTry                           []string          `yaml:"bedrockTry,omitempty" json:"bedrockTry,omitempty"`                 // Try order of Bedrock players, try if empty
+	BedrockForcedHosts                   ForcedHosts       `yaml:"bedrockForcedHosts,omitempty" json:"bedrockForcedHosts,omitempty"` // Forced hosts of Bedrock players, take precedence
+	ServerGroups                         ServerGroups      `yaml:"serverGroups,omitempty" json:"serverGroups,omitempty"`             // Usable in try and forced hosts
 	FailoverOnUnexpectedServerDisconnect bool              `yaml:"failoverOnUnexpectedServerDisconnect,omitempty" json:"failoverOnUnexpectedServerDisconnect,omitempty"`
+	HealthCheck                          HealthCheck       `yaml:"healthCheck,omitempty" json:"healthCheck,omitempty"` // Backend server health checking
+	Queue                                Queue             `yaml:"queue,omitempty" json:"queue,omitempty"`             // Connection queues for full or offline servers
 
 	ConnectionTimeout configutil.Duration `yaml:"connectionTimeout,omitempty" json:"connectionTimeout,omitempty"` // Write timeout
 	ReadTimeout       configutil.Duration `yaml:"readTimeout,omitempty" json:"readTimeout,omitempty"`             // Read timeout
@@ -168,6 +233,16 @@ type Config struct { // TODO use https://github.com/projectdiscovery/yamldoc-go
 	AnnounceProxyCommands            bool `yaml:"announceProxyCommands,omitempty" json:"announceProxyCommands,omitempty"`                       // Whether to announce proxy commands to players
 	ForceKeyAuthentication           bool `yaml:"forceKeyAuthentication,omitempty" json:"forceKeyAuthentication,omitempty"`                     // Added in 1.19
 
+	Permissions Permissions `yaml:"permissions,omitempty" json:"permissions,omitempty"` // Built-in permission provider settings
+	Punishments Punishments `yaml:"punishments,omitempty" json:"punishments,omitempty"` // Built-in bans and mutes settings
+	Whitelist   Whitelist   `yaml:"whitelist,omitempty" json:"whitelist,omitempty"`     // Proxy-level whitelist settings
+	Maintenance Maintenance `yaml:"maintenance,omitempty" json:"maintenance,omitempty"` // Maintenance mode settings
+
+	ServerLinks   ServerLinks   `yaml:"serverLinks,omitempty" json:"serverLinks,omitempty"`     // Pause menu links of 1.21+ clients
+	ReportDetails ReportDetails `yaml:"reportDetails,omitempty" json:"reportDetails,omitempty"` // Crash and disconnect report details of 1.21+ clients
+	ChatChannels  ChatChannels  `yaml:"chatChannels,omitempty" json:"chatChannels,omitempty"`   // Proxy-level chat channels across servers
+	GlobalTabList GlobalTabList `yaml:"globalTabList,omitempty" json:"globalTabList,omitempty"` // Tab list showing the players of all servers
+
 	Debug          bool                      `yaml:"debug,omitempty" json:"debug,omitempty"` // Enable debug mode
 	ShutdownReason *configutil.TextComponent `yaml:"shutdownReason,omitempty" json:"shutdownReason,omitempty"`
 
@@ -185,12 +260,124 @@ type (
 		Motd            *configutil.Component `yaml:"motd"`
 		Favicon         favicon.Favicon       `yaml:"favicon"`
 		LogPingRequests bool                  `yaml:"logPingRequests"`
+
+		Motds       []*configutil.Component `yaml:"motds,omitempty"`       // Rotated instead of Motd if set.
+		Favicons    []favicon.Favicon       `yaml:"favicons,omitempty"`    // Rotated instead of Favicon if set.
+		Rotation    configutil.Rotation     `yaml:"rotation,omitempty"`    // How Motds and Favicons are rotated.
+		Hosts       map[string]HostStatus   `yaml:"hosts,omitempty"`       // Overrides by virtual host.
+		Unsupported ping.UnsupportedVersion `yaml:"unsupported,omitempty"` // Response for unsupported client versions.
+	}
+	// HostStatus overrides the status response for clients connecting with a virtual host.
+	// Unset fields fall back to the Status ones.
+	HostStatus struct {
+		ShowMaxPlayers int                     `yaml:"showMaxPlayers,omitempty"`
+		Motd           *configutil.Component   `yaml:"motd,omitempty"`
+		Motds          []*configutil.Component `yaml:"motds,omitempty"`
+		Favicon        favicon.Favicon         `yaml:"favicon,omitempty"`
+		Favicons       []favicon.Favicon       `yaml:"favicons,omitempty"`
 	}
 	Query struct {
 		Enabled     bool `yaml:"enabled"`
 		Port        int  `yaml:"port"`
 		ShowPlugins bool `yaml:"showPlugins"`
 	}
+	// Permissions is the config for the built-in file-backed permission provider.
+	Permissions struct {
+		Enabled bool   `yaml:"enabled"` // If false, player permissions are left to plugins.
+		File    string `yaml:"file"`    // Path to the permissions YAML file, watched for changes.
+	}
+	// Punishments is the config for the built-in file-backed bans and mutes.
+	Punishments struct {
+		Enabled bool   `yaml:"enabled"` // If false, bans and mutes are not enforced.
+		File    string `yaml:"file"`    // Path to the punishments YAML file, watched for changes.
+	}
+	// Whitelist is the config for the proxy-level whitelist of players allowed to join.
+	Whitelist struct {
+		Enabled     bool                  `yaml:"enabled"`
+		File        string                `yaml:"file"`                  // Path to the whitelist YAML file, watched for changes.
+		Hosts       []string              `yaml:"hosts,omitempty"`       // Virtual hosts the whitelist applies to, empty for all.
+		KickMessage *configutil.Component `yaml:"kickMessage,omitempty"` // Disconnect reason of players that are not whitelisted.
+	}
+	// Maintenance is the config for maintenance mode, which can also be toggled at runtime.
+	// Players that are neither whitelisted nor have the bypass permission are kicked
+	// during global maintenance and can't connect to servers under maintenance.
+	Maintenance struct {
+		Enabled          bool                  `yaml:"enabled"`                    // Global maintenance of the whole network.
+		Servers          []string              `yaml:"servers,omitempty"`          // Servers under maintenance, route hosts in Lite mode.
+		Whitelist        []string              `yaml:"whitelist,omitempty"`        // Usernames or UUIDs of players allowed to join.
+		BypassPermission string                `yaml:"bypassPermission,omitempty"` // Players with this permission are allowed to join.
+		Motd             *configutil.Component `yaml:"motd,omitempty"`             // Status MOTD during maintenance.
+		VersionName      string                `yaml:"versionName,omitempty"`      // Status version text during maintenance, empty to keep the version.
+		KickMessage      *configutil.Component `yaml:"kickMessage,omitempty"`      // Disconnect reason of players not allowed to join.
+		ServerMessage    *configutil.Component `yaml:"serverMessage,omitempty"`    // Sent to players connecting to a server under maintenance.
+	}
+	// ServerLinks are the links shown in the pause menu of 1.21+ clients.
+	ServerLinks struct {
+		Policy BackendPolicy `yaml:"policy"` // How links sent by backend servers are handled.
+		Links  []ServerLink  `yaml:"links"`
+	}
+	// ServerLink is a link with either a built-in type or a custom label.
+	ServerLink struct {
+		Type  string                `yaml:"type,omitempty"`  // Built-in type with a translated label, see ServerLinkTypes.
+		Label *configutil.Component `yaml:"label,omitempty"` // Custom label, used if type is empty.
+		URL   string                `yaml:"url"`
+	}
+	// ReportDetails are the details added to crash and disconnect reports of 1.21+ clients.
+	ReportDetails struct {
+		Policy  BackendPolicy     `yaml:"policy"` // How details sent by backend servers are handled.
+		Details map[string]string `yaml:"details"`
+	}
+	// ChatChannels are named chat channels delivering messages to their members on all servers.
+	ChatChannels struct {
+		Enabled  bool                   `yaml:"enabled"`
+		Default  string                 `yaml:"default,omitempty"` // Channel players chat in by default, empty for the server chat.
+		Channels map[string]ChatChannel `yaml:"channels"`
+	}
+	// ChatChannel is a chat channel players can join and speak in based on permissions.
+	ChatChannel struct {
+		Prefix          string `yaml:"prefix,omitempty"`          // Chat messages starting with the prefix are sent to the channel, e.g. "@".
+		Command         string `yaml:"command,omitempty"`         // Command to send to the channel or toggle it as default channel, e.g. "sc".
+		Format          string `yaml:"format,omitempty"`          // Message format, see DefaultChatChannelFormat.
+		Permission      string `yaml:"permission,omitempty"`      // Permission to join and read the channel, empty for everyone.
+		SpeakPermission string `yaml:"speakPermission,omitempty"` // Permission to send to the channel, defaults to Permission.
+	}
+	// GlobalTabList is the config for showing the players of all servers in the tab list.
+	// Format, Header and Footer support the placeholders {player}, {server}, {ping},
+	// {online}, {server_online} and the ones registered by plugins.
+	GlobalTabList struct {
+		Enabled        bool                `yaml:"enabled"`
+		SortByServer   bool                `yaml:"sortByServer"`     // Sort players by server on 1.21.2+ clients.
+		Format         string              `yaml:"format,omitempty"` // Display name of players on other servers, empty for the username.
+		Header         string              `yaml:"header,omitempty"` // Replaces the backend's tab list header if not empty.
+		Footer         string              `yaml:"footer,omitempty"` // Replaces the backend's tab list footer if not empty.
+		UpdateInterval configutil.Duration `yaml:"updateInterval"`   // How often entries, latencies and header/footer are updated.
+	}
+	// ServerGroups are named groups of servers usable in place of a server name
+	// in try and forced hosts (name:group).
+	ServerGroups map[string]ServerGroup
+	// ServerGroup is a group of servers that are tried in the order of the strategy.
+	ServerGroup struct {
+		Servers  []string            `yaml:"servers"`            // Server names
+		Strategy liteconfig.Strategy `yaml:"strategy,omitempty"` // Defaults to sequential
+	}
+	// HealthCheck is the config for periodically pinging the registered servers.
+	// Servers that are down are skipped when choosing a server from the try list or forced hosts.
+	HealthCheck struct {
+		Enabled          bool                `yaml:"enabled"`
+		Interval         configutil.Duration `yaml:"interval"`         // How often each server is pinged.
+		Timeout          configutil.Duration `yaml:"timeout"`          // How long a ping may take before it fails.
+		DegradedLatency  configutil.Duration `yaml:"degradedLatency"`  // Servers responding slower are degraded (<=0 disables).
+		FailureThreshold int                 `yaml:"failureThreshold"` // Consecutive failed pings until a server is down.
+	}
+	// Queue is the config for the per-server connection queues of players
+	// waiting for a full or offline server.
+	Queue struct {
+		Enabled    bool                `yaml:"enabled"`
+		Interval   configutil.Duration `yaml:"interval"`   // How often queued players are connected and notified.
+		Priorities map[string]int      `yaml:"priorities"` // permission:priority, higher priorities are served first.
+		ActionBar  bool                `yaml:"actionBar"`  // Whether to show the queue position in the action bar.
+		BossBar    bool                `yaml:"bossBar"`    // Whether to show the queue position in a boss bar.
+	}
 	Forwarding struct {
 		Mode              ForwardingMode `yaml:"mode"`
 		VelocitySecret    string         `yaml:"velocitySecret"`    // Used with "velocity" mode
@@ -251,6 +438,42 @@ const (
 	BungeeGuardForwardingMode ForwardingMode = "bungeeguard"
 )
 
+// BackendPolicy decides how proxy-managed values are combined with the ones sent by backend servers.
+type BackendPolicy string
+
+const (
+	// MergeBackendPolicy keeps the values sent by backend servers in addition to the proxy's.
+	// Proxy values take precedence over backend values of the same key.
+	MergeBackendPolicy BackendPolicy = "merge"
+	// OverrideBackendPolicy drops the values sent by backend servers if the proxy has any.
+	OverrideBackendPolicy BackendPolicy = "override"
+)
+
+// ServerLinkTypes are the built-in server link types ordered by their protocol id.
+var ServerLinkTypes = []string{
+	"bug_report",
+	"community_guidelines",
+	"support",
+	"status",
+	"feedback",
+	"community",
+	"website",
+	"forums",
+	"news",
+	"announcements",
+}
+
+// MaxReportDetails is the maximum number of report details accepted by clients.
+const MaxReportDetails = 32
+
+// DefaultChatChannelFormat is the default format of chat channel messages.
+// The placeholders {channel}, {player}, {server} and {message} are replaced
+// with the channel name, the sender's username and server and the message.
+const DefaultChatChannelFormat = "§7[{channel}] §f{player}§7: §f{message}"
+
+// ServerChatChannel is the reserved channel name for the chat of the player's current server.
+const ServerChatChannel = "server"
+
 // Validate validates Config.
 func (c *Config) Validate() (warns []error, errs []error) {
 	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
@@ -287,6 +510,52 @@ func (c *Config) Validate() (warns []error, errs []error) {
 		w("Packet limiter has a rate set but interval <= 0; the limiter is disabled. Set packetLimiter.interval > 0 to enable it.")
 	}
 
+	if c.Status.Unsupported.Enabled {
+		if _, _, err := c.Status.Unsupported.Range(); err != nil {
+			e("Invalid unsupported version status: %v", err)
+		}
+	}
+
+	if c.Query.Enabled && (c.Query.Port < 1 || c.Query.Port > 65535) {
+		e("Invalid query port %d, must be 1-65535", c.Query.Port)
+	}
+
+	if hc := c.HealthCheck; hc.Enabled {
+		if hc.Interval <= 0 {
+			e("Invalid health check interval %s, must be > 0", time.Duration(hc.Interval))
+		}
+		if hc.Timeout <= 0 {
+			e("Invalid health check timeout %s, must be > 0", time.Duration(hc.Timeout))
+		}
+		if hc.FailureThreshold < 1 {
+			e("Invalid health check failure threshold %d, use a number >= 1", hc.FailureThreshold)
+		}
+	}
+
+	if c.Queue.Enabled && c.Queue.Interval <= 0 {
+		e("Invalid queue interval %s, must be > 0", time.Duration(c.Queue.Interval))
+	}
+	if c.Queue.Enabled && !c.HealthCheck.Enabled {
+		w("Queue is enabled without healthCheck: full and offline servers are only detected when connecting to them fails. Enable healthCheck to queue players for them.")
+	}
+
+	if c.GlobalTabList.Enabled && c.GlobalTabList.UpdateInterval <= 0 {
+		e("Invalid global tab list update interval %s, must be > 0", time.Duration(c.GlobalTabList.UpdateInterval))
+	}
+
+	if c.Permissions.Enabled && strings.TrimSpace(c.Permissions.File) == "" {
+		e("Permissions file must not be empty when permissions are enabled")
+	}
+	if c.Punishments.Enabled && strings.TrimSpace(c.Punishments.File) == "" {
+		e("Punishments file must not be empty when punishments are enabled")
+	}
+	if c.Whitelist.Enabled && strings.TrimSpace(c.Whitelist.File) == "" {
+		e("Whitelist file must not be empty when the whitelist is enabled")
+	}
+
+	validateServerLinks(c, e)
+	validateChatChannels(c, e)
+
 	validateProxyProtocol(c, e, w)
 
 	validateBackendFloodgate(c, e)
@@ -300,6 +569,12 @@ func (c *Config) Validate() (warns []error, errs []error) {
 
 	validateVia(c, e)
 
+	for _, name := range c.Maintenance.Servers {
+		if _, ok := c.Servers[name]; !ok {
+			w("Server %q under maintenance is not configured", name)
+		}
+	}
+
 	if !c.OnlineMode {
 		w("Proxy is running in offline mode!")
 	}
@@ -327,16 +602,57 @@ func (c *Config) Validate() (warns []error, errs []error) {
 		}
 	}
 
+	for name, group := range c.ServerGroups {
+		if !validation.ValidServerName(name) {
+			e("Invalid server group name format %q: %s and length be 1-%d", name,
+				validation.QualifiedNameErrMsg, validation.QualifiedNameMaxLength)
+		}
+		if _, ok := c.Servers[name]; ok {
+			e("Server group %q must not have the same name as a server", name)
+		}
+		if len(group.Servers) == 0 {
+			e("Server group %q has no servers", name)
+		}
+		for _, server := range group.Servers {
+			if _, ok := c.Servers[server]; !ok {
+				e("Server group %q server %q must be registered under servers", name, server)
+			}
+		}
+		if !liteconfig.ValidStrategy(group.Strategy) {
+			e("Server group %q has invalid strategy %q", name, group.Strategy)
+		}
+	}
+
+	registered := func(name string) bool {
+		_, server := c.Servers[name]
+		_, group := c.ServerGroups[name]
+		return server || group
+	}
+
 	for _, name := range c.Try {
-		if _, ok := c.Servers[name]; !ok {
-			e("Fallback/try server %q must be registered under servers", name)
+		if !registered(name) {
+			e("Fallback/try server %q must be registered under servers or serverGroups", name)
 		}
 	}
 
 	for host, servers := range c.ForcedHosts {
 		for _, name := range servers {
-			if _, ok := c.Servers[name]; !ok {
-				e("Forced host %q server %q must be registered under servers", host, name)
+			if !registered(name) {
+				e("Forced host %q server %q must be registered under servers or serverGroups", host, name)
+			}
+		}
+	}
+
+	for _, name := range c.BedrockTry {
+		if !registered(name) {
+			e("Bedrock try server %q must be registered under servers or serverGroups", name)
+		}
+	}
+
+	for host, servers := range c.BedrockForcedHosts {
+		for _, name := range servers {
+			if !registered(name) {
+				e("Bedrock forced host %q server %q must be registered under servers or serverGroups", host, name)
 			}
 		}
 	}
@@ -384,7 +700,8 @@ func warnLiteIgnoredSettings(c *Config, w func(string, ...any)) {
 			"See https://gate.minekube.com/guide/modded-servers", f.Mode)
 	}
 
-	if len(c.Servers) != 0 || len(c.Try) != 0 || len(c.ForcedHosts) != 0 {
+	if len(c.Servers) != 0 || len(c.Try) != 0 || len(c.ForcedHosts) != 0 ||
+		len(c.BedrockTry) != 0 || len(c.BedrockForcedHosts) != 0 {
 		w("Lite mode ignores servers, try and forcedHosts: use lite.routes to route " +
 			"connections. See https://gate.minekube.com/guide/lite")
 	}
@@ -497,6 +814,96 @@ func validateVia(c *Config, e func(string, ...any)) {
 	}
 }
 
+func validateServerLinks(c *Config, e func(string, ...any)) {
+	for _, policy := range []BackendPolicy{c.ServerLinks.Policy, c.ReportDetails.Policy} {
+		switch policy {
+		case "", MergeBackendPolicy, OverrideBackendPolicy:
+		default:
+			e("Unknown backend policy %q, must be one of merge,override", policy)
+		}
+	}
+	for i, link := range c.ServerLinks.Links {
+		if strings.TrimSpace(link.URL) == "" {
+			e("Server link %d has no url", i)
+		}
+		switch {
+		case link.Type != "":
+			if !slices.Contains(ServerLinkTypes, link.Type) {
+				e("Unknown server link type %q, must be one of %s", link.Type, strings.Join(ServerLinkTypes, ","))
+			}
+		case link.Label == nil:
+			e("Server link %d needs either a type or a label", i)
+		}
+	}
+	if len(c.ReportDetails.Details) > MaxReportDetails {
+		e("Too many report details %d, clients accept at most %d", len(c.ReportDetails.Details), MaxReportDetails)
+	}
+}
+
+func validateChatChannels(c *Config, e func(string, ...any)) {
+	if !c.ChatChannels.Enabled {
+		return
+	}
+	if d := c.ChatChannels.Default; d != "" && d != ServerChatChannel {
+		if _, ok := c.ChatChannels.Channels[d]; !ok {
+			e("Default chat channel %q does not exist", d)
+		}
+	}
+	prefixes := map[string]string{}
+	commands := map[string]string{}
+	for _, name := range slices.Sorted(maps.Keys(c.ChatChannels.Channels)) {
+		ch := c.ChatChannels.Channels[name]
+		if name == "" || strings.ContainsAny(name, " /") || name == ServerChatChannel {
+			e("Invalid chat channel name %q, must not be empty, %q or contain spaces or slashes", name, ServerChatChannel)
+		}
+		if ch.Prefix != "" {
+			if strings.HasPrefix(ch.Prefix, "/") || strings.TrimSpace(ch.Prefix) != ch.Prefix {
+				e("Invalid prefix %q of chat channel %q, must not start with a slash or space, use command instead", ch.Prefix, name)
+			}
+			if other, ok := prefixes[ch.Prefix]; ok {
+				e("Chat channels %q and %q use the same prefix %q", other, name, ch.Prefix)
+			}
+			prefixes[ch.Prefix] = name
+		}
+		if ch.Command != "" {
+			if strings.ContainsAny(ch.Command, " /") {
+				e("Invalid command %q of chat channel %q, must not contain spaces or slashes", ch.Command, name)
+			}
+			if other, ok := commands[ch.Command]; ok {
+				e("Chat channels %q and %q use the same command %q", other, name, ch.Command)
+			}
+			commands[ch.Command] = name
+		}
+	}
+}
+
+// Resolve returns the MOTD, favicon and max players of the status response for clients
+// connecting with the virtual hostname at the given time, applying the host overrides
+// and picking from the rotated MOTDs and favicons.
+func (s *Status) Resolve(host string, now time.Time) (motd *configutil.Component, fav favicon.Favicon, maxPlayers int) {
+	motd, motds := s.Motd, s.Motds
+	fav, favs := s.Favicon, s.Favicons
+	maxPlayers = s.ShowMaxPlayers
+	if h, ok := s.Hosts[strings.ToLower(host)]; ok {
+		if h.Motd != nil || len(h.Motds) != 0 {
+			motd, motds = h.Motd, h.Motds
+		}
+		if h.Favicon != "" || len(h.Favicons) != 0 {
+			fav, favs = h.Favicon, h.Favicons
+		}
+		if h.ShowMaxPlayers != 0 {
+			maxPlayers = h.ShowMaxPlayers
+		}
+	}
+	if len(motds) != 0 {
+		motd = configutil.Pick(s.Rotation, motds, now)
+	}
+	if len(favs) != 0 {
+		fav = configutil.Pick(s.Rotation, favs, now)
+	}
+	return motd, fav, maxPlayers
+}
+
 func text(s string) *configutil.TextComponent {
 	return (*configutil.TextComponent)(must(componentutil.ParseTextComponent(
 		version.MinimumVersion.Protocol, s)))
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.minekube.com/common/minecraft/component"
@@ -44,6 +45,143 @@ func TestViaConfigValidate(t *testing.T) {
 	require.Empty(t, errs)
 }
 
+func TestServerGroupsValidate(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Servers = map[string]string{
+		"lobby1": "127.0.0.1:25566",
+		"lobby2": "127.0.0.1:25567",
+	}
+	cfg.ServerGroups = map[string]ServerGroup{
+		"lobbies": {Servers: []string{"lobby1", "lobby2"}, Strategy: liteconfig.StrategyLeastConnections},
+	}
+	cfg.Try = []string{"lobbies"}
+	cfg.ForcedHosts = map[string][]string{"lobby.example.com": {"lobbies", "lobby1"}}
+
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+
+	cfg.ServerGroups = map[string]ServerGroup{
+		"lobbies": {Servers: []string{"lobby1", "missing"}, Strategy: "fastest"},
+		"lobby1":  {Servers: []string{"lobby2"}},
+		"empty":   {},
+	}
+	_, errs = cfg.Validate()
+	requireErrorContains(t, errs, `Server group "lobbies" server "missing" must be registered under servers`)
+	requireErrorContains(t, errs, `Server group "lobbies" has invalid strategy "fastest"`)
+	requireErrorContains(t, errs, `Server group "lobby1" must not have the same name as a server`)
+	requireErrorContains(t, errs, `Server group "empty" has no servers`)
+}
+
+func TestServerLinksValidate(t *testing.T) {
+	var cfg Config
+	require.NoError(t, yaml.Unmarshal([]byte(`
+bind: 0.0.0.0:25565
+serverLinks:
+  policy: override
+  links:
+    - type: website
+      url: https://example.com
+    - label: "&9Discord"
+      url: https://discord.gg/example
+reportDetails:
+  details:
+    network: example
+`), &cfg))
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+	require.Equal(t, OverrideBackendPolicy, cfg.ServerLinks.Policy)
+	require.NotNil(t, cfg.ServerLinks.Links[1].Label)
+
+	cfg.ServerLinks.Policy = "replace"
+	cfg.ServerLinks.Links = append(cfg.ServerLinks.Links,
+		ServerLink{Type: "shop", URL: "https://example.com/shop"},
+		ServerLink{URL: "https://example.com/unlabeled"},
+		ServerLink{Type: "news"},
+	)
+	_, errs = cfg.Validate()
+	requireErrorContains(t, errs, `Unknown backend policy "replace"`)
+	requireErrorContains(t, errs, `Unknown server link type "shop"`)
+	requireErrorContains(t, errs, `Server link 3 needs either a type or a label`)
+	requireErrorContains(t, errs, `Server link 4 has no url`)
+}
+
+func TestQueueValidate(t *testing.T) {
+	cfg := DefaultConfig
+	cfg.Queue.Enabled = true
+	cfg.HealthCheck.Enabled = false
+	warns, _ := cfg.Validate()
+	requireWarnContains(t, warns, "Queue is enabled without healthCheck")
+
+	cfg.HealthCheck.Enabled = true
+	warns, _ = cfg.Validate()
+	for _, warn := range warns {
+		require.NotContains(t, warn.Error(), "healthCheck")
+	}
+}
+
+func TestChatChannelsValidate(t *testing.T) {
+	var cfg Config
+	require.NoError(t, yaml.Unmarshal([]byte(`
+bind: 0.0.0.0:25565
+chatChannels:
+  enabled: true
+  default: global
+  channels:
+    global:
+      prefix: "!"
+    staff:
+      prefix: "@"
+      command: sc
+      permission: gate.chat.staff
+`), &cfg))
+	_, errs := cfg.Validate()
+	require.Empty(t, errs)
+	require.Equal(t, "sc", cfg.ChatChannels.Channels["staff"].Command)
+
+	cfg.ChatChannels.Default = "party"
+	cfg.ChatChannels.Channels[ServerChatChannel] = ChatChannel{}
+	cfg.ChatChannels.Channels["admin"] = ChatChannel{Prefix: "@", Command: "/ac"}
+	_, errs = cfg.Validate()
+	requireErrorContains(t, errs, `Default chat channel "party" does not exist`)
+	requireErrorContains(t, errs, `Invalid chat channel name "server"`)
+	requireErrorContains(t, errs, `Chat channels "admin" and "staff" use the same prefix "@"`)
+	requireErrorContains(t, errs, `Invalid command "/ac" of chat channel "admin"`)
+}
+
+func TestStatusResolve(t *testing.T) {
+	var cfg Config
+	require.NoError(t, yaml.Unmarshal([]byte(`
+bind: 0.0.0.0:25565
+status:
+  showMaxPlayers: 100
+  motd: default
+  motds: [first, second]
+  rotation:
+    interval: 1m
+  hosts:
+    play.example.com:
+      showMaxPlayers: 50
+      motd: play
+  unsupported:
+    enabled: true
+    minVersion: "1.21"
+    maxVersion: "1.20"
+`), &cfg))
+	_, errs := cfg.Validate()
+	requireErrorContains(t, errs, "Invalid unsupported version status")
+
+	now := time.Unix(60, 0)
+	motd, _, maxPlayers := cfg.Status.Resolve("lobby.example.com", now)
+	require.Equal(t, "second", motd.C().(*component.Text).Content)
+	require.Equal(t, 100, maxPlayers)
+	motd, _, _ = cfg.Status.Resolve("", now.Add(time.Minute))
+	require.Equal(t, "first", motd.C().(*component.Text).Content)
+
+	motd, _, maxPlayers = cfg.Status.Resolve("Play.Example.com", now)
+	require.Equal(t, "play", motd.C().(*component.Text).Content)
+	require.Equal(t, 50, maxPlayers)
+}
+
 func TestViaConfigHasNoBackendOverrideSetting(t *testing.T) {
 	typ := reflect.TypeOf(Via{})
 	for i := 0; i < typ.NumField(); i++ {
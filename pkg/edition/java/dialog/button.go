@@ -0,0 +1,108 @@
+package dialog
+
+import (
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+)
+
+// ActionKey is the key of the clicked button's ID in response payloads, see Response.Action.
+// Inputs must not use this key.
+const ActionKey = "action"
+
+// Button is a clickable button of a dialog.
+type Button struct {
+	// ID is sent as action in the response when the button is clicked,
+	// together with the values of the dialog's inputs.
+	// Ignored if the button has a static Action.
+	ID      string
+	Label   component.Component
+	Tooltip component.Component // Optional
+	// Width of the button between 1 and 1024. (default 150)
+	Width int
+	// Action is an optional static action performed by the client instead of sending a response.
+	Action *Action
+}
+
+// ActionType is the type of static button action.
+type ActionType string
+
+const (
+	OpenURLAction         ActionType = "open_url"
+	RunCommandAction      ActionType = "run_command"
+	SuggestCommandAction  ActionType = "suggest_command"
+	CopyToClipboardAction ActionType = "copy_to_clipboard"
+)
+
+// Action is a static button action performed by the client.
+type Action struct {
+	Type ActionType
+	// Value is the url, command or text of the action depending on the type.
+	Value string
+}
+
+// OpenURL returns an action that opens the url.
+func OpenURL(url string) *Action { return &Action{Type: OpenURLAction, Value: url} }
+
+// RunCommand returns an action that runs the command as the player.
+func RunCommand(command string) *Action { return &Action{Type: RunCommandAction, Value: command} }
+
+// SuggestCommand returns an action that puts the command into the player's chat box.
+func SuggestCommand(command string) *Action {
+	return &Action{Type: SuggestCommandAction, Value: command}
+}
+
+// CopyToClipboard returns an action that copies the text to the player's clipboard.
+func CopyToClipboard(text string) *Action { return &Action{Type: CopyToClipboardAction, Value: text} }
+
+func (b *Button) encode(e *encoder, dialogID key.Key) map[string]any {
+	label := b.Label
+	if label == nil {
+		label = &component.Text{Content: b.ID}
+	}
+	m := map[string]any{"label": e.component(label)}
+	if b.Tooltip != nil {
+		m["tooltip"] = e.component(b.Tooltip)
+	}
+	if b.Width > 0 {
+		m["width"] = int32(min(b.Width, 1024))
+	}
+	switch {
+	case b.Action != nil:
+		m["action"] = b.Action.encode()
+	case b.ID != "":
+		// The client sends the input values and additions to the proxy.
+		m["action"] = map[string]any{
+			"type":      "dynamic/custom",
+			"id":        dialogID.String(),
+			"additions": map[string]any{ActionKey: b.ID},
+		}
+	}
+	return m
+}
+
+func (a *Action) encode() map[string]any {
+	m := map[string]any{"type": string(a.Type)}
+	switch a.Type {
+	case OpenURLAction:
+		m["url"] = a.Value
+	case RunCommandAction, SuggestCommandAction:
+		m["command"] = a.Value
+	default:
+		m["value"] = a.Value
+	}
+	return m
+}
+
+// clickEvent returns the chat click event equivalent of the action.
+func (a *Action) clickEvent() component.ClickEvent {
+	switch a.Type {
+	case OpenURLAction:
+		return component.OpenUrl(a.Value)
+	case RunCommandAction:
+		return component.RunCommand(a.Value)
+	case SuggestCommandAction:
+		return component.SuggestCommand(a.Value)
+	default:
+		return component.CopyToClipboard(a.Value)
+	}
+}
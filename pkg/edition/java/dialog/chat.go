@@ -0,0 +1,56 @@
+package dialog
+
+import (
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+)
+
+// chat returns the chat message representation of the dialog for clients without dialog support.
+func (d *Dialog) chat() component.Component {
+	msg := &component.Text{Extra: []component.Component{
+		&component.Text{S: component.Style{Bold: component.True}, Extra: []component.Component{d.Title}},
+	}}
+	for _, body := range d.Body {
+		msg.Extra = append(msg.Extra, &component.Text{Content: "\n"}, body)
+	}
+	if len(d.Inputs) != 0 {
+		msg.Extra = append(msg.Extra, &component.Text{
+			Content: "\nUpdate to Minecraft 1.21.6 or newer to fill out this form.",
+			S:       component.Style{Color: color.Gray, Italic: component.True},
+		})
+	}
+
+	buttons := d.Buttons
+	switch d.Type {
+	case NoticeType:
+		buttons = buttons[:min(len(buttons), 1)]
+	case ConfirmationType:
+		buttons = buttons[:2]
+	}
+	if d.Exit != nil {
+		buttons = append(buttons[:len(buttons):len(buttons)], *d.Exit)
+	}
+	sep := "\n"
+	for _, b := range buttons {
+		// Only buttons with static actions can be clicked in chat.
+		if b.Action == nil {
+			continue
+		}
+		label := b.Label
+		if label == nil {
+			label = &component.Text{Content: b.ID}
+		}
+		style := component.Style{Color: color.Aqua, ClickEvent: b.Action.clickEvent()}
+		if b.Tooltip != nil {
+			style.HoverEvent = component.ShowText(b.Tooltip)
+		}
+		msg.Extra = append(msg.Extra,
+			&component.Text{Content: sep},
+			&component.Text{Content: "[", S: style, Extra: []component.Component{
+				label, &component.Text{Content: "]"},
+			}},
+		)
+		sep = " "
+	}
+	return msg
+}
@@ -0,0 +1,334 @@
+// Package dialog provides functionality for showing Minecraft dialogs to players.
+//
+// Dialogs are screens with a title, body, inputs and buttons that were added in Minecraft 1.21.6.
+// Buttons without a static action send a response back to the proxy that is fired
+// as proxy.PlayerDialogResponseEvent with the ID of the dialog, so that forms can be
+// handled by proxy plugins without a backend server plugin.
+//
+// Older clients are shown a chat message representation of the dialog instead.
+package dialog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/Tnze/go-mc/nbt"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/internal/methods"
+	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+var (
+	// ErrUnsupportedState is returned when showing a dialog to a player
+	// that is neither in the configuration nor in the play state.
+	ErrUnsupportedState = errors.New("player must be in configuration or play state to show dialogs")
+	// ErrInvalidDialog is returned when showing an invalid dialog.
+	ErrInvalidDialog = errors.New("invalid dialog")
+)
+
+// Viewer is the interface for a dialog viewer (e.g. a player).
+type Viewer interface {
+	netmc.PacketWriter
+}
+
+// Type is the type of dialog.
+type Type string
+
+const (
+	// NoticeType is a dialog with a single button.
+	NoticeType Type = "minecraft:notice"
+	// ConfirmationType is a dialog with a yes and a no button.
+	ConfirmationType Type = "minecraft:confirmation"
+	// MultiActionType is a dialog with any number of buttons arranged in columns.
+	MultiActionType Type = "minecraft:multi_action"
+)
+
+// AfterAction is what the client does with the dialog after a button was clicked.
+type AfterAction string
+
+const (
+	// AfterActionClose closes the dialog. (default)
+	AfterActionClose AfterAction = "close"
+	// AfterActionNone keeps the dialog open.
+	AfterActionNone AfterAction = "none"
+	// AfterActionWaitForResponse replaces the dialog with a waiting screen
+	// until a new dialog is shown or the dialog is cleared.
+	AfterActionWaitForResponse AfterAction = "wait_for_response"
+)
+
+// Dialog is a dialog screen shown to players.
+// Use the Notice, Confirmation and MultiAction functions to create dialogs.
+type Dialog struct {
+	// ID identifies the dialog in responses, see Response.
+	ID   key.Key
+	Type Type
+	// Title is shown at the top of the dialog.
+	Title component.Component
+	// ExternalTitle is the label of buttons leading to this dialog, e.g. in the pause menu.
+	// Defaults to Title if nil.
+	ExternalTitle component.Component
+	// Body is the list of messages shown below the title.
+	Body []component.Component
+	// Inputs are the input controls shown below the body.
+	// Their values are sent with the response of buttons without a static action.
+	Inputs []Input
+	// Buttons of the dialog.
+	// Notice dialogs use the first button, confirmation dialogs the first two
+	// as yes and no buttons and multi-action dialogs all of them.
+	Buttons []Button
+	// Exit is the optional button of multi-action dialogs shown at the bottom,
+	// also used when the dialog is closed with escape.
+	Exit *Button
+	// Columns is the number of button columns of multi-action dialogs. (default 2)
+	Columns int
+	// DisableEscape prevents closing the dialog with the escape key.
+	DisableEscape bool
+	// AfterAction is what happens after a button was clicked. (default AfterActionClose)
+	AfterAction AfterAction
+}
+
+// Notice creates a dialog with a single OK button responding with the action "ok".
+func Notice(id key.Key, title component.Component, body ...component.Component) *Dialog {
+	return &Dialog{
+		ID:      id,
+		Type:    NoticeType,
+		Title:   title,
+		Body:    body,
+		Buttons: []Button{{ID: "ok", Label: &component.Translation{Key: "gui.ok"}}},
+	}
+}
+
+// Confirmation creates a dialog with yes and no buttons responding with the actions "yes" and "no".
+func Confirmation(id key.Key, title component.Component, body ...component.Component) *Dialog {
+	return &Dialog{
+		ID:    id,
+		Type:  ConfirmationType,
+		Title: title,
+		Body:  body,
+		Buttons: []Button{
+			{ID: "yes", Label: &component.Translation{Key: "gui.yes"}},
+			{ID: "no", Label: &component.Translation{Key: "gui.no"}},
+		},
+	}
+}
+
+// MultiAction creates a dialog with the buttons arranged in columns.
+func MultiAction(id key.Key, title component.Component, buttons []Button, body ...component.Component) *Dialog {
+	return &Dialog{
+		ID:      id,
+		Type:    MultiActionType,
+		Title:   title,
+		Body:    body,
+		Buttons: buttons,
+	}
+}
+
+// WithInputs returns a copy of the dialog with the input controls added, turning it into a form.
+func (d Dialog) WithInputs(inputs ...Input) *Dialog {
+	d.Inputs = append(d.Inputs[:len(d.Inputs):len(d.Inputs)], inputs...)
+	return &d
+}
+
+// WithButtons returns a copy of the dialog with the buttons replaced.
+func (d Dialog) WithButtons(buttons ...Button) *Dialog {
+	d.Buttons = buttons
+	return &d
+}
+
+// Validate returns an error if the dialog can not be shown.
+func (d *Dialog) Validate() error {
+	if d == nil {
+		return fmt.Errorf("%w: nil dialog", ErrInvalidDialog)
+	}
+	if d.ID == nil {
+		return fmt.Errorf("%w: missing id", ErrInvalidDialog)
+	}
+	if d.Title == nil {
+		return fmt.Errorf("%w: missing title", ErrInvalidDialog)
+	}
+	switch d.Type {
+	case NoticeType:
+	case ConfirmationType:
+		if len(d.Buttons) < 2 {
+			return fmt.Errorf("%w: confirmation dialog needs a yes and a no button", ErrInvalidDialog)
+		}
+	case MultiActionType:
+		if len(d.Buttons) == 0 {
+			return fmt.Errorf("%w: multi-action dialog needs at least one button", ErrInvalidDialog)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidDialog, d.Type)
+	}
+	seen := make(map[string]struct{}, len(d.Inputs))
+	for _, in := range d.Inputs {
+		if in.Key == "" || in.Key == ActionKey {
+			return fmt.Errorf("%w: input key must not be empty or %q", ErrInvalidDialog, ActionKey)
+		}
+		if _, ok := seen[in.Key]; ok {
+			return fmt.Errorf("%w: duplicate input key %q", ErrInvalidDialog, in.Key)
+		}
+		seen[in.Key] = struct{}{}
+	}
+	return nil
+}
+
+// Show shows the dialog to the viewer, replacing any dialog currently shown.
+//
+// Clients older than 1.21.6 are sent the dialog as chat message instead,
+// where only buttons with a static action can be clicked.
+func Show(viewer Viewer, d *Dialog) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	protocol, _ := methods.Protocol(viewer)
+	if !isProtocolSupported(protocol) {
+		if s, ok := methods.State(viewer); ok && s != states.PlayState {
+			return nil // chat messages can only be sent in play state
+		}
+		b := &chat.Builder{
+			Protocol:  protocol,
+			Component: d.chat(),
+		}
+		return viewer.WritePacket(b.ToClient())
+	}
+	state, err := dialogState(viewer)
+	if err != nil {
+		return err
+	}
+	tag, err := d.binaryTag(protocol)
+	if err != nil {
+		return err
+	}
+	return viewer.WritePacket(&packet.DialogShow{
+		State:     state,
+		BinaryTag: tag,
+	})
+}
+
+// Clear closes the dialog currently shown to the viewer.
+// It does nothing for clients older than 1.21.6.
+func Clear(viewer Viewer) error {
+	protocol, _ := methods.Protocol(viewer)
+	if !isProtocolSupported(protocol) {
+		return nil
+	}
+	if _, err := dialogState(viewer); err != nil {
+		return err
+	}
+	return viewer.WritePacket(&packet.DialogClear{})
+}
+
+func isProtocolSupported(protocol proto.Protocol) bool {
+	return protocol.GreaterEqual(version.Minecraft_1_21_6)
+}
+
+// dialogState returns the state to show dialogs in, assuming play if the viewer has no state.
+func dialogState(viewer Viewer) (states.State, error) {
+	s, ok := methods.State(viewer)
+	if !ok {
+		return states.PlayState, nil
+	}
+	if s != states.ConfigState && s != states.PlayState {
+		return s, fmt.Errorf("%w: player is in %s state", ErrUnsupportedState, s)
+	}
+	return s, nil
+}
+
+// binaryTag encodes the dialog to the NBT format of the DialogShow packet.
+func (d *Dialog) binaryTag(protocol proto.Protocol) (nbt.RawMessage, error) {
+	e := &encoder{protocol: protocol}
+	m := map[string]any{
+		"type":  string(d.Type),
+		"title": e.component(d.Title),
+	}
+	if d.ExternalTitle != nil {
+		m["external_title"] = e.component(d.ExternalTitle)
+	}
+	if len(d.Body) != 0 {
+		body := make([]any, 0, len(d.Body))
+		for _, c := range d.Body {
+			body = append(body, map[string]any{
+				"type":     "minecraft:plain_message",
+				"contents": e.component(c),
+			})
+		}
+		m["body"] = body
+	}
+	if len(d.Inputs) != 0 {
+		inputs := make([]any, 0, len(d.Inputs))
+		for _, in := range d.Inputs {
+			inputs = append(inputs, in.encode(e))
+		}
+		m["inputs"] = inputs
+	}
+	if d.DisableEscape {
+		m["can_close_with_escape"] = false
+	}
+	if d.AfterAction != "" && d.AfterAction != AfterActionClose {
+		m["after_action"] = string(d.AfterAction)
+		m["pause"] = false // the game can only be paused by dialogs closing after actions
+	}
+
+	switch d.Type {
+	case NoticeType:
+		if len(d.Buttons) != 0 {
+			m["action"] = d.Buttons[0].encode(e, d.ID)
+		}
+	case ConfirmationType:
+		m["yes"] = d.Buttons[0].encode(e, d.ID)
+		m["no"] = d.Buttons[1].encode(e, d.ID)
+	case MultiActionType:
+		actions := make([]any, 0, len(d.Buttons))
+		for _, b := range d.Buttons {
+			actions = append(actions, b.encode(e, d.ID))
+		}
+		m["actions"] = actions
+		if d.Columns > 0 {
+			m["columns"] = int32(d.Columns)
+		}
+		if d.Exit != nil {
+			m["exit_action"] = d.Exit.encode(e, d.ID)
+		}
+	}
+	if e.err != nil {
+		return nbt.RawMessage{}, e.err
+	}
+	return encodeBinaryTag(m)
+}
+
+// encodeBinaryTag encodes v in network format.
+func encodeBinaryTag(v any) (nbt.RawMessage, error) {
+	buf := new(bytes.Buffer)
+	enc := nbt.NewEncoder(buf)
+	enc.NetworkFormat(true)
+	if err := enc.Encode(v, ""); err != nil {
+		return nbt.RawMessage{}, fmt.Errorf("error encoding dialog: %w", err)
+	}
+	b := buf.Bytes()
+	return nbt.RawMessage{Type: b[0], Data: b[1:]}, nil
+}
+
+// encoder converts components to binary tags and remembers the first error.
+type encoder struct {
+	protocol proto.Protocol
+	err      error
+}
+
+func (e *encoder) component(c component.Component) any {
+	if c == nil {
+		c = &component.Text{}
+	}
+	tag, err := chat.FromComponentProtocol(c, e.protocol).AsBinaryTag()
+	if err != nil && e.err == nil {
+		e.err = fmt.Errorf("error encoding dialog component: %w", err)
+	}
+	return tag
+}
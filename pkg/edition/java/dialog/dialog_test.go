@@ -0,0 +1,109 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/Tnze/go-mc/nbt"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+)
+
+func decode(t *testing.T, d *Dialog) map[string]any {
+	t.Helper()
+	tag, err := d.binaryTag(version.Minecraft_1_21_6.Protocol)
+	require.NoError(t, err)
+	require.Equal(t, byte(nbt.TagCompound), tag.Type)
+	var m map[string]any
+	require.NoError(t, tag.Unmarshal(&m))
+	return m
+}
+
+func TestDialog_binaryTag_Confirmation(t *testing.T) {
+	id := key.New("test", "report")
+	d := Confirmation(id, &component.Text{Content: "Report"}, &component.Text{Content: "Body"}).
+		WithInputs(
+			TextInput("name", &component.Text{Content: "Name"}),
+			BooleanInput("anonymous", &component.Text{Content: "Anonymous"}, true),
+			NumberRangeInput("severity", &component.Text{Content: "Severity"}, 1, 10, 1),
+		)
+	d.AfterAction = AfterActionWaitForResponse
+	require.NoError(t, d.Validate())
+
+	m := decode(t, d)
+	require.Equal(t, "minecraft:confirmation", m["type"])
+	require.Equal(t, "wait_for_response", m["after_action"])
+	require.Len(t, m["body"], 1)
+
+	inputs := m["inputs"].([]any)
+	require.Len(t, inputs, 3)
+	require.Equal(t, "minecraft:text", inputs[0].(map[string]any)["type"])
+	require.Equal(t, int8(1), inputs[1].(map[string]any)["initial"])
+	require.Equal(t, float32(10), inputs[2].(map[string]any)["end"])
+
+	yes := m["yes"].(map[string]any)["action"].(map[string]any)
+	require.Equal(t, "dynamic/custom", yes["type"])
+	require.Equal(t, "test:report", yes["id"])
+	require.Equal(t, map[string]any{ActionKey: "yes"}, yes["additions"])
+	require.Contains(t, m, "no")
+}
+
+func TestDialog_binaryTag_MultiAction(t *testing.T) {
+	d := MultiAction(key.New("test", "menu"), &component.Text{Content: "Menu"}, []Button{
+		{ID: "lobby", Label: &component.Text{Content: "Lobby"}, Width: 2000},
+		{Label: &component.Text{Content: "Website"}, Action: OpenURL("https://minekube.com")},
+	})
+	d.Columns = 1
+
+	m := decode(t, d)
+	require.Equal(t, int32(1), m["columns"])
+	actions := m["actions"].([]any)
+	require.Len(t, actions, 2)
+	require.Equal(t, int32(1024), actions[0].(map[string]any)["width"])
+	require.Equal(t, map[string]any{"type": "open_url", "url": "https://minekube.com"},
+		actions[1].(map[string]any)["action"])
+	require.NotContains(t, m, "exit_action")
+}
+
+func TestDialog_Validate(t *testing.T) {
+	title := &component.Text{Content: "Title"}
+	require.ErrorIs(t, (*Dialog)(nil).Validate(), ErrInvalidDialog)
+	require.ErrorIs(t, Notice(nil, title).Validate(), ErrInvalidDialog)
+	require.ErrorIs(t, MultiAction(key.New("test", "menu"), title, nil).Validate(), ErrInvalidDialog)
+	require.ErrorIs(t, Notice(key.New("test", "form"), title).
+		WithInputs(TextInput("a", nil), TextInput("a", nil)).Validate(), ErrInvalidDialog)
+	require.ErrorIs(t, Notice(key.New("test", "form"), title).
+		WithInputs(TextInput(ActionKey, nil)).Validate(), ErrInvalidDialog)
+	require.NoError(t, Notice(key.New("test", "notice"), title).Validate())
+}
+
+func TestNewResponse(t *testing.T) {
+	payload, err := encodeBinaryTag(map[string]any{
+		ActionKey:   "yes",
+		"name":      "Steve",
+		"anonymous": true,
+		"severity":  float32(7),
+	})
+	require.NoError(t, err)
+
+	r, err := NewResponse(key.New("test", "report"), &payload)
+	require.NoError(t, err)
+	require.Equal(t, "yes", r.Action())
+	name, ok := r.String("name")
+	require.True(t, ok)
+	require.Equal(t, "Steve", name)
+	anonymous, ok := r.Bool("anonymous")
+	require.True(t, ok)
+	require.True(t, anonymous)
+	severity, ok := r.Float("severity")
+	require.True(t, ok)
+	require.Equal(t, 7.0, severity)
+	_, ok = r.String("missing")
+	require.False(t, ok)
+
+	r, err = NewResponse(key.New("test", "empty"), nil)
+	require.NoError(t, err)
+	require.Empty(t, r.Action())
+}
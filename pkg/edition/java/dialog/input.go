@@ -0,0 +1,129 @@
+package dialog
+
+import (
+	"go.minekube.com/common/minecraft/component"
+)
+
+// InputType is the type of input control.
+type InputType string
+
+const (
+	// TextInputType is a text field, the response value is a string.
+	TextInputType InputType = "minecraft:text"
+	// BooleanInputType is a checkbox, the response value is a bool.
+	BooleanInputType InputType = "minecraft:boolean"
+	// SingleOptionInputType is a button cycling through options, the response value is the option ID.
+	SingleOptionInputType InputType = "minecraft:single_option"
+	// NumberRangeInputType is a slider, the response value is a number.
+	NumberRangeInputType InputType = "minecraft:number_range"
+)
+
+// Input is an input control of a dialog form.
+// Use the TextInput, BooleanInput, SingleOptionInput and NumberRangeInput functions to create inputs.
+type Input struct {
+	Type InputType
+	// Key of the input value in the response.
+	Key   string
+	Label component.Component
+	// Width of the input between 1 and 1024. (default 200)
+	Width int
+
+	// Initial is the initial text of text inputs.
+	Initial string
+	// MaxLength is the maximum text length of text inputs. (default 32)
+	MaxLength int
+	// Multiline allows text inputs to span multiple lines.
+	Multiline bool
+
+	// Checked is the initial state of boolean inputs.
+	Checked bool
+
+	// Options of single option inputs.
+	Options []Option
+
+	// Start, End and Step define the range of number range inputs.
+	// A zero Step allows any value in between.
+	Start, End, Step float32
+	// Value is the initial value of number range inputs, defaults to the middle of the range.
+	Value *float32
+}
+
+// Option is an option of a single option input.
+type Option struct {
+	// ID is sent as input value in the response when selected.
+	ID      string
+	Display component.Component // Optional, defaults to the ID
+	Initial bool                // Whether the option is selected initially
+}
+
+// TextInput creates a text input.
+func TextInput(key string, label component.Component) Input {
+	return Input{Type: TextInputType, Key: key, Label: label}
+}
+
+// BooleanInput creates a checkbox input.
+func BooleanInput(key string, label component.Component, checked bool) Input {
+	return Input{Type: BooleanInputType, Key: key, Label: label, Checked: checked}
+}
+
+// SingleOptionInput creates an input selecting one of the options.
+func SingleOptionInput(key string, label component.Component, options ...Option) Input {
+	return Input{Type: SingleOptionInputType, Key: key, Label: label, Options: options}
+}
+
+// NumberRangeInput creates a slider input from start to end.
+func NumberRangeInput(key string, label component.Component, start, end, step float32) Input {
+	return Input{Type: NumberRangeInputType, Key: key, Label: label, Start: start, End: end, Step: step}
+}
+
+func (in *Input) encode(e *encoder) map[string]any {
+	label := in.Label
+	if label == nil {
+		label = &component.Text{}
+	}
+	m := map[string]any{
+		"type":  string(in.Type),
+		"key":   in.Key,
+		"label": e.component(label),
+	}
+	if in.Width > 0 {
+		m["width"] = int32(min(in.Width, 1024))
+	}
+	switch in.Type {
+	case TextInputType:
+		if in.Initial != "" {
+			m["initial"] = in.Initial
+		}
+		if in.MaxLength > 0 {
+			m["max_length"] = int32(in.MaxLength)
+		}
+		if in.Multiline {
+			m["multiline"] = map[string]any{}
+		}
+	case BooleanInputType:
+		m["initial"] = in.Checked
+	case SingleOptionInputType:
+		options := make([]any, 0, len(in.Options))
+		for _, o := range in.Options {
+			option := map[string]any{"id": o.ID}
+			if o.Display != nil {
+				option["display"] = e.component(o.Display)
+			}
+			if o.Initial {
+				option["initial"] = true
+			}
+			options = append(options, option)
+		}
+		m["options"] = options
+	case NumberRangeInputType:
+		m["start"] = in.Start
+		m["end"] = in.End
+		if in.Step > 0 {
+			m["step"] = in.Step
+		}
+		if in.Value != nil {
+			m["initial"] = *in.Value
+		}
+	}
+	return m
+}
@@ -0,0 +1,80 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/Tnze/go-mc/nbt"
+	"go.minekube.com/common/minecraft/key"
+)
+
+// Response is the response of a player clicking a dialog button without a static action.
+type Response struct {
+	// ID is the ID of the dialog, or of the custom click action if not sent by a Gate dialog.
+	ID key.Key
+	// Payload is the raw payload sent by the client, nil if none.
+	Payload *nbt.RawMessage
+
+	values map[string]any
+}
+
+// NewResponse creates a response and decodes the input values of a compound payload.
+func NewResponse(id key.Key, payload *nbt.RawMessage) (*Response, error) {
+	r := &Response{ID: id, Payload: payload}
+	if payload == nil || payload.Type != nbt.TagCompound {
+		return r, nil
+	}
+	if err := payload.Unmarshal(&r.values); err != nil {
+		return nil, fmt.Errorf("error decoding dialog response payload: %w", err)
+	}
+	return r, nil
+}
+
+// Action returns the ID of the clicked button or an empty string if unknown.
+func (r *Response) Action() string {
+	s, _ := r.String(ActionKey)
+	return s
+}
+
+// Values returns the decoded values of the payload by key,
+// e.g. the values of the dialog's inputs.
+func (r *Response) Values() map[string]any {
+	return r.values
+}
+
+// String returns the value of a text or single option input.
+func (r *Response) String(key string) (string, bool) {
+	s, ok := r.values[key].(string)
+	return s, ok
+}
+
+// Bool returns the value of a boolean input.
+func (r *Response) Bool(key string) (bool, bool) {
+	switch v := r.values[key].(type) {
+	case bool:
+		return v, true
+	case int8:
+		return v != 0, true
+	case uint8:
+		return v != 0, true
+	}
+	return false, false
+}
+
+// Float returns the value of a number range input.
+func (r *Response) Float(key string) (float64, bool) {
+	switch v := r.values[key].(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
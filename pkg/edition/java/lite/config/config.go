@@ -39,6 +39,10 @@ type (
 		TCPShieldRealIP   bool     `json:"tcpShieldRealIP,omitempty" yaml:"tcpShieldRealIP,omitempty"`
 		ModifyVirtualHost bool     `json:"modifyVirtualHost,omitempty" yaml:"modifyVirtualHost,omitempty"`
 		Strategy          Strategy `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+		// Maintenance answers status requests and logins with the proxy's maintenance
+		// config instead of dialing the backend. Players on the maintenance whitelist
+		// are still forwarded.
+		Maintenance bool `json:"maintenance,omitempty" yaml:"maintenance,omitempty"`
 	}
 	Status struct {
 		MOTD    *configutil.Component `yaml:"motd,omitempty" json:"motd,omitempty"`
@@ -46,18 +50,33 @@ type (
 		Players *ping.Players         `json:"players,omitempty" yaml:"players,omitempty"`
 		Favicon favicon.Favicon       `yaml:"favicon,omitempty" json:"favicon,omitempty"`
 		ModInfo modinfo.ModInfo       `yaml:"modInfo,omitempty" json:"modInfo,omitempty"`
+
+		MOTDs       []*configutil.Component `yaml:"motds,omitempty" json:"motds,omitempty"`             // Rotated instead of MOTD if set.
+		Favicons    []favicon.Favicon       `yaml:"favicons,omitempty" json:"favicons,omitempty"`       // Rotated instead of Favicon if set.
+		Rotation    configutil.Rotation     `yaml:"rotation,omitempty" json:"rotation,omitempty"`       // How MOTDs and Favicons are rotated.
+		Unsupported ping.UnsupportedVersion `yaml:"unsupported,omitempty" json:"unsupported,omitempty"` // Response for unsupported client versions.
 	}
 )
 
-// Response returns the configured status response.
-func (s *Status) Response(proto.Protocol) (*ping.ServerPing, error) {
-	return &ping.ServerPing{
+// Response returns the configured status response for the client protocol.
+func (s *Status) Response(protocol proto.Protocol) (*ping.ServerPing, error) {
+	now := time.Now()
+	motd, fav := s.MOTD, s.Favicon
+	if len(s.MOTDs) != 0 {
+		motd = configutil.Pick(s.Rotation, s.MOTDs, now)
+	}
+	if len(s.Favicons) != 0 {
+		fav = configutil.Pick(s.Rotation, s.Favicons, now)
+	}
+	p := &ping.ServerPing{
 		Version:     s.Version,
 		Players:     s.Players,
-		Description: s.MOTD.C(),
-		Favicon:     s.Favicon,
+		Description: motd.C(),
+		Favicon:     fav,
 		ModInfo:     &s.ModInfo,
-	}, nil
+	}
+	s.Unsupported.Apply(p, protocol)
+	return p, nil
 }
 
 // GetCachePingTTL returns the configured ping cache TTL or a default duration if not set.
@@ -103,6 +122,11 @@ var allowedStrategies = []Strategy{
 	StrategyLowestLatency,
 }
 
+// ValidStrategy returns true if s is a known strategy or empty, which defaults to sequential.
+func ValidStrategy(s Strategy) bool {
+	return s == "" || slices.Contains(allowedStrategies, s)
+}
+
 func (c Config) Validate() (warns []error, errs []error) {
 	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
 	w := func(m string, args ...any) { warns = append(warns, fmt.Errorf(m, args...)) }
@@ -122,6 +146,11 @@ func (c Config) Validate() (warns []error, errs []error) {
 		if !slices.Contains(allowedStrategies, ep.Strategy) && ep.Strategy != "" {
 			e("Route %d: invalid strategy '%s', allowed: %v", i, ep.Strategy, allowedStrategies)
 		}
+		if ep.Fallback != nil && ep.Fallback.Unsupported.Enabled {
+			if _, _, err := ep.Fallback.Unsupported.Range(); err != nil {
+				e("Route %d: invalid unsupported version fallback: %v", i, err)
+			}
+		}
 
 		// Validate parameter usage in backend addresses
 		for hostIdx, host := range ep.Host {
@@ -0,0 +1,229 @@
+package lite
+
+import (
+	"net"
+	"time"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// The events in this file are fired by Lite mode through the proxy's event manager.
+// Connections forwarded in Lite mode never become players, so most proxy events
+// do not fire for them.
+
+// routeInfo describes the client connection a Lite event is about.
+type routeInfo struct {
+	remoteAddr  net.Addr
+	virtualHost string
+	protocol    proto.Protocol
+	ping        bool
+	host        string
+	route       *config.Route
+}
+
+// RemoteAddr returns the address of the client.
+func (i *routeInfo) RemoteAddr() net.Addr { return i.remoteAddr }
+
+// VirtualHost returns the cleared virtual host the client connected with.
+func (i *routeInfo) VirtualHost() string { return i.virtualHost }
+
+// Protocol returns the protocol version of the client.
+func (i *routeInfo) Protocol() proto.Protocol { return i.protocol }
+
+// Ping returns true if the client requests the server status
+// and false if the client wants to join.
+func (i *routeInfo) Ping() bool { return i.ping }
+
+// Host returns the host pattern of the matched route.
+func (i *routeInfo) Host() string { return i.host }
+
+// Route returns the matched route. It is shared with the config and must not be modified.
+func (i *routeInfo) Route() *config.Route { return i.route }
+
+//
+//
+//
+//
+//
+
+// RouteMatchedEvent is fired when a route matched the virtual host of a client,
+// before any backend is selected.
+//
+// Handlers can override the backends of the route for this connection or deny it.
+// Denied joining clients are disconnected with the reason and denied status pings
+// are answered with the status response, if set, or closed otherwise.
+type RouteMatchedEvent struct {
+	routeInfo
+	backends []string
+
+	denied   bool
+	reason   component.Component
+	response *packet.StatusResponse
+}
+
+// Backends returns the backend addresses to try, with route parameters already substituted.
+func (e *RouteMatchedEvent) Backends() []string {
+	return e.backends
+}
+
+// SetBackends overrides the backend addresses to try for this connection.
+// The route's load balancing strategy still picks the order they are tried in.
+func (e *RouteMatchedEvent) SetBackends(backends []string) {
+	e.backends = backends
+}
+
+// Deny denies the connection. Joining clients are disconnected with the reason.
+func (e *RouteMatchedEvent) Deny(reason component.Component) {
+	e.denied = true
+	e.reason = reason
+}
+
+// DenyWithStatus denies the connection and answers status pings with the response
+// instead of resolving it from a backend. Joining clients are disconnected without reason.
+func (e *RouteMatchedEvent) DenyWithStatus(res *packet.StatusResponse) {
+	e.denied = true
+	e.response = res
+}
+
+// Allow allows the connection again after it was denied.
+func (e *RouteMatchedEvent) Allow() {
+	e.denied = false
+	e.reason = nil
+	e.response = nil
+}
+
+// Denied returns true if the connection was denied.
+func (e *RouteMatchedEvent) Denied() bool {
+	return e.denied
+}
+
+// Reason returns the reason to disconnect a denied joining client. May be nil!
+func (e *RouteMatchedEvent) Reason() component.Component {
+	return e.reason
+}
+
+// StatusResponse returns the status response for a denied status ping. May be nil!
+func (e *RouteMatchedEvent) StatusResponse() *packet.StatusResponse {
+	return e.response
+}
+
+//
+//
+//
+//
+//
+
+// BackendSelectedEvent is fired when the load balancing strategy selected a backend
+// for a client, before it is dialed. It fires again for every backend tried
+// after the previous one failed.
+type BackendSelectedEvent struct {
+	routeInfo
+	backend string
+}
+
+// Backend returns the selected backend address.
+func (e *BackendSelectedEvent) Backend() string {
+	return e.backend
+}
+
+// SetBackend overrides the backend address to dial.
+func (e *BackendSelectedEvent) SetBackend(addr string) {
+	e.backend = addr
+}
+
+//
+//
+//
+//
+//
+
+// ConnectionEstablishedEvent is fired when a joining client was connected to a backend
+// and the connection starts being forwarded.
+type ConnectionEstablishedEvent struct {
+	routeInfo
+	backend string
+}
+
+// Backend returns the address of the backend the client is forwarded to.
+func (e *ConnectionEstablishedEvent) Backend() string {
+	return e.backend
+}
+
+//
+//
+//
+//
+//
+
+// ConnectionClosedEvent is fired when the forwarded connection of a client
+// to a backend was closed.
+type ConnectionClosedEvent struct {
+	routeInfo
+	backend             string
+	toBackend, toClient int64
+	established, closed time.Time
+}
+
+// Backend returns the address of the backend the client was forwarded to.
+func (e *ConnectionClosedEvent) Backend() string {
+	return e.backend
+}
+
+// BytesToBackend returns the number of bytes forwarded from the client to the backend.
+func (e *ConnectionClosedEvent) BytesToBackend() int64 {
+	return e.toBackend
+}
+
+// BytesToClient returns the number of bytes forwarded from the backend to the client.
+func (e *ConnectionClosedEvent) BytesToClient() int64 {
+	return e.toClient
+}
+
+// Duration returns how long the connection was forwarded.
+func (e *ConnectionClosedEvent) Duration() time.Duration {
+	return e.closed.Sub(e.established)
+}
+
+//
+//
+//
+//
+//
+
+// StatusResponseResolvedEvent is fired when the status response for a status ping
+// was resolved from a backend, the ping cache or the route's fallback status.
+//
+// Handlers can rewrite the response for this ping. The response may be shared by
+// the ping cache and must not be modified, set a new one with SetResponse instead.
+type StatusResponseResolvedEvent struct {
+	routeInfo
+	backend  string
+	fallback bool
+	response *packet.StatusResponse
+}
+
+// Backend returns the address of the backend that answered the ping.
+// Empty if the fallback status was used.
+func (e *StatusResponseResolvedEvent) Backend() string {
+	return e.backend
+}
+
+// Fallback returns true if the route's fallback status was used as no backend answered.
+func (e *StatusResponseResolvedEvent) Fallback() bool {
+	return e.fallback
+}
+
+// Response returns the status response sent to the client.
+func (e *StatusResponseResolvedEvent) Response() *packet.StatusResponse {
+	return e.response
+}
+
+// SetResponse replaces the status response sent to the client.
+// The ping cache is not affected. Setting nil closes the connection without answering.
+func (e *StatusResponseResolvedEvent) SetResponse(res *packet.StatusResponse) {
+	e.response = res
+}
@@ -0,0 +1,205 @@
+package lite
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/robinbraemer/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/configutil"
+)
+
+// eventTestConn is a routeTestConn with a context, as needed to resolve status responses.
+type eventTestConn struct {
+	routeTestConn
+}
+
+func (c *eventTestConn) Context() context.Context { return context.Background() }
+
+func newEventTestConn(t *testing.T) *eventTestConn {
+	client, server := net.Pipe()
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = server.Close()
+	})
+	return &eventTestConn{routeTestConn{conn: client}}
+}
+
+func TestRouteMatchedAndBackendSelectedEvents(t *testing.T) {
+	routes := []config.Route{{
+		Host:    []string{"*.example.org"},
+		Backend: []string{"$1:25565"},
+	}}
+	mgr := event.New()
+	var matched *RouteMatchedEvent
+	event.Subscribe(mgr, 0, func(e *RouteMatchedEvent) {
+		matched = e
+		assert.Equal(t, []string{"lobby:25565"}, e.Backends())
+		e.SetBackends([]string{"10.0.0.1:25565"})
+	})
+	event.Subscribe(mgr, 0, func(e *BackendSelectedEvent) {
+		assert.Equal(t, "10.0.0.1:25565", e.Backend())
+		e.SetBackend("10.0.0.2:25565")
+	})
+
+	_, _, route, info, nextBackend, err := findRoute(routes, testr.New(t), newEventTestConn(t), &packet.Handshake{
+		ServerAddress:   "lobby.example.org",
+		ProtocolVersion: int(version.Minecraft_1_20_2.Protocol),
+		NextStatus:      int(packet.LoginHandshakeIntent),
+	}, NewStrategyManager(), mgr)
+	require.NoError(t, err)
+	require.NotNil(t, matched)
+	assert.Same(t, route, matched.Route())
+	assert.Equal(t, "*.example.org", info.Host())
+	assert.Equal(t, "lobby.example.org", matched.VirtualHost())
+	assert.False(t, matched.Ping())
+
+	backend, _, ok := nextBackend()
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.2:25565", backend)
+	_, _, ok = nextBackend()
+	assert.False(t, ok, "overridden backends must replace the route's backends")
+}
+
+func TestRouteMatchedEventDeny(t *testing.T) {
+	routes := []config.Route{{
+		Host:    []string{"play.example.org"},
+		Backend: []string{"127.0.0.1:25566"},
+	}}
+	reason := &component.Text{Content: "go away"}
+	mgr := event.New()
+	event.Subscribe(mgr, 0, func(e *RouteMatchedEvent) {
+		e.Deny(reason)
+	})
+
+	_, _, _, _, nextBackend, err := findRoute(routes, testr.New(t), newEventTestConn(t), &packet.Handshake{
+		ServerAddress:   "play.example.org",
+		ProtocolVersion: int(version.Minecraft_1_20_2.Protocol),
+	}, NewStrategyManager(), mgr)
+	var denied *routeDeniedError
+	require.True(t, errors.As(err, &denied))
+	assert.Equal(t, reason, denied.reason)
+	assert.Nil(t, nextBackend)
+}
+
+func TestRouteMatchedEventDenyWithStatus(t *testing.T) {
+	routes := []config.Route{{
+		Host:    []string{"play.example.org"},
+		Backend: []string{"127.0.0.1:25566"},
+	}}
+	res := &packet.StatusResponse{Status: `{"description":"closed"}`}
+	mgr := event.New()
+	event.Subscribe(mgr, 0, func(e *RouteMatchedEvent) {
+		assert.True(t, e.Ping())
+		e.DenyWithStatus(res)
+	})
+	event.Subscribe(mgr, 0, func(*StatusResponseResolvedEvent) {
+		t.Error("status response of a denied ping must not be resolved")
+	})
+
+	handshake := &packet.Handshake{
+		ServerAddress:   "play.example.org",
+		ProtocolVersion: int(version.Minecraft_1_20_2.Protocol),
+		NextStatus:      int(packet.StatusHandshakeIntent),
+	}
+	pc := &proto.PacketContext{Protocol: version.Minecraft_1_20_2.Protocol}
+	_, got, err := ResolveStatusResponseWithOptions(time.Second, routes, testr.New(t), newEventTestConn(t),
+		handshake, pc, pc, NewStrategyManager(), StatusOptions{EventMgr: mgr})
+	require.NoError(t, err)
+	assert.Same(t, res, got)
+}
+
+func TestStatusResponseResolvedEvent(t *testing.T) {
+	motd := configutil.Component{Value: &component.Text{Content: "offline"}}
+	routes := []config.Route{{
+		Host:         []string{"play.example.org"},
+		Backend:      []string{"127.0.0.1:1"}, // refused
+		CachePingTTL: -1,
+		Fallback:     &config.Status{MOTD: &motd},
+	}}
+	rewritten := &packet.StatusResponse{Status: `{"description":"rewritten"}`}
+	mgr := event.New()
+	var resolved *StatusResponseResolvedEvent
+	event.Subscribe(mgr, 0, func(e *StatusResponseResolvedEvent) {
+		resolved = e
+		assert.Contains(t, e.Response().Status, "offline")
+		e.SetResponse(rewritten)
+	})
+
+	handshake := &packet.Handshake{
+		ServerAddress:   "play.example.org",
+		ProtocolVersion: int(version.Minecraft_1_20_2.Protocol),
+		NextStatus:      int(packet.StatusHandshakeIntent),
+	}
+	pc := &proto.PacketContext{Protocol: version.Minecraft_1_20_2.Protocol}
+	_, got, err := ResolveStatusResponseWithOptions(time.Second, routes, testr.New(t), newEventTestConn(t),
+		handshake, pc, pc, NewStrategyManager(), StatusOptions{EventMgr: mgr})
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+	assert.True(t, resolved.Fallback())
+	assert.Empty(t, resolved.Backend())
+	assert.Same(t, rewritten, got)
+}
+
+func TestStatusResponseResolvedEventRemoveResponse(t *testing.T) {
+	motd := configutil.Component{Value: &component.Text{Content: "offline"}}
+	routes := []config.Route{{
+		Host:         []string{"play.example.org"},
+		Backend:      []string{"127.0.0.1:1"}, // refused
+		CachePingTTL: -1,
+		Fallback:     &config.Status{MOTD: &motd},
+	}}
+	mgr := event.New()
+	event.Subscribe(mgr, 0, func(e *StatusResponseResolvedEvent) {
+		e.SetResponse(nil)
+	})
+
+	handshake := &packet.Handshake{
+		ServerAddress:   "play.example.org",
+		ProtocolVersion: int(version.Minecraft_1_20_2.Protocol),
+		NextStatus:      int(packet.StatusHandshakeIntent),
+	}
+	pc := &proto.PacketContext{Protocol: version.Minecraft_1_20_2.Protocol}
+	_, got, err := ResolveStatusResponseWithOptions(time.Second, routes, testr.New(t), newEventTestConn(t),
+		handshake, pc, pc, NewStrategyManager(), StatusOptions{EventMgr: mgr})
+	require.ErrorIs(t, err, errStatusResponseRemoved)
+	assert.Nil(t, got)
+}
+
+func TestPipeCountsBytes(t *testing.T) {
+	clientPeer, src := net.Pipe()
+	dst, backendPeer := net.Pipe()
+	t.Cleanup(func() {
+		_ = clientPeer.Close()
+		_ = backendPeer.Close()
+		_ = src.Close()
+	})
+
+	go func() {
+		_, _ = clientPeer.Write([]byte("hello"))
+		buf := make([]byte, 3)
+		_, _ = io.ReadFull(clientPeer, buf)
+		_ = clientPeer.Close()
+	}()
+	go func() {
+		buf := make([]byte, 5)
+		_, _ = io.ReadFull(backendPeer, buf)
+		_, _ = backendPeer.Write([]byte("hi!"))
+	}()
+
+	toBackend, toClient := pipe(testr.New(t), src, dst)
+	assert.Equal(t, int64(5), toBackend)
+	assert.Equal(t, int64(3), toClient)
+}
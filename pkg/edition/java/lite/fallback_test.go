@@ -14,6 +14,7 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proto/packet"
 	"go.minekube.com/gate/pkg/gate/proto"
 	"go.minekube.com/gate/pkg/util/configutil"
+	"go.minekube.com/gate/pkg/util/placeholder"
 	"go.minekube.com/gate/pkg/util/uuid"
 )
 
@@ -28,12 +29,12 @@ func TestHandleFallbackResponse(t *testing.T) {
 			// No fallback
 		}
 
-		resp, _ := handleFallbackResponse(log, route, protocol, backendErr)
+		resp, _ := handleFallbackResponse(log, route, protocol, backendErr, nil)
 		assert.Nil(t, resp, "Should return nil when no fallback configured")
 	})
 
 	t.Run("nil route", func(t *testing.T) {
-		resp, _ := handleFallbackResponse(log, nil, protocol, backendErr)
+		resp, _ := handleFallbackResponse(log, nil, protocol, backendErr, nil)
 		assert.Nil(t, resp, "Should return nil for nil route")
 	})
 
@@ -50,7 +51,7 @@ func TestHandleFallbackResponse(t *testing.T) {
 			},
 		}
 
-		resp, _ := handleFallbackResponse(log, route, protocol, backendErr)
+		resp, _ := handleFallbackResponse(log, route, protocol, backendErr, nil)
 		require.NotNil(t, resp, "Should return fallback response")
 
 		// Verify the response contains our fallback MOTD
@@ -77,7 +78,7 @@ func TestHandleFallbackResponse(t *testing.T) {
 			},
 		}
 
-		resp, _ := handleFallbackResponse(log, route, protocol, backendErr)
+		resp, _ := handleFallbackResponse(log, route, protocol, backendErr, nil)
 		require.NotNil(t, resp, "Should return fallback response")
 
 		// Verify players info is in the response
@@ -85,6 +86,25 @@ func TestHandleFallbackResponse(t *testing.T) {
 		assert.Contains(t, resp.Status, "\"online\":0", "Response should contain online players")
 		assert.Contains(t, resp.Status, "No servers available", "Response should contain sample player")
 	})
+
+	t.Run("fallback with placeholders", func(t *testing.T) {
+		motd := configutil.Component{Value: &component.Text{Content: "{online}/{max} on {network}"}}
+		route := &config.Route{
+			Fallback: &config.Status{
+				MOTD:    &motd,
+				Players: &ping.Players{Max: 100, Online: 5},
+			},
+		}
+
+		placeholders := placeholder.NewRegistry()
+		require.NoError(t, placeholders.Register("network", func(*placeholder.Context, string) (string, bool) {
+			return "Example", true
+		}))
+		resp, _ := handleFallbackResponse(log, route, protocol, backendErr, placeholders)
+		require.NotNil(t, resp, "Should return fallback response")
+		assert.Contains(t, resp.Status, "5/100 on Example", "Response should contain rendered MOTD")
+		assert.Equal(t, "{online}/{max} on {network}", motd.Value.(*component.Text).Content, "Config must not be modified")
+	})
 }
 
 // TestTryBackendsWithFallback tests the integration of tryBackends with fallback
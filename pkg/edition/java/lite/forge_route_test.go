@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr/testr"
+	"github.com/robinbraemer/event"
 	"github.com/stretchr/testify/require"
 
 	"go.minekube.com/gate/pkg/edition/java/lite/config"
@@ -64,6 +65,7 @@ func TestFindRouteMatchesForgeHandshakeHost(t *testing.T) {
 					ProtocolVersion: int(version.Minecraft_1_20_2.Protocol),
 				},
 				NewStrategyManager(),
+				event.Nop,
 			)
 
 			if !tt.wantRoute {
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -15,6 +17,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/jellydator/ttlcache/v3"
+	"github.com/robinbraemer/event"
+	"go.minekube.com/common/minecraft/component"
 	"go.minekube.com/gate/pkg/edition/java/internal/protoutil"
 	"go.minekube.com/gate/pkg/edition/java/lite/config"
 	"go.minekube.com/gate/pkg/edition/java/netmc"
@@ -25,6 +29,7 @@ import (
 	"go.minekube.com/gate/pkg/gate/proto"
 	"go.minekube.com/gate/pkg/util/errs"
 	"go.minekube.com/gate/pkg/util/netutil"
+	"go.minekube.com/gate/pkg/util/placeholder"
 	"golang.org/x/sync/singleflight"
 )
 
@@ -44,10 +49,42 @@ func Forward(
 	handshake *packet.Handshake,
 	pc *proto.PacketContext,
 	strategyManager *StrategyManager,
+) {
+	ForwardWithOptions(dialTimeout, routes, log, client, handshake, pc, strategyManager, ForwardOptions{})
+}
+
+// ForwardOptions are the optional settings for forwarding a client connection.
+type ForwardOptions struct {
+	// EventMgr fires the Lite events. If nil, no events are fired.
+	EventMgr event.Manager
+	// Login is the login start packet if it was already read from the client.
+	// It is sent to the backend after the handshake.
+	Login *proto.PacketContext
+}
+
+// ForwardWithOptions forwards a client connection to a matching backend route.
+func ForwardWithOptions(
+	dialTimeout time.Duration,
+	routes []config.Route,
+	log logr.Logger,
+	client netmc.MinecraftConn,
+	handshake *packet.Handshake,
+	pc *proto.PacketContext,
+	strategyManager *StrategyManager,
+	opts ForwardOptions,
 ) {
 	defer func() { _ = client.Close() }()
 
-	log, src, route, routeHost, nextBackend, err := findRoute(routes, log, client, handshake, strategyManager)
+	eventMgr := orNopEventMgr(opts.EventMgr)
+	log, src, route, info, nextBackend, err := findRoute(routes, log, client, handshake, strategyManager, eventMgr)
+	var denied *routeDeniedError
+	if errors.As(err, &denied) {
+		log.V(1).Info("connection denied by event handler")
+		if denied.reason != nil {
+			_ = netmc.CloseWith(client, packet.NewDisconnect(denied.reason, client.Protocol(), client.State().State))
+		}
+		return
+	}
 	if err != nil {
 		// A player connection that matches no route is silently dropped, so log it at
 		// the default verbosity: it is always an operator-actionable misconfiguration,
@@ -66,17 +103,41 @@ func Forward(
 	}
 	defer func() { _ = dst.Close() }()
 
+	if opts.Login != nil {
+		if err = writePacket(dst, opts.Login); err != nil {
+			errs.V(log, err).Info("failed to write login start packet to backend", "error", err)
+			return
+		}
+	}
 	if err = emptyReadBuff(client, dst); err != nil {
 		errs.V(log, err).Info("failed to empty client buffer", "error", err)
 		return
 	}
 
 	// Track connection for all strategies (used by status API and least-connections strategy)
-	decrementConnection := strategyManager.TrackConnection(routeHost, backendAddr)
+	decrementConnection := strategyManager.TrackConnection(info.host, backendAddr)
 	defer decrementConnection()
 
 	log.Info("forwarding connection", "backendAddr", backendAddr)
-	pipe(log, src, dst)
+	established := time.Now()
+	eventMgr.FireParallel(&ConnectionEstablishedEvent{routeInfo: *info, backend: backendAddr})
+	toBackend, toClient := pipe(log, src, dst)
+	eventMgr.FireParallel(&ConnectionClosedEvent{
+		routeInfo:   *info,
+		backend:     backendAddr,
+		toBackend:   toBackend,
+		toClient:    toClient,
+		established: established,
+		closed:      time.Now(),
+	})
+}
+
+// orNopEventMgr returns the event manager or event.Nop if it is nil.
+func orNopEventMgr(mgr event.Manager) event.Manager {
+	if mgr == nil {
+		return event.Nop
+	}
+	return mgr
 }
 
 // errAllBackendsFailed is returned when all backends failed to dial.
@@ -117,22 +178,30 @@ func emptyReadBuff(src netmc.MinecraftConn, dst net.Conn) error {
 	return nil
 }
 
-func pipe(log logr.Logger, src, dst net.Conn) {
+// pipe copies between the connections until the client is done
+// and returns the number of bytes copied in each direction.
+func pipe(log logr.Logger, src, dst net.Conn) (toBackend, toClient int64) {
 	// disable deadlines
 	var zero time.Time
 	_ = src.SetDeadline(zero)
 	_ = dst.SetDeadline(zero)
 
+	copied := make(chan int64, 1)
 	go func() {
 		i, err := io.Copy(src, dst)
 		if log.Enabled() {
 			log.V(1).Info("done copying backend -> client", "bytes", i, "error", err)
 		}
+		copied <- i
 	}()
-	i, err := io.Copy(dst, src)
+	toBackend, err := io.Copy(dst, src)
 	if log.Enabled() {
-		log.V(1).Info("done copying client -> backend", "bytes", i, "error", err)
+		log.V(1).Info("done copying client -> backend", "bytes", toBackend, "error", err)
 	}
+	// unblock copying backend -> client
+	_ = dst.Close()
+	_ = src.SetWriteDeadline(time.Now())
+	return toBackend, <-copied
 }
 
 type nextBackendFunc func() (backendAddr string, log logr.Logger, ok bool)
@@ -163,17 +232,18 @@ func findRoute(
 	client netmc.MinecraftConn,
 	handshake *packet.Handshake,
 	strategyManager *StrategyManager,
+	eventMgr event.Manager,
 ) (
 	newLog logr.Logger,
 	src net.Conn,
 	route *config.Route,
-	routeHost string,
+	info *routeInfo,
 	nextBackend nextBackendFunc,
 	err error,
 ) {
 	srcConn, ok := netmc.Assert[interface{ Conn() net.Conn }](client)
 	if !ok {
-		return log, src, nil, "", nil, errors.New("failed to assert connection as net.Conn")
+		return log, src, nil, nil, nil, errors.New("failed to assert connection as net.Conn")
 	}
 	src = srcConn.Conn()
 
@@ -188,21 +258,39 @@ func findRoute(
 	if route == nil {
 		// Status pings hit unknown hosts constantly, so they keep this out of the
 		// default log via errs.V. Forward logs it unconditionally for players.
-		return log, src, nil, "", nil, &errs.VerbosityError{
+		return log, src, nil, nil, nil, &errs.VerbosityError{
 			Err:       fmt.Errorf("no route configured for host %s", clearedHost),
 			Verbosity: 1,
 		}
 	}
 	log = log.WithValues("route", host)
-
-	if len(route.Backend) == 0 {
-		return log, src, route, host, nil, errors.New("no backend configured for route")
+	info = &routeInfo{
+		remoteAddr:  src.RemoteAddr(),
+		virtualHost: clearedHost,
+		protocol:    proto.Protocol(handshake.ProtocolVersion),
+		ping:        packet.HandshakeIntent(handshake.NextStatus) == packet.StatusHandshakeIntent,
+		host:        host,
+		route:       route,
 	}
 
 	tryBackends := route.Backend.Copy()
 	for i := range tryBackends {
 		tryBackends[i] = substituteBackendParams(tryBackends[i], groups)
 	}
+
+	matched := &RouteMatchedEvent{routeInfo: *info, backends: tryBackends}
+	eventMgr.Fire(matched)
+	if matched.Denied() {
+		return log, src, route, info, nil, &routeDeniedError{
+			reason:   matched.Reason(),
+			response: matched.StatusResponse(),
+		}
+	}
+	tryBackends = slices.Clone(matched.Backends()) // not modified by nextBackend
+
+	if len(tryBackends) == 0 {
+		return log, src, route, info, nil, errors.New("no backend configured for route")
+	}
 	nextBackend = func() (string, logr.Logger, bool) {
 		if len(tryBackends) == 0 {
 			return "", log, false
@@ -240,10 +328,24 @@ func findRoute(
 			}
 		}
 
+		selected := &BackendSelectedEvent{routeInfo: *info, backend: backendAddr}
+		eventMgr.Fire(selected)
+		backendAddr = selected.Backend()
+
 		return backendAddr, newLog.WithValues("backendAddr", backendAddr), true
 	}
 
-	return log, src, route, host, nextBackend, nil
+	return log, src, route, info, nextBackend, nil
+}
+
+// routeDeniedError is returned by findRoute when a RouteMatchedEvent handler denied the connection.
+type routeDeniedError struct {
+	reason   component.Component
+	response *packet.StatusResponse
+}
+
+func (e *routeDeniedError) Error() string {
+	return "connection denied by route matched event handler"
 }
 
 func dialRoute(
@@ -260,7 +362,9 @@ func dialRoute(
 	defer cancel()
 
 	var dialer net.Dialer
+	start := time.Now()
 	dst, err = dialer.DialContext(dialCtx, "tcp", backendAddr)
+	recordDial(ctx, start, route, backendAddr, err)
 	if err != nil {
 		v := 0
 		if dialCtx.Err() != nil {
@@ -361,15 +465,51 @@ func ResolveStatusResponseWithGeneration(
 	statusRequestCtx *proto.PacketContext,
 	strategyManager *StrategyManager,
 ) (logr.Logger, *packet.StatusResponse, error) {
-	log, src, route, _, nextBackend, err := findRoute(routes, log, client, handshake, strategyManager)
+	return ResolveStatusResponseWithOptions(dialTimeout, routes, log, client, handshake, handshakeCtx, statusRequestCtx, strategyManager,
+		StatusOptions{RouteGeneration: routeGeneration})
+}
+
+// StatusOptions are the optional settings for resolving a status response.
+type StatusOptions struct {
+	// RouteGeneration is the generation of the route snapshot the routes are from.
+	RouteGeneration uint64
+	// Placeholders are rendered in the MOTD of fallback status responses.
+	// If nil, only {online} and {max} are rendered.
+	Placeholders *placeholder.Registry
+	// EventMgr fires the Lite events. If nil, no events are fired.
+	EventMgr event.Manager
+}
+
+// ResolveStatusResponseWithOptions resolves the status response for the matching route
+// and caches it for a short time.
+func ResolveStatusResponseWithOptions(
+	dialTimeout time.Duration,
+	routes []config.Route,
+	log logr.Logger,
+	client netmc.MinecraftConn,
+	handshake *packet.Handshake,
+	handshakeCtx *proto.PacketContext,
+	statusRequestCtx *proto.PacketContext,
+	strategyManager *StrategyManager,
+	opts StatusOptions,
+) (logr.Logger, *packet.StatusResponse, error) {
+	eventMgr := orNopEventMgr(opts.EventMgr)
+	log, src, route, info, nextBackend, err := findRoute(routes, log, client, handshake, strategyManager, eventMgr)
+	var denied *routeDeniedError
+	if errors.As(err, &denied) {
+		if denied.response != nil {
+			return log, denied.response, nil
+		}
+		return log, nil, &errs.VerbosityError{Err: err, Verbosity: 1}
+	}
 	if err != nil {
 		return log, nil, err
 	}
 
-	_, log, res, err := tryBackends(nextBackend, func(log logr.Logger, backendAddr string) (logr.Logger, *packet.StatusResponse, error) {
+	backendAddr, log, res, err := tryBackends(nextBackend, func(log logr.Logger, backendAddr string) (logr.Logger, *packet.StatusResponse, error) {
 		// Measure status response time for latency tracking (better than dial time)
 		start := time.Now()
-		newLog, response, respErr := resolveStatusResponse(src, dialTimeout, routeGeneration, backendAddr, route, log, client, handshake, handshakeCtx, statusRequestCtx)
+		newLog, response, respErr := resolveStatusResponse(src, dialTimeout, opts.RouteGeneration, backendAddr, route, log, client, handshake, handshakeCtx, statusRequestCtx)
 		statusLatency := time.Since(start)
 
 		// Record latency for lowest-latency strategy (only on success)
@@ -381,19 +521,40 @@ func ResolveStatusResponseWithGeneration(
 	})
 
 	// Handle fallback if all backends failed
+	var fallback bool
 	if err != nil {
-		fallbackResp, fallbackLog := handleFallbackResponse(log, route, handshakeCtx.Protocol, err)
-		if fallbackResp != nil {
-			return fallbackLog, fallbackResp, nil
+		fallbackResp, fallbackLog := handleFallbackResponse(log, route, handshakeCtx.Protocol, err, opts.Placeholders)
+		if fallbackResp == nil {
+			return log, nil, err
 		}
+		log, res, backendAddr, fallback = fallbackLog, fallbackResp, "", true
 	}
 
-	return log, res, err
+	resolved := &StatusResponseResolvedEvent{
+		routeInfo: *info,
+		backend:   backendAddr,
+		fallback:  fallback,
+		response:  res,
+	}
+	eventMgr.Fire(resolved)
+	if resolved.Response() == nil {
+		return log, nil, &errs.VerbosityError{Err: errStatusResponseRemoved, Verbosity: 1}
+	}
+	return log, resolved.Response(), nil
 }
 
+// errStatusResponseRemoved is returned when an event handler removed the status response.
+var errStatusResponseRemoved = errors.New("status response removed by event handler")
+
 // handleFallbackResponse handles the fallback response when all backends fail.
 // This is extracted for better testability.
-func handleFallbackResponse(log logr.Logger, route *config.Route, protocol proto.Protocol, backendErr error) (*packet.StatusResponse, logr.Logger) {
+func handleFallbackResponse(
+	log logr.Logger,
+	route *config.Route,
+	protocol proto.Protocol,
+	backendErr error,
+	placeholders *placeholder.Registry,
+) (*packet.StatusResponse, logr.Logger) {
 	if route == nil || route.Fallback == nil {
 		return nil, log
 	}
@@ -408,6 +569,12 @@ func handleFallbackResponse(log logr.Logger, route *config.Route, protocol proto
 	}
 
 	if fallbackPong != nil {
+		ctx := &placeholder.Context{Values: map[string]string{}}
+		if fallbackPong.Players != nil {
+			ctx.Values["online"] = strconv.Itoa(fallbackPong.Players.Online)
+			ctx.Values["max"] = strconv.Itoa(fallbackPong.Players.Max)
+		}
+		fallbackPong.Description = placeholders.Component(ctx, fallbackPong.Description)
 		status, err2 := json.Marshal(fallbackPong)
 		if err2 != nil {
 			log.Error(err2, "failed to marshal fallback status response")
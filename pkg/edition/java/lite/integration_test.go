@@ -117,7 +117,7 @@ func TestFallbackResponseWithRealRoute(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resp, _ := handleFallbackResponse(log, tt.route, protocol, errAllBackendsFailed)
+			resp, _ := handleFallbackResponse(log, tt.route, protocol, errAllBackendsFailed, nil)
 
 			if tt.expectResponse {
 				require.NotNil(t, resp, "Should return fallback response")
@@ -0,0 +1,41 @@
+package lite
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"go.minekube.com/gate/pkg/edition/java/lite/config"
+)
+
+var meter = otel.Meter("java/lite")
+
+// Instruments fall back to no-ops if they could not be created.
+var (
+	dialDuration, _ = meter.Float64Histogram(
+		"gate.lite.dial.duration",
+		metric.WithDescription("The time it took to dial a route's backend, by route and backend"),
+		metric.WithUnit("s"),
+	)
+	dialFailures, _ = meter.Int64Counter(
+		"gate.lite.dial.failures",
+		metric.WithDescription("The failed dials of a route's backend, by route and backend"),
+		metric.WithUnit("{dial}"),
+	)
+)
+
+// recordDial records the dial metrics of a route's backend.
+// The route is identified by its comma separated hosts.
+func recordDial(ctx context.Context, start time.Time, route *config.Route, backendAddr string, err error) {
+	routeAttr := attribute.String("route", strings.Join(route.Host, ","))
+	backendAttr := attribute.String("backend", backendAddr)
+	dialDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		routeAttr, backendAttr, attribute.Bool("success", err == nil)))
+	if err != nil {
+		dialFailures.Add(ctx, 1, metric.WithAttributes(routeAttr, backendAttr))
+	}
+}
@@ -0,0 +1,83 @@
+package lite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/go-logr/logr"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/codec"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/state"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// PingStatus dials the server at addr and requests its status
+// the same way Lite resolves the status of a route's backend.
+// The connection is bound to the deadline of ctx, if any.
+func PingStatus(ctx context.Context, addr string, protocol proto.Protocol) (*packet.StatusResponse, error) {
+	log := logr.FromContextOrDiscard(ctx)
+
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server address %q: %w", addr, err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid server port %q: %w", portStr, err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial server: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	enc := codec.NewEncoder(conn, proto.ServerBound, log.V(2))
+	enc.SetProtocol(protocol)
+	enc.SetState(state.Handshake)
+	if _, err = enc.WritePacket(&packet.Handshake{
+		ProtocolVersion: int(protocol),
+		ServerAddress:   host,
+		Port:            port,
+		NextStatus:      int(packet.StatusHandshakeIntent),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to write handshake packet to server: %w", err)
+	}
+
+	statusRequestCtx, err := newStatusRequestCtx(protocol)
+	if err != nil {
+		return nil, err
+	}
+	return fetchStatus(log, conn, protocol, statusRequestCtx)
+}
+
+// newStatusRequestCtx returns the encoded status request packet for the protocol.
+func newStatusRequestCtx(protocol proto.Protocol) (*proto.PacketContext, error) {
+	req := &packet.StatusRequest{}
+	id, ok := state.FromDirection(proto.ServerBound, state.Status, protocol).PacketID(req)
+	if !ok {
+		return nil, fmt.Errorf("status request packet not registered for protocol %s", protocol)
+	}
+	pc := &proto.PacketContext{
+		Direction: proto.ServerBound,
+		Protocol:  protocol,
+		PacketID:  id,
+		Packet:    req,
+	}
+	payload := new(bytes.Buffer)
+	_ = util.WriteVarInt(payload, int(id))
+	if err := req.Encode(pc, payload); err != nil {
+		return nil, fmt.Errorf("failed to encode status request: %w", err)
+	}
+	pc.Payload = payload.Bytes()
+	return pc, nil
+}
@@ -0,0 +1,77 @@
+package lite
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/codec"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/state"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+func TestPingStatus(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	protocol := version.MaximumVersion.Protocol
+	handshakes := make(chan *packet.Handshake, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		dec := codec.NewDecoder(conn, proto.ServerBound, logr.Discard())
+		dec.SetProtocol(protocol)
+		dec.SetState(state.Handshake)
+		pc, err := dec.Decode()
+		if err != nil {
+			return
+		}
+		handshakes <- pc.Packet.(*packet.Handshake)
+
+		dec.SetState(state.Status)
+		if pc, err = dec.Decode(); err != nil {
+			return
+		}
+		if _, ok := pc.Packet.(*packet.StatusRequest); !ok {
+			return
+		}
+
+		enc := codec.NewEncoder(conn, proto.ClientBound, logr.Discard())
+		enc.SetProtocol(protocol)
+		enc.SetState(state.Status)
+		_, _ = enc.WritePacket(&packet.StatusResponse{Status: `{"description":"ok"}`})
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := PingStatus(ctx, ln.Addr().String(), protocol)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"description":"ok"}`, res.Status)
+
+	handshake := <-handshakes
+	assert.Equal(t, int(protocol), handshake.ProtocolVersion)
+	assert.Equal(t, packet.StatusHandshakeIntent, handshake.Intent())
+	assert.Equal(t, ln.Addr().(*net.TCPAddr).Port, handshake.Port)
+}
+
+func TestPingStatusUnreachable(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := PingStatus(ctx, "127.0.0.1:1", version.MaximumVersion.Protocol)
+	require.Error(t, err)
+
+	_, err = PingStatus(ctx, "no-port", version.MaximumVersion.Protocol)
+	require.Error(t, err)
+}
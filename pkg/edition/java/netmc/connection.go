@@ -175,6 +175,7 @@ func NewMinecraftConn(
 		direction:     direction,
 		autoReading:   newStateControl(true),
 		packetLimiter: packetLimiter,
+		metrics:       newConnMetrics(direction),
 	}
 	c.sessionHandlerMu.sessionHandlers = make(map[*state.Registry]SessionHandler)
 	return c, c.startReadLoop
@@ -192,6 +193,7 @@ type minecraftConn struct {
 
 	autoReading   *stateControl          // Whether the connection should automatically read packets from the underlying connection.
 	packetLimiter *packetlimiter.Limiter // Per-connection serverbound rate limiter; nil disables it (e.g. backend connections).
+	metrics       connMetrics            // Attributes of the connection's I/O metrics.
 
 	ctx             context.Context // is canceled when connection closed
 	cancelCtx       context.CancelFunc
@@ -247,10 +249,13 @@ func (c *minecraftConn) startReadLoop() {
 			return false
 		}
 		bytesRead += int64(packetCtx.BytesRead)
+		ioBytes.Add(ctx, int64(packetCtx.BytesRead), c.metrics.receive)
+		ioPackets.Add(ctx, 1, c.metrics.receive)
 
 		// Enforce the per-connection serverbound packet rate limit (nil/disabled
 		// for backend connections and when not configured).
 		if !c.packetLimiter.Account(packetCtx.BytesRead) {
+			packetLimiterRejections.Add(ctx, 1)
 			c.log.Info("serverbound packet rate limit exceeded, closing connection",
 				"remoteAddr", c.c.RemoteAddr())
 			return false
@@ -332,10 +337,12 @@ func (c *minecraftConn) Write(payload []byte) (err error) {
 	if Closed(c) {
 		return ErrClosedConn
 	}
-	if _, err = c.wr.Write(payload); err != nil {
+	n, err := c.wr.Write(payload)
+	if err != nil {
 		c.closeOnWriteErr(err, "writePayloadLen", len(payload))
 		return err
 	}
+	c.recordTransmit(n)
 	return c.Flush()
 }
 
@@ -371,10 +378,19 @@ func (c *minecraftConn) bufferPacket(packet proto.Packet, canQueue bool) (err er
 			return nil
 		}
 	}
-	_, err = c.wr.WritePacket(packet)
+	n, err := c.wr.WritePacket(packet)
+	if err == nil {
+		c.recordTransmit(n)
+	}
 	return err
 }
 
+// recordTransmit records a packet of n bytes sent on the connection.
+func (c *minecraftConn) recordTransmit(n int) {
+	ioBytes.Add(c.ctx, int64(n), c.metrics.transmit)
+	ioPackets.Add(c.ctx, 1, c.metrics.transmit)
+}
+
 func (c *minecraftConn) BufferPayload(payload []byte) (err error) {
 	if Closed(c) {
 		return ErrClosedConn
@@ -384,7 +400,10 @@ func (c *minecraftConn) BufferPayload(payload []byte) (err error) {
 			c.closeOnWriteErr(err, "bufferPayloadLen", len(payload))
 		}
 	}()
-	_, err = c.wr.Write(payload)
+	n, err := c.wr.Write(payload)
+	if err == nil {
+		c.recordTransmit(n)
+	}
 	return err
 }
 
@@ -0,0 +1,54 @@
+package netmc
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+var meter = otel.Meter("netmc")
+
+// Instruments fall back to no-ops if they could not be created.
+var (
+	ioBytes, _ = meter.Int64Counter(
+		"gate.connection.io.bytes",
+		metric.WithDescription("The packet bytes received and sent on Minecraft connections"),
+		metric.WithUnit("By"),
+	)
+	ioPackets, _ = meter.Int64Counter(
+		"gate.connection.io.packets",
+		metric.WithDescription("The packets received and sent on Minecraft connections"),
+		metric.WithUnit("{packet}"),
+	)
+	packetLimiterRejections, _ = meter.Int64Counter(
+		"gate.packet_limiter.rejections",
+		metric.WithDescription("The connections closed for exceeding the serverbound packet rate limit"),
+		metric.WithUnit("{connection}"),
+	)
+)
+
+// connMetrics are the precomputed measurement attributes of a connection.
+type connMetrics struct {
+	receive, transmit metric.MeasurementOption
+}
+
+// newConnMetrics returns the connMetrics for a connection of the given direction.
+// The peer attribute is "client" for player and "server" for backend connections.
+func newConnMetrics(direction proto.Direction) connMetrics {
+	peer := "client"
+	if direction == proto.ClientBound {
+		peer = "server"
+	}
+	set := func(ioDirection string) metric.MeasurementOption {
+		return metric.WithAttributeSet(attribute.NewSet(
+			attribute.String("gate.connection.peer", peer),
+			attribute.String("network.io.direction", ioDirection),
+		))
+	}
+	return connMetrics{
+		receive:  set("receive"),
+		transmit: set("transmit"),
+	}
+}
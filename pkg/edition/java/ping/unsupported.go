@@ -0,0 +1,68 @@
+package ping
+
+import (
+	"fmt"
+
+	protoversion "go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/configutil"
+)
+
+// UnsupportedVersion configures the status response for clients with a version
+// outside a supported range. The client shows such a response as outdated.
+type UnsupportedVersion struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// The lowest and highest supported versions, e.g. "1.20.5".
+	// Default to the lowest and highest versions supported by Gate.
+	MinVersion string `yaml:"minVersion,omitempty" json:"minVersion,omitempty"`
+	MaxVersion string `yaml:"maxVersion,omitempty" json:"maxVersion,omitempty"`
+	// The version name shown by the client instead of the player count.
+	// Defaults to the supported range, e.g. "1.20.5-1.21.4".
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// The MOTD shown to unsupported clients, optional.
+	Motd *configutil.Component `yaml:"motd,omitempty" json:"motd,omitempty"`
+}
+
+// Range returns the lowest and highest supported version.
+func (u *UnsupportedVersion) Range() (lowest, highest *proto.Version, err error) {
+	lowest, highest = protoversion.MinimumVersion, protoversion.MaximumVersion
+	if u.MinVersion != "" {
+		var ok bool
+		if lowest, ok = protoversion.ByName(u.MinVersion); !ok {
+			return nil, nil, fmt.Errorf("unknown min version %q", u.MinVersion)
+		}
+	}
+	if u.MaxVersion != "" {
+		var ok bool
+		if highest, ok = protoversion.ByName(u.MaxVersion); !ok {
+			return nil, nil, fmt.Errorf("unknown max version %q", u.MaxVersion)
+		}
+	}
+	if lowest.Protocol > highest.Protocol {
+		return nil, nil, fmt.Errorf("min version %s must not be higher than max version %s", lowest, highest)
+	}
+	return lowest, highest, nil
+}
+
+// Apply sets the version of the status response to the supported range and the
+// configured MOTD if enabled and the protocol is outside the supported range.
+// Returns false if the response was not modified.
+func (u *UnsupportedVersion) Apply(p *ServerPing, protocol proto.Protocol) bool {
+	if !u.Enabled {
+		return false
+	}
+	lowest, highest, err := u.Range()
+	if err != nil || (protocol >= lowest.Protocol && protocol <= highest.Protocol) {
+		return false
+	}
+	// The client compares the protocol with its own to tell whether it or the server is outdated
+	p.Version.Protocol = highest.Protocol
+	p.Version.Name = u.Name
+	if p.Version.Name == "" {
+		p.Version.Name = fmt.Sprintf("%s-%s", lowest.FirstName(), highest.LastName())
+	}
+	if u.Motd != nil {
+		p.Description = u.Motd.C()
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package ping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/util/configutil"
+)
+
+func TestUnsupportedVersion_Apply(t *testing.T) {
+	u := &UnsupportedVersion{
+		Enabled:    true,
+		MinVersion: "1.20.5",
+		MaxVersion: "1.21.4",
+		Motd:       &configutil.Component{Value: &component.Text{Content: "Please update"}},
+	}
+
+	p := &ServerPing{Version: Version{Protocol: version.Minecraft_1_21.Protocol, Name: "Gate"}}
+	require.False(t, u.Apply(p, version.Minecraft_1_21.Protocol))
+	require.Equal(t, "Gate", p.Version.Name)
+
+	require.True(t, u.Apply(p, version.Minecraft_1_8.Protocol))
+	require.Equal(t, version.Minecraft_1_21_4.Protocol, p.Version.Protocol)
+	require.Equal(t, "1.20.5-1.21.4", p.Version.Name)
+	require.Equal(t, "Please update", p.Description.(*component.Text).Content)
+
+	u.Name = "§cRequires 1.20.5+"
+	require.True(t, u.Apply(p, version.Minecraft_1_21_5.Protocol))
+	require.Equal(t, "§cRequires 1.20.5+", p.Version.Name)
+
+	u.Enabled = false
+	require.False(t, u.Apply(p, version.Minecraft_1_8.Protocol))
+}
+
+func TestUnsupportedVersion_Range(t *testing.T) {
+	lowest, highest, err := (&UnsupportedVersion{}).Range()
+	require.NoError(t, err)
+	require.Equal(t, version.MinimumVersion, lowest)
+	require.Equal(t, version.MaximumVersion, highest)
+
+	_, _, err = (&UnsupportedVersion{MinVersion: "1.0"}).Range()
+	require.Error(t, err)
+	_, _, err = (&UnsupportedVersion{MinVersion: "1.21", MaxVersion: "1.20"}).Range()
+	require.Error(t, err)
+}
@@ -1,12 +1,24 @@
 package packet
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"io"
 
+	"github.com/Tnze/go-mc/nbt"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
 	"go.minekube.com/gate/pkg/gate/proto"
 )
 
+// MaxCustomClickActionPayload is the maximum size of a custom click action payload in bytes.
+const MaxCustomClickActionPayload = 65536
+
 // CustomClickActionPacket is sent by the client when clicking on a custom action.
+//
+// The data is kept as is to forward it unmodified, use Parse to read its contents.
 type CustomClickActionPacket struct {
 	Data []byte
 }
@@ -27,3 +39,55 @@ func (s *CustomClickActionPacket) Decode(ctx *proto.PacketContext, rd io.Reader)
 	s.Data = data
 	return nil
 }
+
+// Parse reads the action ID and the optional payload from the packet data.
+// The payload is nil if the client sent none.
+func (s *CustomClickActionPacket) Parse(protocol proto.Protocol) (id key.Key, payload *nbt.RawMessage, err error) {
+	rd := bytes.NewReader(s.Data)
+	id, err = util.ReadKey(rd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading custom click action id: %w", err)
+	}
+	length, err := util.ReadVarInt(rd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading custom click action payload length: %w", err)
+	}
+	if length < 0 || length > MaxCustomClickActionPayload {
+		return nil, nil, fmt.Errorf("custom click action payload too large: %d bytes", length)
+	}
+	if length == 0 {
+		return id, nil, nil
+	}
+	data := make([]byte, length)
+	if _, err = io.ReadFull(rd, data); err != nil {
+		return nil, nil, fmt.Errorf("error reading custom click action payload: %w", err)
+	}
+	if data[0] == nbt.TagEnd {
+		return id, nil, nil
+	}
+	tag, err := util.ReadBinaryTag(bytes.NewReader(data), protocol)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading custom click action payload: %w", err)
+	}
+	return id, &tag, nil
+}
+
+// NewCustomClickAction creates a custom click action packet with the optional payload.
+func NewCustomClickAction(protocol proto.Protocol, id key.Key, payload *nbt.RawMessage) (*CustomClickActionPacket, error) {
+	buf := new(bytes.Buffer)
+	if err := util.WriteKey(buf, id); err != nil {
+		return nil, err
+	}
+	tag := new(bytes.Buffer)
+	if payload == nil {
+		tag.WriteByte(nbt.TagEnd)
+	} else if err := util.WriteBinaryTag(tag, protocol, *payload); err != nil {
+		return nil, err
+	}
+	if tag.Len() > MaxCustomClickActionPayload {
+		return nil, errors.New("custom click action payload too large")
+	}
+	util.PWriteVarInt(buf, tag.Len())
+	buf.Write(tag.Bytes())
+	return &CustomClickActionPacket{Data: buf.Bytes()}, nil
+}
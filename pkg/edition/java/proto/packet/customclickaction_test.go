@@ -0,0 +1,35 @@
+package packet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/proto/nbtconv"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+)
+
+func TestCustomClickActionPacket_Parse(t *testing.T) {
+	protocol := version.Minecraft_1_21_6.Protocol
+	payload, err := nbtconv.SnbtToBinaryTag(`{action:"yes",name:"Steve"}`)
+	require.NoError(t, err)
+
+	p, err := NewCustomClickAction(protocol, key.New("test", "report"), &payload)
+	require.NoError(t, err)
+	id, got, err := p.Parse(protocol)
+	require.NoError(t, err)
+	require.Equal(t, "test:report", id.String())
+	require.NotNil(t, got)
+	require.Equal(t, payload, *got)
+
+	p, err = NewCustomClickAction(protocol, key.New("test", "empty"), nil)
+	require.NoError(t, err)
+	id, got, err = p.Parse(protocol)
+	require.NoError(t, err)
+	require.Equal(t, "test:empty", id.String())
+	require.Nil(t, got)
+
+	_, _, err = (&CustomClickActionPacket{Data: p.Data[:3]}).Parse(protocol)
+	require.Error(t, err)
+}
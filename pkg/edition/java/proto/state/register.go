@@ -315,6 +315,10 @@ func init() {
 		m(0x14, version.Minecraft_1_21_6),
 		m(0x15, version.Minecraft_26_1),
 	)
+	Play.ServerBound.Register(&p.CustomClickActionPacket{},
+		m(0x41, version.Minecraft_1_21_6),
+		m(0x42, version.Minecraft_26_1),
+	)
 
 	Play.ClientBound.Register(&p.KeepAlive{},
 		m(0x00, version.Minecraft_1_7_2),
@@ -684,6 +688,16 @@ func init() {
 		m(0x87, version.Minecraft_1_21_9),
 		m(0x89, version.Minecraft_26_1),
 	)
+	Play.ClientBound.Register(&p.DialogClear{},
+		m(0x84, version.Minecraft_1_21_6),
+		m(0x89, version.Minecraft_1_21_9),
+		m(0x8B, version.Minecraft_26_1),
+	)
+	Play.ClientBound.Register(&p.DialogShow{},
+		m(0x85, version.Minecraft_1_21_6),
+		m(0x8A, version.Minecraft_1_21_9),
+		m(0x8C, version.Minecraft_26_1),
+	)
 	Play.ClientBound.Register(&p.SoundEntityPacket{},
 		m(0x5D, version.Minecraft_1_19_3),
 		m(0x61, version.Minecraft_1_19_4),
@@ -71,6 +71,7 @@ func TestPacketIDs_26_1(t *testing.T) {
 		{"ResourcePackResponse", &p.ResourcePackResponse{}, 0x31},
 		{"FinishedUpdate", &config.FinishedUpdate{}, 0x10},
 		{"CookieResponse", &cookie.CookieResponse{}, 0x15},
+		{"CustomClickAction", &p.CustomClickActionPacket{}, 0x42},
 	}
 	for _, tc := range sbTests {
 		t.Run("ServerBound/"+tc.name, func(t *testing.T) {
@@ -102,6 +103,8 @@ func TestPacketIDs_26_1(t *testing.T) {
 		{"Transfer", &p.Transfer{}, 0x81},
 		{"CustomReportDetails", &p.CustomReportDetails{}, 0x88},
 		{"ServerLinks", &p.ServerLinks{}, 0x89},
+		{"DialogClear", &p.DialogClear{}, 0x8B},
+		{"DialogShow", &p.DialogShow{}, 0x8C},
 		{"SoundEntity", &p.SoundEntityPacket{}, 0x74},
 		{"StopSound", &p.StopSoundPacket{}, 0x77},
 		{"CookieStore", &cookie.CookieStore{}, 0x78},
@@ -105,6 +105,18 @@ var (
 	SupportedVersionsString = fmt.Sprintf("%s-%s", MinimumVersion, MaximumVersion)
 )
 
+// ByName returns the supported version with the name, e.g. "1.21.4".
+func ByName(name string) (*proto.Version, bool) {
+	for _, ver := range SupportedVersions {
+		for _, n := range ver.Names {
+			if n == name {
+				return ver, true
+			}
+		}
+	}
+	return nil, false
+}
+
 // Protocol is proto.Protocol with additional methods for Java edition.
 type Protocol proto.Protocol
 
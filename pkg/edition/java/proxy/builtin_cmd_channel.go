@@ -0,0 +1,156 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+
+	"go.minekube.com/brigodier"
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/command/suggest"
+	"go.minekube.com/gate/pkg/edition/java/config"
+)
+
+const (
+	channelCmdName    = "channel"
+	channelNameArg    = "name"
+	channelMessageArg = "message"
+)
+
+// command to list chat channels and switch the default channel
+func newChannelCmd(cc *ChatChannels) brigodier.LiteralNodeBuilder {
+	return brigodier.Literal(channelCmdName).
+		Requires(command.Requires(func(c *command.RequiresContext) bool {
+			return cc.config().Enabled
+		})).
+		Executes(command.Command(func(c *command.Context) error {
+			return c.Source.SendMessage(chatChannelsInfo(cc, c.Source))
+		})).
+		Then(brigodier.Argument(channelNameArg, brigodier.String).
+			Suggests(chatChannelSuggestionProvider(cc)).
+			Executes(command.Command(func(c *command.Context) error {
+				player, ok := c.Source.(Player)
+				if !ok {
+					return c.Source.SendMessage(&Text{S: Style{Color: Red},
+						Content: "Only players can switch chat channels!"})
+				}
+				return switchChatChannel(cc, c, player, c.String(channelNameArg))
+			})),
+		)
+}
+
+// command to send to a chat channel or toggle it as default channel
+func newChannelSendCmd(cc *ChatChannels, name string) brigodier.LiteralNodeBuilder {
+	return brigodier.Literal(name).
+		Requires(command.Requires(func(c *command.RequiresContext) bool {
+			_, ch, ok := cc.channelByCommand(name)
+			if !ok {
+				return false // removed from config
+			}
+			player, isPlayer := c.Source.(Player)
+			return !isPlayer || canSpeakChatChannel(player, ch)
+		})).
+		Executes(command.Command(func(c *command.Context) error {
+			player, ok := c.Source.(Player)
+			if !ok {
+				return c.Source.SendMessage(&Text{S: Style{Color: Red},
+					Content: "Only players can switch chat channels!"})
+			}
+			channel, _, ok := cc.channelByCommand(name)
+			if !ok {
+				return nil
+			}
+			if cc.DefaultChannel(player) == channel {
+				channel = config.ServerChatChannel
+			}
+			return switchChatChannel(cc, c, player, channel)
+		})).
+		Then(brigodier.Argument(channelMessageArg, brigodier.StringPhrase).
+			Executes(command.Command(func(c *command.Context) error {
+				channel, _, ok := cc.channelByCommand(name)
+				if !ok {
+					return nil
+				}
+				_, err := cc.Send(channel, c.Source, c.String(channelMessageArg))
+				return chatChannelCmdErr(c, err)
+			})),
+		)
+}
+
+func switchChatChannel(cc *ChatChannels, c *command.Context, player Player, channel string) error {
+	if err := cc.SetDefaultChannel(player, channel); err != nil {
+		return chatChannelCmdErr(c, err)
+	}
+	return c.Source.SendMessage(&Text{S: Style{Color: Green},
+		Content: fmt.Sprintf("You are now chatting in %s.", chatChannelDisplayName(channel))})
+}
+
+func chatChannelCmdErr(c *command.Context, err error) error {
+	var msg string
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrChatChannelNotFound):
+		msg = "This chat channel doesn't exist."
+	case errors.Is(err, ErrChatChannelPermission):
+		msg = "You don't have permission to chat in this channel."
+	default:
+		msg = "Chat channels are disabled."
+	}
+	return c.Source.SendMessage(&Text{S: Style{Color: Red}, Content: msg})
+}
+
+func chatChannelDisplayName(channel string) string {
+	if channel == config.ServerChatChannel {
+		return "the server chat"
+	}
+	return "channel " + channel
+}
+
+// chatChannelsInfo lists the channels the source can join.
+func chatChannelsInfo(cc *ChatChannels, s command.Source) Component {
+	player, isPlayer := s.(Player)
+	current := ""
+	if isPlayer {
+		current = cc.DefaultChannel(player)
+	}
+	info := &Text{Content: "Chat channels:", S: Style{Color: Yellow}}
+	for _, name := range append(cc.Channels(), config.ServerChatChannel) {
+		if ch, ok := cc.Channel(name); ok && isPlayer && !canJoinChatChannel(player, ch) {
+			continue
+		}
+		entry := &Text{Content: "\n  " + name, S: Style{Color: Gray}}
+		if ch, ok := cc.Channel(name); ok {
+			if ch.Prefix != "" {
+				entry.Extra = append(entry.Extra, &Text{Content: fmt.Sprintf(" (%s)", ch.Prefix), S: Style{Color: DarkGray}})
+			}
+			if ch.Command != "" {
+				entry.Extra = append(entry.Extra, &Text{Content: fmt.Sprintf(" /%s", ch.Command), S: Style{Color: DarkGray}})
+			}
+		}
+		if name == current {
+			entry.S.Color = Green
+			entry.Extra = append(entry.Extra, &Text{Content: " - current", S: Style{Color: Green}})
+		}
+		info.Extra = append(info.Extra, entry)
+	}
+	return info
+}
+
+// chatChannelSuggestionProvider suggests the channels the source can speak in.
+func chatChannelSuggestionProvider(cc *ChatChannels) brigodier.SuggestionProvider {
+	return command.SuggestFunc(func(
+		c *command.Context,
+		b *brigodier.SuggestionsBuilder,
+	) *brigodier.Suggestions {
+		candidates := []string{config.ServerChatChannel}
+		player, isPlayer := c.Source.(Player)
+		for _, name := range cc.Channels() {
+			if ch, ok := cc.Channel(name); ok && (!isPlayer || canSpeakChatChannel(player, ch)) {
+				candidates = append(candidates, name)
+			}
+		}
+		return suggest.Similar(b, candidates).Build()
+	})
+}
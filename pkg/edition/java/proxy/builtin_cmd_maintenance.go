@@ -0,0 +1,80 @@
+package proxy
+
+import (
+	"fmt"
+	"strings"
+
+	"go.minekube.com/brigodier"
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/command"
+)
+
+const maintenanceCmdPermission = "gate.command.maintenance"
+
+// command to show and toggle maintenance of the network and servers
+func newMaintenanceCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	const serverArg = "server"
+	toggle := func(enabled bool) brigodier.LiteralNodeBuilder {
+		name := "off"
+		if enabled {
+			name = "on"
+		}
+		return brigodier.Literal(name)
+	}
+	setGlobal := func(enabled bool) brigodier.LiteralNodeBuilder {
+		return toggle(enabled).Executes(command.Command(func(c *command.Context) error {
+			kicked := proxy.Maintenance().SetEnabled(enabled)
+			return c.Source.SendMessage(&Text{S: Style{Color: Green},
+				Content: fmt.Sprintf("Maintenance %s, kicked %d players.", onOff(enabled), kicked)})
+		}))
+	}
+	setServer := func(enabled bool) brigodier.LiteralNodeBuilder {
+		return toggle(enabled).Executes(command.Command(func(c *command.Context) error {
+			name := c.String(serverArg)
+			if err := proxy.Maintenance().SetServerEnabled(name, enabled); err != nil {
+				return c.Source.SendMessage(&Text{S: Style{Color: Red},
+					Content: fmt.Sprintf("Server %q doesn't exist.", name)})
+			}
+			return c.Source.SendMessage(&Text{S: Style{Color: Green},
+				Content: fmt.Sprintf("Maintenance of server %s %s.", name, onOff(enabled))})
+		}))
+	}
+	return brigodier.Literal("maintenance").
+		Requires(command.Requires(func(c *command.RequiresContext) bool {
+			return c.Source.HasPermission(maintenanceCmdPermission)
+		})).
+		Executes(command.Command(func(c *command.Context) error {
+			return c.Source.SendMessage(maintenanceStatus(proxy.Maintenance()))
+		})).
+		Then(setGlobal(true)).
+		Then(setGlobal(false)).
+		Then(brigodier.Literal("server").
+			Then(brigodier.Argument(serverArg, brigodier.String).
+				Suggests(serverSuggestionProvider(proxy)).
+				Then(setServer(true)).
+				Then(setServer(false)),
+			),
+		)
+}
+
+func maintenanceStatus(m *Maintenance) Component {
+	servers := "none"
+	if s := m.Servers(); len(s) != 0 {
+		servers = strings.Join(s, ", ")
+	}
+	return &Text{
+		S:       Style{Color: Yellow},
+		Content: fmt.Sprintf("Maintenance is %s.", onOff(m.Enabled())),
+		Extra: []Component{
+			&Text{S: Style{Color: Gray}, Content: "\nServers under maintenance: " + servers},
+		},
+	}
+}
+
+func onOff(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
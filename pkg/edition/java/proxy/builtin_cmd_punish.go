@@ -0,0 +1,215 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.minekube.com/brigodier"
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/command/suggest"
+	"go.minekube.com/gate/pkg/util/punishment"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+const (
+	banCmdPermission     = "gate.command.ban"
+	tempbanCmdPermission = "gate.command.tempban"
+	unbanCmdPermission   = "gate.command.unban"
+	muteCmdPermission    = "gate.command.mute"
+	unmuteCmdPermission  = "gate.command.unmute"
+)
+
+const (
+	punishTargetArg   = "target"
+	punishDurationArg = "duration"
+	punishReasonArg   = "reason"
+)
+
+// hasPunishCmdPerm requires punishments to be enabled and the permission,
+// regardless of config.RequireBuiltinCommandPermissions.
+func hasPunishCmdPerm(proxy *Proxy, perm string) brigodier.RequireFn {
+	return command.Requires(func(c *command.RequiresContext) bool {
+		return proxy.config().Punishments.Enabled && c.Source.HasPermission(perm)
+	})
+}
+
+// command to ban a player, username or IP address/range
+func newBanCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	return brigodier.Literal("ban").
+		Requires(hasPunishCmdPerm(proxy, banCmdPermission)).
+		Then(brigodier.Argument(punishTargetArg, brigodier.String).
+			Suggests(playerSuggestionProvider(proxy)).
+			Executes(command.Command(func(c *command.Context) error {
+				return punishFromCmd(proxy, c, punishment.Ban, 0, "")
+			})).
+			Then(brigodier.Argument(punishReasonArg, brigodier.StringPhrase).
+				Executes(command.Command(func(c *command.Context) error {
+					return punishFromCmd(proxy, c, punishment.Ban, 0, c.String(punishReasonArg))
+				})),
+			),
+		)
+}
+
+// command to temporarily ban a player, username or IP address/range
+func newTempbanCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	punish := func(c *command.Context, reason string) error {
+		d, err := punishment.ParseDuration(c.String(punishDurationArg))
+		if err != nil || d <= 0 {
+			return c.Source.SendMessage(&Text{S: Style{Color: Red},
+				Content: fmt.Sprintf("Invalid duration %q, use e.g. 30m, 12h, 7d or 1w2d.", c.String(punishDurationArg))})
+		}
+		return punishFromCmd(proxy, c, punishment.Ban, d, reason)
+	}
+	return brigodier.Literal("tempban").
+		Requires(hasPunishCmdPerm(proxy, tempbanCmdPermission)).
+		Then(brigodier.Argument(punishTargetArg, brigodier.String).
+			Suggests(playerSuggestionProvider(proxy)).
+			Then(brigodier.Argument(punishDurationArg, brigodier.String).
+				Executes(command.Command(func(c *command.Context) error {
+					return punish(c, "")
+				})).
+				Then(brigodier.Argument(punishReasonArg, brigodier.StringPhrase).
+					Executes(command.Command(func(c *command.Context) error {
+						return punish(c, c.String(punishReasonArg))
+					})),
+				),
+			),
+		)
+}
+
+// command to mute a player
+func newMuteCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	return brigodier.Literal("mute").
+		Requires(hasPunishCmdPerm(proxy, muteCmdPermission)).
+		Then(brigodier.Argument(punishTargetArg, brigodier.String).
+			Suggests(playerSuggestionProvider(proxy)).
+			Executes(command.Command(func(c *command.Context) error {
+				return punishFromCmd(proxy, c, punishment.Mute, 0, "")
+			})).
+			Then(brigodier.Argument(punishReasonArg, brigodier.StringPhrase).
+				Executes(command.Command(func(c *command.Context) error {
+					return punishFromCmd(proxy, c, punishment.Mute, 0, c.String(punishReasonArg))
+				})),
+			),
+		)
+}
+
+// command to revoke the bans of a player, username or IP address/range
+func newUnbanCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	return newRevokeCmd(proxy, "unban", unbanCmdPermission, punishment.Ban)
+}
+
+// command to revoke the mutes of a player
+func newUnmuteCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	return newRevokeCmd(proxy, "unmute", unmuteCmdPermission, punishment.Mute)
+}
+
+func newRevokeCmd(proxy *Proxy, name, perm string, typ punishment.Type) brigodier.LiteralNodeBuilder {
+	return brigodier.Literal(name).
+		Requires(hasPunishCmdPerm(proxy, perm)).
+		Then(brigodier.Argument(punishTargetArg, brigodier.String).
+			Suggests(punishedSuggestionProvider(proxy, typ)).
+			Executes(command.Command(func(c *command.Context) error {
+				target := c.String(punishTargetArg)
+				removed, err := proxy.Punishments().Revoke(typ, target)
+				if err != nil {
+					return punishCmdErr(proxy, c, err)
+				}
+				if len(removed) == 0 {
+					return c.Source.SendMessage(&Text{S: Style{Color: Red},
+						Content: fmt.Sprintf("%s is not %s.", target, punishedVerb(typ))})
+				}
+				return c.Source.SendMessage(&Text{S: Style{Color: Green},
+					Content: fmt.Sprintf("%s is no longer %s.", target, punishedVerb(typ))})
+			})),
+		)
+}
+
+// punishFromCmd punishes the command's target for duration d, 0 for permanent.
+func punishFromCmd(proxy *Proxy, c *command.Context, typ punishment.Type, d time.Duration, reason string) error {
+	target := c.String(punishTargetArg)
+	p := resolvePunishmentTarget(proxy, target)
+	if typ == punishment.Mute && p.IP != "" {
+		return c.Source.SendMessage(&Text{S: Style{Color: Red},
+			Content: "Only players can be muted."})
+	}
+	p.Type = typ
+	p.Reason = reason
+	p.Issuer = "console"
+	if player, ok := c.Source.(Player); ok {
+		p.Issuer = player.Username()
+	}
+	if d > 0 {
+		p.Expires = time.Now().Add(d)
+	}
+
+	p, err := proxy.Punishments().Punish(p)
+	if err != nil {
+		return punishCmdErr(proxy, c, err)
+	}
+	duration := "permanently"
+	if d > 0 {
+		duration = "for " + punishment.FormatDuration(d)
+	}
+	return c.Source.SendMessage(&Text{S: Style{Color: Green},
+		Content: fmt.Sprintf("%s is now %s %s.", target, punishedVerb(typ), duration)})
+}
+
+// resolvePunishmentTarget returns the punishment targeting the IP address/range,
+// the player UUID, the online player or username.
+func resolvePunishmentTarget(proxy *Proxy, target string) punishment.Punishment {
+	if _, err := punishment.ParseIP(target); err == nil {
+		return punishment.Punishment{IP: target}
+	}
+	if id, err := uuid.Parse(target); err == nil {
+		return punishment.Punishment{Player: id.String()}
+	}
+	if player := proxy.PlayerByName(target); player != nil {
+		return punishment.Punishment{Player: player.ID().String(), Name: player.Username()}
+	}
+	return punishment.Punishment{Name: target}
+}
+
+func punishCmdErr(proxy *Proxy, c *command.Context, err error) error {
+	msg := "Punishments are disabled."
+	if !errors.Is(err, ErrPunishmentsDisabled) {
+		proxy.log.Error(err, "error modifying punishments")
+		msg = "Could not modify punishments, check the logs."
+	}
+	return c.Source.SendMessage(&Text{S: Style{Color: Red}, Content: msg})
+}
+
+func punishedVerb(typ punishment.Type) string {
+	if typ == punishment.Mute {
+		return "muted"
+	}
+	return "banned"
+}
+
+// punishedSuggestionProvider suggests the usernames and IPs of the active punishments of the type.
+func punishedSuggestionProvider(proxy *Proxy, typ punishment.Type) brigodier.SuggestionProvider {
+	return command.SuggestFunc(func(
+		_ *command.Context,
+		b *brigodier.SuggestionsBuilder,
+	) *brigodier.Suggestions {
+		store := proxy.Punishments().Store()
+		if store == nil {
+			return b.Build()
+		}
+		var candidates []string
+		for _, p := range store.List(typ) {
+			switch {
+			case p.Name != "":
+				candidates = append(candidates, p.Name)
+			case p.IP != "":
+				candidates = append(candidates, p.IP)
+			default:
+				candidates = append(candidates, p.Player)
+			}
+		}
+		return suggest.Similar(b, candidates).Build()
+	})
+}
@@ -0,0 +1,108 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.minekube.com/brigodier"
+	. "go.minekube.com/common/minecraft/color"
+	. "go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/command/suggest"
+	"go.minekube.com/gate/pkg/util/whitelist"
+)
+
+const whitelistCmdPermission = "gate.command.whitelist"
+
+// command to add, remove and list whitelisted players
+func newWhitelistCmd(proxy *Proxy) brigodier.LiteralNodeBuilder {
+	const playerArg = "player"
+	return brigodier.Literal("whitelist").
+		Requires(command.Requires(func(c *command.RequiresContext) bool {
+			return proxy.config().Whitelist.Enabled && c.Source.HasPermission(whitelistCmdPermission)
+		})).
+		Then(brigodier.Literal("add").
+			Then(brigodier.Argument(playerArg, brigodier.String).
+				Suggests(playerSuggestionProvider(proxy)).
+				Executes(command.Command(func(c *command.Context) error {
+					entry, err := proxy.Whitelist().Add(c.String(playerArg))
+					if err != nil {
+						return whitelistCmdErr(proxy, c, err)
+					}
+					return c.Source.SendMessage(&Text{S: Style{Color: Green},
+						Content: fmt.Sprintf("Added %s to the whitelist.", whitelistEntryName(entry))})
+				})),
+			),
+		).
+		Then(brigodier.Literal("remove").
+			Then(brigodier.Argument(playerArg, brigodier.String).
+				Suggests(whitelistedSuggestionProvider(proxy)).
+				Executes(command.Command(func(c *command.Context) error {
+					player := c.String(playerArg)
+					removed, err := proxy.Whitelist().Remove(player)
+					if err != nil {
+						return whitelistCmdErr(proxy, c, err)
+					}
+					if len(removed) == 0 {
+						return c.Source.SendMessage(&Text{S: Style{Color: Red},
+							Content: fmt.Sprintf("%s is not whitelisted.", player)})
+					}
+					return c.Source.SendMessage(&Text{S: Style{Color: Green},
+						Content: fmt.Sprintf("Removed %s from the whitelist.", player)})
+				})),
+			),
+		).
+		Then(brigodier.Literal("list").
+			Executes(command.Command(func(c *command.Context) error {
+				store := proxy.Whitelist().Store()
+				if store == nil {
+					return whitelistCmdErr(proxy, c, ErrWhitelistDisabled)
+				}
+				list := store.List()
+				names := make([]string, 0, len(list))
+				for _, e := range list {
+					names = append(names, whitelistEntryName(e))
+				}
+				return c.Source.SendMessage(&Text{
+					S:       Style{Color: Yellow},
+					Content: fmt.Sprintf("%d whitelisted players: ", len(names)),
+					Extra:   []Component{&Text{S: Style{Color: White}, Content: strings.Join(names, ", ")}},
+				})
+			})),
+		)
+}
+
+func whitelistEntryName(e whitelist.Entry) string {
+	if e.Name != "" {
+		return e.Name
+	}
+	return e.ID
+}
+
+func whitelistCmdErr(proxy *Proxy, c *command.Context, err error) error {
+	msg := "The whitelist is disabled."
+	if !errors.Is(err, ErrWhitelistDisabled) {
+		proxy.log.Error(err, "error modifying whitelist")
+		msg = "Could not modify the whitelist, check the logs."
+	}
+	return c.Source.SendMessage(&Text{S: Style{Color: Red}, Content: msg})
+}
+
+// whitelistedSuggestionProvider suggests the usernames of whitelisted players.
+func whitelistedSuggestionProvider(proxy *Proxy) brigodier.SuggestionProvider {
+	return command.SuggestFunc(func(
+		_ *command.Context,
+		b *brigodier.SuggestionsBuilder,
+	) *brigodier.Suggestions {
+		store := proxy.Whitelist().Store()
+		if store == nil {
+			return b.Build()
+		}
+		var candidates []string
+		for _, e := range store.List() {
+			candidates = append(candidates, whitelistEntryName(e))
+		}
+		return suggest.Similar(b, candidates).Build()
+	})
+}
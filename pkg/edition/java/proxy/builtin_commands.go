@@ -10,6 +10,13 @@ func (p *Proxy) registerBuiltinCommands() []string {
 		p.command.Register(newServerCmd(p)).Name(),
 		p.command.Register(newGlistCmd(p)).Name(),
 		p.command.Register(newSendCmd(p)).Name(),
+		p.command.Register(newBanCmd(p)).Name(),
+		p.command.Register(newTempbanCmd(p)).Name(),
+		p.command.Register(newUnbanCmd(p)).Name(),
+		p.command.Register(newMuteCmd(p)).Name(),
+		p.command.Register(newUnmuteCmd(p)).Name(),
+		p.command.Register(newWhitelistCmd(p)).Name(),
+		p.command.Register(newMaintenanceCmd(p)).Name(),
 	}
 }
 
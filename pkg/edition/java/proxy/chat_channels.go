@@ -0,0 +1,331 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/robinbraemer/event"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/componentutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+var (
+	// ErrChatChannelsDisabled is returned when using chat channels while they are disabled in the config.
+	ErrChatChannelsDisabled = errors.New("chat channels are disabled")
+	// ErrChatChannelNotFound is returned when using a chat channel that is not configured.
+	ErrChatChannelNotFound = errors.New("chat channel not found")
+	// ErrChatChannelPermission is returned when a player lacks the permission to use a chat channel.
+	ErrChatChannelPermission = errors.New("missing permission for chat channel")
+)
+
+// chatChannelConsoleName is the sender name of channel messages not sent by a player.
+const chatChannelConsoleName = "Console"
+
+// ChatChannels delivers chat messages to the members of proxy-level chat channels
+// on all servers instead of the sender's current server, see config.ChatChannels.
+//
+// Players are members of the channels they have the permission for.
+// Chat messages starting with the prefix of a channel are sent to that channel,
+// other messages to the player's default channel or the current server.
+type ChatChannels struct {
+	proxy *Proxy
+
+	mu         sync.Mutex
+	defaults   map[uuid.UUID]string // channels players switched to
+	registered map[string]bool      // registered channel commands
+}
+
+func newChatChannels(p *Proxy) *ChatChannels {
+	return &ChatChannels{
+		proxy:      p,
+		defaults:   map[uuid.UUID]string{},
+		registered: map[string]bool{},
+	}
+}
+
+// Channels returns the sorted names of the configured channels.
+// Returns nil if chat channels are disabled.
+func (cc *ChatChannels) Channels() []string {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(cfg.Channels))
+}
+
+// Channel returns the config of the channel.
+func (cc *ChatChannels) Channel(name string) (config.ChatChannel, bool) {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return config.ChatChannel{}, false
+	}
+	ch, ok := cfg.Channels[name]
+	return ch, ok
+}
+
+// Members returns the online players that can read the channel.
+func (cc *ChatChannels) Members(channel string) []Player {
+	ch, ok := cc.Channel(channel)
+	if !ok {
+		return nil
+	}
+	var members []Player
+	for _, player := range cc.proxy.Players() {
+		if canJoinChatChannel(player, ch) {
+			members = append(members, player)
+		}
+	}
+	return members
+}
+
+// DefaultChannel returns the channel the player chats in by default,
+// or config.ServerChatChannel for the chat of the player's current server.
+func (cc *ChatChannels) DefaultChannel(player Player) string {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return config.ServerChatChannel
+	}
+	cc.mu.Lock()
+	name, ok := cc.defaults[player.ID()]
+	cc.mu.Unlock()
+	if !ok {
+		name = cfg.Default
+	}
+	if ch, ok := cfg.Channels[name]; ok && canSpeakChatChannel(player, ch) {
+		return name
+	}
+	return config.ServerChatChannel
+}
+
+// SetDefaultChannel sets the channel the player chats in by default.
+// Use config.ServerChatChannel for the chat of the player's current server
+// or an empty name to reset to the configured default channel.
+func (cc *ChatChannels) SetDefaultChannel(player Player, channel string) error {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return ErrChatChannelsDisabled
+	}
+	if channel != "" && channel != config.ServerChatChannel {
+		ch, ok := cfg.Channels[channel]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrChatChannelNotFound, channel)
+		}
+		if !canSpeakChatChannel(player, ch) {
+			return fmt.Errorf("%w: %s", ErrChatChannelPermission, channel)
+		}
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if channel == "" {
+		delete(cc.defaults, player.ID())
+	} else {
+		cc.defaults[player.ID()] = channel
+	}
+	return nil
+}
+
+// Send sends the message to the members of the channel on all servers and
+// returns the number of recipients. The sender is the player or console sending
+// the message or nil if sent by the API, players must have the speak permission.
+//
+// A ChatChannelMessageEvent is fired before the message is sent.
+func (cc *ChatChannels) Send(channel string, sender command.Source, message string) (int, error) {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return 0, ErrChatChannelsDisabled
+	}
+	ch, ok := cfg.Channels[channel]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrChatChannelNotFound, channel)
+	}
+	if player, ok := sender.(Player); ok && !canSpeakChatChannel(player, ch) {
+		return 0, fmt.Errorf("%w: %s", ErrChatChannelPermission, channel)
+	}
+
+	e := &ChatChannelMessageEvent{channel: channel, source: sender, message: message}
+	cc.proxy.event.Fire(e)
+	if !e.Allowed() || e.Message() == "" {
+		return 0, nil
+	}
+
+	senderName, server := chatChannelConsoleName, ""
+	if player, ok := sender.(Player); ok {
+		senderName = player.Username()
+		if s := player.CurrentServer(); s != nil {
+			server = s.Server().ServerInfo().Name()
+		}
+	}
+	msg := formatChatChannelMessage(ch.Format, channel, senderName, server, e.Message())
+
+	var recipients int
+	for _, player := range cc.proxy.Players() {
+		if player.CurrentServer() == nil || !canJoinChatChannel(player, ch) {
+			continue // chat messages can only be sent in play state
+		}
+		if err := player.SendMessage(msg); err == nil {
+			recipients++
+		}
+	}
+	return recipients, nil
+}
+
+// route returns the channel and message a chat message of the player is sent to.
+// The channel with the longest matching prefix is used, otherwise the player's default channel.
+// Returns false if the message should be sent to the player's current server.
+func (cc *ChatChannels) route(player Player, message string) (channel, msg string, ok bool) {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return "", "", false
+	}
+	var prefix string
+	for name, ch := range cfg.Channels {
+		if ch.Prefix == "" || len(ch.Prefix) <= len(prefix) ||
+			!strings.HasPrefix(message, ch.Prefix) || !canSpeakChatChannel(player, ch) {
+			continue
+		}
+		channel, prefix = name, ch.Prefix
+	}
+	if channel != "" {
+		msg = strings.TrimSpace(strings.TrimPrefix(message, prefix))
+		return channel, msg, msg != ""
+	}
+	if channel = cc.DefaultChannel(player); channel != config.ServerChatChannel {
+		return channel, message, true
+	}
+	return "", "", false
+}
+
+// channelByCommand returns the name and config of the channel with the command.
+func (cc *ChatChannels) channelByCommand(cmd string) (string, config.ChatChannel, bool) {
+	cfg := cc.config()
+	if !cfg.Enabled {
+		return "", config.ChatChannel{}, false
+	}
+	for name, ch := range cfg.Channels {
+		if ch.Command == cmd {
+			return name, ch, true
+		}
+	}
+	return "", config.ChatChannel{}, false
+}
+
+func (cc *ChatChannels) config() config.ChatChannels {
+	return cc.proxy.config().ChatChannels
+}
+
+// run registers the channel commands and keeps in sync with config updates until ctx is canceled.
+func (cc *ChatChannels) run(ctx context.Context) {
+	defer reload.Subscribe(cc.proxy.event, func(e *javaConfigUpdateEvent) {
+		if e == nil || e.Config == nil {
+			return
+		}
+		cc.registerCommands(e.Config.ChatChannels)
+	})()
+	cc.registerCommands(cc.config())
+
+	defer event.Subscribe(cc.proxy.event, 0, func(e *DisconnectEvent) {
+		cc.mu.Lock()
+		delete(cc.defaults, e.Player().ID())
+		cc.mu.Unlock()
+	})()
+
+	<-ctx.Done()
+}
+
+// registerCommands registers the /channel command and the commands of the channels
+// and unregisters the commands of removed channels, or all if chat channels are disabled,
+// so that the server handles commands with the same name again.
+func (cc *ChatChannels) registerCommands(cfg config.ChatChannels) {
+	names := map[string]bool{}
+	if cfg.Enabled {
+		names[channelCmdName] = true
+		for _, ch := range cfg.Channels {
+			if ch.Command != "" {
+				names[ch.Command] = true
+			}
+		}
+	}
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for name := range cc.registered {
+		if !names[name] {
+			cc.proxy.command.Root.RemoveChild(name)
+			delete(cc.registered, name)
+		}
+	}
+	for name := range names {
+		if cc.registered[name] {
+			continue
+		}
+		cc.registered[name] = true
+		if name == channelCmdName {
+			cc.proxy.command.Register(newChannelCmd(cc))
+		} else {
+			cc.proxy.command.Register(newChannelSendCmd(cc, name))
+		}
+	}
+}
+
+func canJoinChatChannel(player Player, ch config.ChatChannel) bool {
+	return ch.Permission == "" || player.HasPermission(ch.Permission)
+}
+
+func canSpeakChatChannel(player Player, ch config.ChatChannel) bool {
+	perm := ch.SpeakPermission
+	if perm == "" {
+		perm = ch.Permission
+	}
+	return canJoinChatChannel(player, ch) && (perm == "" || player.HasPermission(perm))
+}
+
+// formatChatChannelMessage renders the format of a channel message.
+// The message is inserted as plain text so that players can't inject formatting
+// and inherits the style of its placeholder.
+func formatChatChannelMessage(format, channel, sender, server, message string) component.Component {
+	if format == "" {
+		format = config.DefaultChatChannelFormat
+	}
+	format = strings.NewReplacer(
+		"{channel}", channel,
+		"{player}", sender,
+		"{server}", server,
+	).Replace(format)
+	c, err := componentutil.ParseComponent(version.MaximumVersion.Protocol, format)
+	if err != nil {
+		c = &component.Text{Content: format}
+	}
+	if !insertChatChannelMessage(c, message) {
+		c = &component.Text{Extra: []component.Component{c, &component.Text{Content: " " + message}}}
+	}
+	return c
+}
+
+// insertChatChannelMessage replaces the first {message} placeholder in the text components.
+func insertChatChannelMessage(c component.Component, message string) bool {
+	t, ok := c.(*component.Text)
+	if !ok {
+		return false
+	}
+	if before, after, found := strings.Cut(t.Content, "{message}"); found {
+		t.Content = before
+		t.Extra = append([]component.Component{&component.Text{Content: message + after}}, t.Extra...)
+		return true
+	}
+	for _, extra := range t.Extra {
+		if insertChatChannelMessage(extra, message) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/edition/java/proxy/crypto"
+	"go.minekube.com/gate/pkg/edition/java/proxy/crypto/keyrevision"
+	"go.minekube.com/gate/pkg/util/netutil"
+)
+
+func newTestChatChannels(t *testing.T) *ChatChannels {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{"lobby": "localhost:25566"}, nil, nil)
+	proxy.cfg.ChatChannels = config.ChatChannels{
+		Enabled: true,
+		Channels: map[string]config.ChatChannel{
+			"global": {Prefix: "!"},
+			"staff":  {Prefix: "@", Command: "sc", Permission: "gate.chat.staff"},
+			"admin":  {Prefix: "@@", Permission: "gate.chat.admin"},
+		},
+	}
+	return newChatChannels(proxy)
+}
+
+func TestChatChannelsRoute(t *testing.T) {
+	cc := newTestChatChannels(t)
+	player := newTestQueuePlayer("alice", "gate.chat.staff", "gate.chat.admin")
+	guest := newTestQueuePlayer("bob")
+
+	tests := []struct {
+		player  Player
+		message string
+		channel string
+		msg     string
+		ok      bool
+	}{
+		{player, "hello", "", "", false},
+		{player, "! hello", "global", "hello", true},
+		{player, "@hello", "staff", "hello", true},
+		{player, "@@hello", "admin", "hello", true},
+		{player, "@", "", "", false},
+		{guest, "@hello", "", "", false},
+	}
+	for _, tt := range tests {
+		channel, msg, ok := cc.route(tt.player, tt.message)
+		require.Equal(t, tt.ok, ok, tt.message)
+		require.Equal(t, tt.channel, channel, tt.message)
+		require.Equal(t, tt.msg, msg, tt.message)
+	}
+
+	require.NoError(t, cc.SetDefaultChannel(player, "staff"))
+	channel, msg, ok := cc.route(player, "hello")
+	require.True(t, ok)
+	require.Equal(t, "staff", channel)
+	require.Equal(t, "hello", msg)
+
+	require.ErrorIs(t, cc.SetDefaultChannel(guest, "staff"), ErrChatChannelPermission)
+	require.ErrorIs(t, cc.SetDefaultChannel(guest, "party"), ErrChatChannelNotFound)
+	require.NoError(t, cc.SetDefaultChannel(player, config.ServerChatChannel))
+	require.Equal(t, config.ServerChatChannel, cc.DefaultChannel(player))
+}
+
+func TestChatChannelsMembers(t *testing.T) {
+	cc := newTestChatChannels(t)
+	require.Equal(t, []string{"admin", "global", "staff"}, cc.Channels())
+	require.Empty(t, cc.Members("party"))
+
+	cc.proxy.cfg.ChatChannels.Enabled = false
+	require.Nil(t, cc.Channels())
+	_, err := cc.Send("global", nil, "hello")
+	require.ErrorIs(t, err, ErrChatChannelsDisabled)
+}
+
+func TestChatChannelsRegisterCommands(t *testing.T) {
+	cc := newTestChatChannels(t)
+	cfg := cc.proxy.cfg.ChatChannels
+	cc.registerCommands(cfg)
+	require.True(t, cc.proxy.command.Has(channelCmdName))
+	require.True(t, cc.proxy.command.Has("sc"))
+
+	// Commands of removed channels are unregistered to not shadow the server's commands
+	cfg.Channels = map[string]config.ChatChannel{"global": {Prefix: "!", Command: "g"}}
+	cc.registerCommands(cfg)
+	require.False(t, cc.proxy.command.Has("sc"))
+	require.True(t, cc.proxy.command.Has("g"))
+
+	cfg.Enabled = false
+	cc.registerCommands(cfg)
+	require.False(t, cc.proxy.command.Has(channelCmdName))
+	require.False(t, cc.proxy.command.Has("g"))
+}
+
+func TestFormatChatChannelMessage(t *testing.T) {
+	c := formatChatChannelMessage("§c[{channel}] {player}: §e{message}!", "staff", "alice", "lobby", "§ahi {player}")
+	var content func(c component.Component) string
+	content = func(c component.Component) string {
+		t := c.(*component.Text)
+		s := t.Content
+		for _, e := range t.Extra {
+			s += content(e)
+		}
+		return s
+	}
+	require.Equal(t, "[staff] alice: §ahi {player}!", content(c))
+
+	c = formatChatChannelMessage("", "global", "Console", "", "hello")
+	require.Equal(t, "[global] Console: hello", content(c))
+}
+
+// linkedChatTestKey is the chat signing key of a 1.19.1 or 1.19.2 client.
+type linkedChatTestKey struct{ crypto.IdentifiedKey }
+
+func (linkedChatTestKey) KeyRevision() keyrevision.Revision { return keyrevision.LinkedV2 }
+
+func TestKeyedChatChannelRouting(t *testing.T) {
+	newHandler := func() (*chatHandler, *recordingConn) {
+		player, backend, h := newChatCmdFixture(version.Minecraft_1_19_1.Protocol, func(*CommandExecuteEvent) {})
+		fixture := newTestQueuePlayer("alice")
+		player.profile, player.permFunc = fixture.profile, fixture.permFunc
+		player.playerKey = linkedChatTestKey{}
+		player.connectedServer_.server = newRegisteredServer(NewServerInfo("lobby", netutil.NewAddr("localhost:25566", "tcp")))
+		h.chatChannels = newTestChatChannels(t)
+		h.configProvider = fakeConfigProvider{cfg: &config.Config{ForceKeyAuthentication: true}}
+		return h, backend
+	}
+
+	// Signed messages are chained and must reach the server, even with a channel prefix
+	h, backend := newHandler()
+	require.NoError(t, h.handleKeyedChat(&chat.KeyedPlayerChat{Message: "! hello", Expiry: time.Now()}))
+	require.Eventually(t, func() bool { return len(backend.written()) == 1 }, time.Second, 10*time.Millisecond)
+	require.IsType(t, &chat.KeyedPlayerChat{}, backend.written()[0])
+
+	// Unsigned messages are sent to the channel instead
+	h, backend = newHandler()
+	require.NoError(t, h.handleKeyedChat(&chat.KeyedPlayerChat{Message: "! hello", Unsigned: true, Expiry: time.Now()}))
+	require.Never(t, func() bool { return len(backend.written()) != 0 }, 100*time.Millisecond, 10*time.Millisecond)
+}
@@ -0,0 +1,187 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"go.minekube.com/brigodier"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/component/codec/legacy"
+
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/internal/console"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+// ConsoleCompletionSuffix is the suffix of a console input line
+// that requests command suggestions instead of running the command.
+//
+// Terminals in line mode insert a typed tab character into the line,
+// so typing "send Ro<Tab><Enter>" lists the suggestions for "send Ro".
+const ConsoleCompletionSuffix = "\t"
+
+// ConsoleSource is the command.Source of the proxy console/terminal.
+// It has all permissions and renders messages sent to it as ANSI colored text.
+type ConsoleSource struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewConsoleSource returns a new ConsoleSource writing messages to out.
+func NewConsoleSource(out io.Writer) *ConsoleSource {
+	return &ConsoleSource{out: out}
+}
+
+var _ command.Source = (*ConsoleSource)(nil)
+
+// HasPermission always returns true.
+func (c *ConsoleSource) HasPermission(string) bool { return true }
+
+// PermissionValue always returns permission.True.
+func (c *ConsoleSource) PermissionValue(string) permission.TriState { return permission.True }
+
+// SendMessage writes the message as ANSI colored line to the console.
+func (c *ConsoleSource) SendMessage(msg component.Component, _ ...command.MessageOption) error {
+	b := new(strings.Builder)
+	if err := (&legacy.Legacy{}).Marshal(b, msg); err != nil {
+		return err
+	}
+	return c.println(console.AnsiFromLegacy(b.String()))
+}
+
+func (c *ConsoleSource) println(s string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err := fmt.Fprintln(c.out, s)
+	return err
+}
+
+// RunConsole reads commands line by line from in and executes them
+// as ConsoleSource writing replies to out, until ctx is canceled or in is exhausted.
+//
+// A line ending with ConsoleCompletionSuffix lists the command suggestions instead.
+// A CommandExecuteEvent is fired before a command is run.
+func (p *Proxy) RunConsole(ctx context.Context, in io.Reader, out io.Writer) error {
+	src := NewConsoleSource(out)
+	lines := make(chan string)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			select {
+			case lines <- scanner.Text():
+			case <-ctx.Done():
+				return
+			}
+		}
+		errCh <- scanner.Err()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case line, ok := <-lines:
+			if !ok {
+				select {
+				case err := <-errCh:
+					return err
+				default:
+					return nil
+				}
+			}
+			if strings.HasSuffix(line, ConsoleCompletionSuffix) {
+				p.consoleSuggest(ctx, src, strings.TrimSuffix(line, ConsoleCompletionSuffix))
+				continue
+			}
+			p.consoleExecute(ctx, src, line)
+		}
+	}
+}
+
+// consoleExecute runs a console command line.
+func (p *Proxy) consoleExecute(ctx context.Context, src *ConsoleSource, line string) {
+	cmd := strings.TrimPrefix(strings.TrimSpace(line), "/")
+	if cmd == "" {
+		return
+	}
+
+	e := &CommandExecuteEvent{
+		source:          src,
+		commandline:     cmd,
+		originalCommand: cmd,
+	}
+	p.event.Fire(e)
+	if !e.Allowed() {
+		return
+	}
+
+	err := p.command.Do(ctx, src, e.Command())
+	if err == nil {
+		return
+	}
+	var sErr *brigodier.CommandSyntaxError
+	switch {
+	case errors.Is(err, brigodier.ErrDispatcherUnknownCommand):
+		_ = src.SendMessage(&component.Text{
+			Content: "Unknown command.",
+			S:       component.Style{Color: color.Red},
+		})
+	case errors.As(err, &sErr):
+		_ = src.SendMessage(&component.Text{
+			Content: sErr.Error(),
+			S:       component.Style{Color: color.Red},
+		})
+	case errors.Is(err, command.ErrForward):
+		// There is no server to forward console commands to.
+	default:
+		p.log.Error(err, "error while running console command", "command", e.Command())
+		_ = src.SendMessage(&component.Text{
+			Content: "An error occurred while running this command.",
+			S:       component.Style{Color: color.Red},
+		})
+	}
+}
+
+// consoleSuggest prints the possible completions of a console command line.
+func (p *Proxy) consoleSuggest(ctx context.Context, src *ConsoleSource, line string) {
+	cmd := strings.TrimPrefix(strings.TrimLeft(line, " "), "/")
+	suggestions, err := p.command.OfferBrigodierSuggestions(ctx, src, cmd)
+	if err != nil {
+		p.log.Error(err, "error while completing console command", "command", cmd)
+		return
+	}
+	completions := consoleCompletions(cmd, suggestions)
+	if len(completions) == 0 {
+		_ = src.println(console.AnsiFromLegacy("§7No suggestions."))
+		return
+	}
+	_ = src.println(strings.Join(completions, "\n"))
+}
+
+// consoleCompletions returns the complete command lines
+// resulting from applying each suggestion to cmd.
+func consoleCompletions(cmd string, suggestions *brigodier.Suggestions) []string {
+	if suggestions == nil {
+		return nil
+	}
+	completions := make([]string, 0, len(suggestions.Suggestions))
+	for _, s := range suggestions.Suggestions {
+		start, end := s.Range.Start, s.Range.End
+		if start < 0 || start > len(cmd) {
+			continue
+		}
+		if end < start || end > len(cmd) {
+			end = len(cmd)
+		}
+		completions = append(completions, cmd[:start]+s.Text+cmd[end:])
+	}
+	return completions
+}
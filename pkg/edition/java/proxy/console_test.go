@@ -0,0 +1,35 @@
+package proxy
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/brigodier"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/command"
+)
+
+func TestRunConsole(t *testing.T) {
+	p := &Proxy{event: event.Nop, log: logr.Discard()}
+	var ran []string
+	p.command.Register(brigodier.Literal("server").
+		Then(brigodier.Literal("lobby").Executes(command.Command(func(c *command.Context) error {
+			ran = append(ran, "lobby")
+			require.True(t, c.Source.HasPermission("gate.command.server"))
+			return c.Source.SendMessage(&component.Text{Content: "connected"})
+		}))))
+
+	in := strings.NewReader("server lobby\n/server lobby\nserver l\t\nunknown\n\n")
+	out := new(bytes.Buffer)
+	require.NoError(t, p.RunConsole(t.Context(), in, out))
+
+	require.Equal(t, []string{"lobby", "lobby"}, ran)
+	require.Contains(t, out.String(), "connected")
+	require.Contains(t, out.String(), "server lobby\n")
+	require.Contains(t, out.String(), "Unknown command.")
+}
@@ -11,6 +11,8 @@ import (
 	"go.minekube.com/common/minecraft/key"
 
 	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/dialog"
 	"go.minekube.com/gate/pkg/edition/java/forge/modinfo"
 	"go.minekube.com/gate/pkg/edition/java/ping"
 	"go.minekube.com/gate/pkg/edition/java/profile"
@@ -433,6 +435,8 @@ func (e *PostLoginEvent) Player() Player {
 
 // PlayerChooseInitialServerEvent is fired when a player has finished the login process,
 // and we need to choose the first server to connect to.
+// The initial server defaults to the first server of the try list or forced hosts
+// that is not down as per the health checks.
 // The proxy will wait on this event to finish firing before initiating the connection
 // but you should try to limit the work done in this event.
 // Failures will be handled by KickedFromServerEvent as normal.
@@ -861,6 +865,52 @@ func (c *PlayerChatEvent) Allowed() bool {
 //
 //
 
+// ChatChannelMessageEvent is fired before a message is sent to a proxy chat channel,
+// see ChatChannels.
+type ChatChannelMessageEvent struct {
+	channel string
+	source  command.Source
+	message string
+
+	denied bool
+}
+
+// Channel returns the name of the chat channel.
+func (c *ChatChannelMessageEvent) Channel() string {
+	return c.channel
+}
+
+// Source returns the player or console sending the message, nil if sent by the API.
+func (c *ChatChannelMessageEvent) Source() command.Source {
+	return c.source
+}
+
+// Message returns the message that will be sent to the channel.
+func (c *ChatChannelMessageEvent) Message() string {
+	return c.message
+}
+
+// SetMessage modifies the message.
+func (c *ChatChannelMessageEvent) SetMessage(msg string) {
+	c.message = msg
+}
+
+// SetAllowed sets whether the message is sent to the channel.
+func (c *ChatChannelMessageEvent) SetAllowed(allowed bool) {
+	c.denied = !allowed
+}
+
+// Allowed returns true when the message is sent to the channel.
+func (c *ChatChannelMessageEvent) Allowed() bool {
+	return !c.denied
+}
+
+//
+//
+//
+//
+//
+
 // CommandExecuteEvent is fired when someone wants to execute a command.
 type CommandExecuteEvent struct {
 	source          command.Source
@@ -1434,6 +1484,49 @@ func (e *CookieRequestEvent) SetAllowed(allowed bool) { e.denied = !allowed }
 //
 //
 
+// PlayerDialogResponseEvent is fired when a player clicks a dialog button that sends a
+// custom click action, e.g. a button without static action of a dialog shown with the
+// dialog package. The response is identified by the dialog ID and contains the button ID
+// and input values.
+//
+// Gate waits on this event to finish firing before forwarding the action to the player's
+// server, unless it was handled with SetForward(false). Handling responses of proxy-side
+// dialogs prevents backend servers from receiving actions they don't know about.
+type PlayerDialogResponseEvent struct {
+	player   Player
+	response *dialog.Response
+	forward  bool
+}
+
+func newPlayerDialogResponseEvent(player Player, response *dialog.Response) *PlayerDialogResponseEvent {
+	return &PlayerDialogResponseEvent{
+		player:   player,
+		response: response,
+		forward:  true,
+	}
+}
+
+// Player returns the player that responded to the dialog.
+func (e *PlayerDialogResponseEvent) Player() Player { return e.player }
+
+// DialogID returns the ID of the dialog or custom click action.
+func (e *PlayerDialogResponseEvent) DialogID() key.Key { return e.response.ID }
+
+// Response returns the response containing the clicked button and input values.
+func (e *PlayerDialogResponseEvent) Response() *dialog.Response { return e.response }
+
+// SetForward sets whether the action should be forwarded to the player's server.
+func (e *PlayerDialogResponseEvent) SetForward(forward bool) { e.forward = forward }
+
+// Allowed returns whether the action is forwarded to the player's server.
+func (e *PlayerDialogResponseEvent) Allowed() bool { return e.forward }
+
+//
+//
+//
+//
+//
+
 // ServerRegisteredEvent is fired when a backend server is registered with the proxy.
 // This allows plugins to react to dynamically added servers and perform necessary setup.
 type ServerRegisteredEvent struct {
@@ -1461,3 +1554,86 @@ type ServerUnregisteredEvent struct {
 func (e *ServerUnregisteredEvent) ServerInfo() ServerInfo {
 	return e.server
 }
+
+//
+//
+//
+//
+//
+
+// ServerHealthChangedEvent is fired when the health of a registered server changed
+// as determined by the periodic status pings of the proxy.
+// Health checks must be enabled in the config.
+type ServerHealthChangedEvent struct {
+	server   RegisteredServer
+	previous ServerHealth
+	health   ServerHealth
+}
+
+// Server returns the server whose health changed.
+func (e *ServerHealthChangedEvent) Server() RegisteredServer {
+	return e.server
+}
+
+// PreviousHealth returns the health of the server before the change.
+func (e *ServerHealthChangedEvent) PreviousHealth() ServerHealth {
+	return e.previous
+}
+
+// Health returns the new health of the server.
+func (e *ServerHealthChangedEvent) Health() ServerHealth {
+	return e.health
+}
+
+//
+//
+//
+//
+//
+
+// ProxyQueryEvent is fired when the proxy receives a GameSpy 4 (Minecraft query protocol)
+// stat request on the query listener, which must be enabled in the config.
+// The response can be modified or replaced to change what the querying client sees.
+type ProxyQueryEvent struct {
+	queryType QueryType
+	querier   net.Addr
+	response  *QueryResponse
+}
+
+// QueryType returns the type of the query request.
+func (e *ProxyQueryEvent) QueryType() QueryType {
+	return e.queryType
+}
+
+// Querier returns the address of the client that sent the query.
+func (e *ProxyQueryEvent) Querier() net.Addr {
+	return e.querier
+}
+
+// Response returns the query response to send. (pre-initialized by the proxy)
+func (e *ProxyQueryEvent) Response() *QueryResponse {
+	return e.response
+}
+
+// SetResponse sets the query response to send.
+// If set to nil, no response is sent.
+func (e *ProxyQueryEvent) SetResponse(response *QueryResponse) {
+	e.response = response
+}
+
+//
+//
+//
+//
+//
+
+// ConfigAppliedEvent is fired after a changed config was applied at runtime,
+// either by a reload of the config file or the API.
+type ConfigAppliedEvent struct {
+	config *config.Config
+}
+
+// Config returns the applied config. It must not be modified.
+func (e *ConfigAppliedEvent) Config() *config.Config {
+	return e.config
+}
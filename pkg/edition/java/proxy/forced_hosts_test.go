@@ -7,6 +7,7 @@ import (
 	"github.com/robinbraemer/event"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.minekube.com/gate/pkg/edition/bedrock/geyser/floodgate"
 	"go.minekube.com/gate/pkg/edition/java/auth"
 	"go.minekube.com/gate/pkg/edition/java/config"
 	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
@@ -83,6 +84,57 @@ func TestForcedHosts_FallbackToTryList(t *testing.T) {
 	assert.Equal(t, []string{"server3"}, player.serversToTry, "serversToTry should be populated with Try list as fallback")
 }
 
+func TestForcedHosts_Bedrock(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"java":          "localhost:25566",
+		"bedrock":       "localhost:25567",
+		"bedrock-lobby": "localhost:25568",
+		"lobby":         "localhost:25569",
+	}, map[string][]string{
+		"play.example.com": {"java"},
+	}, []string{"lobby"})
+	proxy.cfg.BedrockForcedHosts = map[string][]string{
+		"play.example.com": {"bedrock"},
+	}
+	proxy.cfg.BedrockTry = []string{"bedrock-lobby"}
+
+	newPlayer := func(host string, bedrock bool) *connectedPlayer {
+		p := &connectedPlayer{
+			sessionHandlerDeps: &sessionHandlerDeps{
+				proxy:          proxy,
+				configProvider: &testConfigProvider{cfg: proxy.cfg},
+			},
+			virtualHost: netutil.NewAddr(host, "tcp"),
+		}
+		if bedrock {
+			p.bedrockData = &floodgate.BedrockData{Username: "Steve"}
+		}
+		return p
+	}
+
+	tests := []struct {
+		host    string
+		bedrock bool
+		want    string
+	}{
+		{"play.example.com:25565", false, "java"},
+		{"play.example.com:25565", true, "bedrock"},
+		{"other.example.com:25565", false, "lobby"},
+		{"other.example.com:25565", true, "bedrock-lobby"},
+	}
+	for _, tt := range tests {
+		next := newPlayer(tt.host, tt.bedrock).nextServerToTry(nil)
+		require.NotNil(t, next, "%s bedrock=%v", tt.host, tt.bedrock)
+		assert.Equal(t, tt.want, next.ServerInfo().Name(), "%s bedrock=%v", tt.host, tt.bedrock)
+	}
+
+	// Bedrock players use the Java forced hosts and try list if no Bedrock ones are configured
+	proxy.cfg.BedrockForcedHosts = nil
+	proxy.cfg.BedrockTry = nil
+	assert.Equal(t, "java", newPlayer("play.example.com", true).nextServerToTry(nil).ServerInfo().Name())
+	assert.Equal(t, "lobby", newPlayer("other.example.com", true).nextServerToTry(nil).ServerInfo().Name())
+}
+
 func TestForcedHosts_VirtualHostProcessing(t *testing.T) {
 	// Test different virtual host formats to ensure proper hostname extraction
 	testCases := []struct {
@@ -180,6 +232,7 @@ func createTestProxyWithForcedHosts(t *testing.T, servers map[string]string, for
 		configServers: make(map[string]bool),
 		authenticator: authenticator,
 	}
+	proxy.maintenance = newMaintenance(proxy)
 
 	// Initialize with initial servers
 	if err := proxy.init(); err != nil {
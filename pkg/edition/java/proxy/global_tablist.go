@@ -0,0 +1,357 @@
+package proxy
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robinbraemer/event"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/edition/java/proxy/tablist"
+	internaltablist "go.minekube.com/gate/pkg/internal/tablist"
+	"go.minekube.com/gate/pkg/util/componentutil"
+	"go.minekube.com/gate/pkg/util/placeholder"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// GlobalTabList shows the players of all servers in the tab lists of players,
+// see config.GlobalTabList.
+//
+// Backend servers keep managing the entries of players on the same server,
+// the proxy adds the players on other servers and removes them once they leave
+// or join the viewer's server. Header and footer are sent by the proxy and
+// replace the ones sent by backend servers if configured.
+type GlobalTabList struct {
+	proxy *Proxy
+	wake  chan struct{} // requests an immediate update
+
+	mu      sync.Mutex
+	viewers map[uuid.UUID]*globalTabListViewer
+}
+
+// globalTabListViewer is the state of the tab list of a player managed by the proxy.
+type globalTabListViewer struct {
+	managed        map[uuid.UUID]managedTabListEntry // entries added by the proxy
+	header, footer string                            // last sent, rendered templates
+}
+
+// managedTabListEntry is an entry added by the proxy.
+type managedTabListEntry struct {
+	entry       tablist.Entry
+	displayText string // last set, rendered display name format
+}
+
+// globalTabListPlayer is a player shown in the global tab list.
+type globalTabListPlayer struct {
+	*connectedPlayer
+	server string
+
+	// The rendered display name format, rendered once per update for all viewers.
+	displayText string
+	displayName component.Component // nil if no format is configured
+}
+
+func newGlobalTabList(p *Proxy) *GlobalTabList {
+	return &GlobalTabList{
+		proxy:   p,
+		wake:    make(chan struct{}, 1),
+		viewers: map[uuid.UUID]*globalTabListViewer{},
+	}
+}
+
+// Enabled returns true if the global tab list is enabled in the config.
+func (gt *GlobalTabList) Enabled() bool {
+	return gt.config().Enabled
+}
+
+// Update updates the tab lists of all players as soon as possible
+// instead of waiting for the next update interval.
+func (gt *GlobalTabList) Update() {
+	select {
+	case gt.wake <- struct{}{}:
+	default: // update already pending
+	}
+}
+
+func (gt *GlobalTabList) config() config.GlobalTabList {
+	return gt.proxy.config().GlobalTabList
+}
+
+// replacesHeaderFooter returns true if header and footer sent by backend servers should be dropped.
+func (gt *GlobalTabList) replacesHeaderFooter() bool {
+	cfg := gt.config()
+	return cfg.Enabled && (cfg.Header != "" || cfg.Footer != "")
+}
+
+// run updates the tab lists in the configured interval until ctx is canceled.
+func (gt *GlobalTabList) run(ctx context.Context) {
+	defer event.Subscribe(gt.proxy.event, 0, func(e *ServerPostConnectEvent) {
+		gt.mu.Lock()
+		if v, ok := gt.viewers[e.Player().ID()]; ok {
+			// The client may have reset the header and footer while switching servers
+			v.header, v.footer = "", ""
+		}
+		gt.mu.Unlock()
+		gt.Update()
+	})()
+	defer event.Subscribe(gt.proxy.event, 0, func(e *DisconnectEvent) {
+		gt.mu.Lock()
+		delete(gt.viewers, e.Player().ID())
+		gt.mu.Unlock()
+		gt.Update()
+	})()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-gt.wake:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		}
+		cfg := gt.config()
+		if cfg.Enabled {
+			gt.tick(cfg)
+		} else {
+			gt.reset()
+		}
+		interval := time.Duration(cfg.UpdateInterval)
+		if interval <= 0 {
+			interval = time.Duration(config.DefaultConfig.GlobalTabList.UpdateInterval)
+		}
+		timer.Reset(interval)
+	}
+}
+
+// tick updates the tab list of every player that is connected to a server.
+func (gt *GlobalTabList) tick(cfg config.GlobalTabList) {
+	players := gt.players()
+	serverOnline := make(map[string]int)
+	for i, p := range players {
+		serverOnline[p.server]++
+		renderGlobalTabListDisplayName(gt.proxy.placeholders, cfg.Format, &players[i])
+	}
+	gt.mu.Lock()
+	defer gt.mu.Unlock()
+	for _, viewer := range players {
+		v, ok := gt.viewers[viewer.ID()]
+		if !ok {
+			v = &globalTabListViewer{managed: map[uuid.UUID]managedTabListEntry{}}
+			gt.viewers[viewer.ID()] = v
+		}
+		gt.updateEntries(cfg, v, viewer, players)
+		gt.updateHeaderFooter(cfg, v, viewer, len(players), serverOnline[viewer.server])
+	}
+}
+
+// players returns the players connected to a server sorted by server and username.
+func (gt *GlobalTabList) players() []globalTabListPlayer {
+	gt.proxy.muP.RLock()
+	players := make([]globalTabListPlayer, 0, len(gt.proxy.playerIDs))
+	for _, p := range gt.proxy.playerIDs {
+		if s := p.CurrentServer(); s != nil {
+			players = append(players, globalTabListPlayer{connectedPlayer: p, server: s.Server().ServerInfo().Name()})
+		}
+	}
+	gt.proxy.muP.RUnlock()
+	sortGlobalTabListPlayers(players)
+	return players
+}
+
+func sortGlobalTabListPlayers(players []globalTabListPlayer) {
+	slices.SortFunc(players, func(a, b globalTabListPlayer) int {
+		if c := strings.Compare(a.server, b.server); c != 0 {
+			return c
+		}
+		return strings.Compare(strings.ToLower(a.Username()), strings.ToLower(b.Username()))
+	})
+}
+
+// updateEntries adds, updates and removes the entries of players on other servers
+// in the viewer's tab list. The players must be sorted by sortGlobalTabListPlayers
+// and have their display name rendered by renderGlobalTabListDisplayName.
+func (gt *GlobalTabList) updateEntries(
+	cfg config.GlobalTabList,
+	v *globalTabListViewer,
+	viewer globalTabListPlayer,
+	players []globalTabListPlayer,
+) {
+	tl := viewer.tabList
+	entries := tl.Entries()
+	// Forget entries the backend server replaced or that were cleared when switching servers
+	for id, m := range v.managed {
+		if entries[id] != m.entry {
+			delete(v.managed, id)
+		}
+	}
+
+	var remove []uuid.UUID
+	shown := make(map[uuid.UUID]struct{}, len(players))
+	for i, p := range players {
+		id := p.ID()
+		order := len(players) - i // higher list orders are shown first
+		e := entries[id]
+		if p.server == viewer.server {
+			if _, ok := v.managed[id]; ok {
+				// Joined the viewer's server, the backend server adds its own entry
+				delete(v.managed, id)
+				remove = append(remove, id)
+				continue
+			}
+			if e != nil && cfg.SortByServer && e.ListOrder() != order {
+				_ = e.SetListOrder(order)
+			}
+			continue
+		}
+		shown[id] = struct{}{}
+
+		if !cfg.SortByServer {
+			order = 0
+		}
+		if e == nil {
+			e = internaltablist.NewEntry(tl, internaltablist.EntryAttributes{
+				Profile:     p.GameProfile(),
+				DisplayName: p.displayName,
+				Latency:     max(p.Ping(), 0),
+				Listed:      true,
+				ListOrder:   order,
+				ShowsHat:    true,
+			})
+			if err := tl.Add(e); err != nil {
+				viewer.log.V(1).Info("error adding global tab list entry", "player", p.Username(), "error", err)
+				continue
+			}
+			v.managed[id] = managedTabListEntry{entry: e, displayText: p.displayText}
+			continue
+		}
+		m, ok := v.managed[id]
+		if !ok || m.entry != e {
+			continue // managed by the backend server
+		}
+		if latency := max(p.Ping(), 0); e.Latency() != latency {
+			_ = e.SetLatency(latency)
+		}
+		if m.displayText != p.displayText {
+			_ = e.SetDisplayName(p.displayName)
+			v.managed[id] = managedTabListEntry{entry: e, displayText: p.displayText}
+		}
+		if e.ListOrder() != order {
+			_ = e.SetListOrder(order)
+		}
+	}
+
+	// Remove players that left the proxy or aren't connected to a server anymore
+	for id := range v.managed {
+		if _, ok := shown[id]; !ok {
+			delete(v.managed, id)
+			remove = append(remove, id)
+		}
+	}
+	if len(remove) != 0 {
+		if err := tl.RemoveAll(remove...); err != nil {
+			viewer.log.V(1).Info("error removing global tab list entries", "error", err)
+		}
+		_ = viewer.Flush()
+	}
+}
+
+// updateHeaderFooter sends the configured header and footer if they changed since last sent.
+func (gt *GlobalTabList) updateHeaderFooter(
+	cfg config.GlobalTabList,
+	v *globalTabListViewer,
+	viewer globalTabListPlayer,
+	online, serverOnline int,
+) {
+	if (cfg.Header == "" && cfg.Footer == "") || viewer.Protocol().Lower(version.Minecraft_1_8) {
+		return
+	}
+	values := map[string]string{
+		"server":        viewer.server,
+		"online":        strconv.Itoa(online),
+		"server_online": strconv.Itoa(serverOnline),
+	}
+	header := globalTabListText(gt.proxy.placeholders, cfg.Header, viewer, values)
+	footer := globalTabListText(gt.proxy.placeholders, cfg.Footer, viewer, values)
+	if header == v.header && footer == v.footer {
+		return
+	}
+	err := viewer.tabList.SetHeaderFooter(
+		parseGlobalTabListText(header),
+		parseGlobalTabListText(footer),
+	)
+	if err != nil {
+		viewer.log.V(1).Info("error sending global tab list header and footer", "error", err)
+		return
+	}
+	v.header, v.footer = header, footer
+}
+
+// reset removes all proxy-managed entries after the global tab list got disabled.
+func (gt *GlobalTabList) reset() {
+	gt.mu.Lock()
+	defer gt.mu.Unlock()
+	if len(gt.viewers) == 0 {
+		return
+	}
+	for id, v := range gt.viewers {
+		delete(gt.viewers, id)
+		gt.proxy.muP.RLock()
+		player := gt.proxy.playerIDs[id]
+		gt.proxy.muP.RUnlock()
+		if player == nil || len(v.managed) == 0 {
+			continue
+		}
+		entries := player.tabList.Entries()
+		var remove []uuid.UUID
+		for id, m := range v.managed {
+			if entries[id] == m.entry {
+				remove = append(remove, id)
+			}
+		}
+		if len(remove) != 0 {
+			_ = player.tabList.RemoveAll(remove...)
+			_ = player.Flush()
+		}
+	}
+}
+
+// renderGlobalTabListDisplayName renders the display name format of a player.
+// The display name stays nil if no format is configured, showing the username.
+func renderGlobalTabListDisplayName(r *placeholder.Registry, format string, p *globalTabListPlayer) {
+	if format == "" {
+		p.displayText, p.displayName = "", nil
+		return
+	}
+	p.displayText = globalTabListText(r, format, *p, map[string]string{"server": p.server})
+	p.displayName = parseGlobalTabListText(p.displayText)
+}
+
+// globalTabListText replaces the placeholders of a global tab list template for the player.
+// The values take precedence over the registered placeholders.
+func globalTabListText(r *placeholder.Registry, template string, p globalTabListPlayer, values map[string]string) string {
+	return r.Replace(&placeholder.Context{Subject: p.connectedPlayer, Values: values}, template)
+}
+
+func parseGlobalTabListText(s string) component.Component {
+	if s == "" {
+		return nil
+	}
+	c, err := componentutil.ParseComponent(version.MaximumVersion.Protocol, s)
+	if err != nil {
+		return &component.Text{Content: s}
+	}
+	return c
+}
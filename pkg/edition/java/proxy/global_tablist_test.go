@@ -0,0 +1,141 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+	internaltablist "go.minekube.com/gate/pkg/internal/tablist"
+	"go.minekube.com/gate/pkg/util/placeholder"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestSortGlobalTabListPlayers(t *testing.T) {
+	players := []globalTabListPlayer{
+		{connectedPlayer: newTestQueuePlayer("carol"), server: "survival"},
+		{connectedPlayer: newTestQueuePlayer("Bob"), server: "lobby"},
+		{connectedPlayer: newTestQueuePlayer("dave"), server: "lobby"},
+		{connectedPlayer: newTestQueuePlayer("alice"), server: "survival"},
+	}
+	sortGlobalTabListPlayers(players)
+	names := make([]string, 0, len(players))
+	for _, p := range players {
+		names = append(names, p.Username())
+	}
+	require.Equal(t, []string{"Bob", "dave", "alice", "carol"}, names)
+}
+
+func TestGlobalTabListText(t *testing.T) {
+	proxy := &Proxy{placeholders: placeholder.NewRegistry()}
+	registerBuiltinPlaceholders(proxy)
+	r := proxy.Placeholders()
+
+	p := globalTabListPlayer{connectedPlayer: newTestQueuePlayer("alice"), server: "lobby"}
+	values := map[string]string{"server": p.server, "online": "12", "server_online": "3"}
+	text := globalTabListText(r, "{player}@{server} {ping}ms {server_online}/{online}", p, values)
+	require.Equal(t, "alice@lobby 0ms 3/12", text)
+
+	renderGlobalTabListDisplayName(r, "", &p)
+	require.Nil(t, p.displayName)
+	renderGlobalTabListDisplayName(r, "§7[{server}] §f{player}", &p)
+	require.Equal(t, "§7[lobby] §falice", p.displayText)
+	require.IsType(t, &component.Text{}, p.displayName)
+}
+
+// tabListTestConn accepts all packets written to a player's tab list.
+type tabListTestConn struct{ netmc.MinecraftConn }
+
+func (tabListTestConn) Protocol() proto.Protocol        { return version.MaximumVersion.Protocol }
+func (tabListTestConn) WritePacket(proto.Packet) error  { return nil }
+func (tabListTestConn) BufferPacket(proto.Packet) error { return nil }
+func (tabListTestConn) Flush() error                    { return nil }
+
+func newTestTabListPlayer(name, server string) globalTabListPlayer {
+	p := newTestQueuePlayer(name)
+	p.MinecraftConn = tabListTestConn{}
+	p.log = logr.Discard()
+	p.tabList = internaltablist.New(p)
+	return globalTabListPlayer{connectedPlayer: p, server: server}
+}
+
+func TestGlobalTabListUpdateEntries(t *testing.T) {
+	gt := &GlobalTabList{proxy: &Proxy{placeholders: placeholder.NewRegistry()}}
+	cfg := config.GlobalTabList{Enabled: true}
+	alice := newTestTabListPlayer("alice", "lobby")
+	bob := newTestTabListPlayer("bob", "survival")
+	v := &globalTabListViewer{managed: map[uuid.UUID]managedTabListEntry{}}
+	update := func(players ...globalTabListPlayer) {
+		sortGlobalTabListPlayers(players)
+		gt.updateEntries(cfg, v, alice, players)
+	}
+
+	// Players on other servers are added
+	update(alice, bob)
+	entries := alice.tabList.Entries()
+	require.Contains(t, entries, bob.ID())
+	require.Contains(t, v.managed, bob.ID())
+	require.NotContains(t, entries, alice.ID(), "the backend server manages the viewer's own entry")
+
+	// Removed when joining the viewer's server
+	bob.server = "lobby"
+	update(alice, bob)
+	require.NotContains(t, alice.tabList.Entries(), bob.ID())
+	require.Empty(t, v.managed)
+
+	// The backend server's entry is left alone
+	backendEntry := internaltablist.NewEntry(alice.tabList, internaltablist.EntryAttributes{
+		Profile: bob.GameProfile(),
+		Listed:  true,
+	})
+	require.NoError(t, alice.tabList.Add(backendEntry))
+	update(alice, bob)
+	require.Same(t, backendEntry, alice.tabList.Entries()[bob.ID()])
+	require.Empty(t, v.managed)
+
+	// Back on another server, the backend's entry is kept until the backend removes it
+	bob.server = "survival"
+	update(alice, bob)
+	require.Same(t, backendEntry, alice.tabList.Entries()[bob.ID()])
+	require.Empty(t, v.managed)
+	require.NoError(t, alice.tabList.RemoveAll(bob.ID()))
+	update(alice, bob)
+	require.NotSame(t, backendEntry, alice.tabList.Entries()[bob.ID()])
+	require.Contains(t, v.managed, bob.ID())
+
+	// Removed when leaving the proxy
+	update(alice)
+	require.NotContains(t, alice.tabList.Entries(), bob.ID())
+	require.Empty(t, v.managed)
+}
+
+func TestGlobalTabListUpdateDisplayName(t *testing.T) {
+	gt := &GlobalTabList{proxy: &Proxy{placeholders: placeholder.NewRegistry()}}
+	cfg := config.GlobalTabList{Enabled: true, Format: "[{server}] {player}"}
+	alice := newTestTabListPlayer("alice", "lobby")
+	bob := newTestTabListPlayer("bob", "survival")
+	v := &globalTabListViewer{managed: map[uuid.UUID]managedTabListEntry{}}
+	update := func() {
+		players := []globalTabListPlayer{alice, bob}
+		for i := range players {
+			renderGlobalTabListDisplayName(gt.proxy.placeholders, cfg.Format, &players[i])
+		}
+		gt.updateEntries(cfg, v, alice, players)
+	}
+
+	update()
+	e := alice.tabList.Entries()[bob.ID()]
+	require.NotNil(t, e)
+	require.Equal(t, "[survival] bob", v.managed[bob.ID()].displayText)
+
+	bob.server = "creative"
+	update()
+	require.Same(t, e, alice.tabList.Entries()[bob.ID()], "the entry is updated in place")
+	require.Equal(t, "[creative] bob", v.managed[bob.ID()].displayText)
+	require.Equal(t, parseGlobalTabListText("[creative] bob"), e.DisplayName())
+}
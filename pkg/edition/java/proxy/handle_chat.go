@@ -20,6 +20,7 @@ type chatHandler struct {
 	player         *connectedPlayer
 	cmdMgr         *command.Manager
 	configProvider configProvider
+	chatChannels   *ChatChannels // nil if not available
 }
 
 func (c *chatHandler) handleChat(packet proto.Packet) error {
@@ -49,7 +50,7 @@ func (c *chatHandler) handleLegacyChat(packet *chat.LegacyChat) error {
 		original: packet.Message,
 	}
 	c.eventMgr.Fire(evt)
-	if !evt.Allowed() {
+	if !evt.Allowed() || c.sendToChatChannel(evt.Message()) {
 		return nil
 	}
 	return server.WritePacket((&chat.Builder{
@@ -59,6 +60,23 @@ func (c *chatHandler) handleLegacyChat(packet *chat.LegacyChat) error {
 	}).ToServer())
 }
 
+// sendToChatChannel sends the message to a proxy chat channel instead of the backend server
+// if it starts with a channel prefix or the player chats in a channel by default.
+// Returns true if the message was sent to a channel.
+func (c *chatHandler) sendToChatChannel(message string) bool {
+	if c.chatChannels == nil {
+		return false
+	}
+	channel, msg, ok := c.chatChannels.route(c.player, message)
+	if !ok {
+		return false
+	}
+	if _, err := c.chatChannels.Send(channel, c.player, msg); err != nil {
+		c.log.V(1).Info("error sending chat channel message", "channel", channel, "error", err)
+	}
+	return true
+}
+
 //type chatQueue interface {
 //	// Enqueue enqueues a chat message to be sent to the server.
 //	// The message is sent to the server when the player is connected to the server.
@@ -75,12 +93,21 @@ func (c *chatHandler) handleSessionChat(packet *chat.SessionPlayerChat, unsigned
 		original: packet.Message,
 	}
 	c.eventMgr.Fire(evt)
+	sentToChannel := evt.Allowed() && c.sendToChatChannel(evt.Message())
 
 	asFuture := func(p proto.Packet) *future.Future[proto.Packet] {
 		return future.New[proto.Packet]().Complete(p)
 	}
 
 	c.player.chatQueue.QueuePacket(func(newLastSeenMessages *chat.LastSeenMessages) *future.Future[proto.Packet] {
+		if sentToChannel {
+			// The backend never sees the message, but must still advance its 'last seen' window.
+			// Skipping a signed message is fine as its successors only need a higher index.
+			if newLastSeenMessages != nil && newLastSeenMessages.Offset != 0 {
+				return asFuture(&chat.ChatAcknowledgement{Offset: newLastSeenMessages.Offset})
+			}
+			return asFuture(nil)
+		}
 		if !evt.Allowed() {
 			if packet.Signed {
 				c.invalidCancel(c.log, c.player)
@@ -120,6 +147,13 @@ func (c *chatHandler) handleKeyedChat(packet *chat.KeyedPlayerChat) error {
 		original: packet.Message,
 	}
 	c.eventMgr.Fire(evt)
+	// 1.19.1+ signed messages are chained, not forwarding one to the server breaks the chain
+	// and disconnects the player, so they are never sent to chat channels.
+	chained := c.player.IdentifiedKey() != nil && !packet.Unsigned &&
+		keyrevision.RevisionIndex(c.player.IdentifiedKey().KeyRevision()) >= keyrevision.RevisionIndex(keyrevision.LinkedV2)
+	if evt.Allowed() && !chained && c.sendToChatChannel(evt.Message()) {
+		return nil
+	}
 
 	var msg proto.Packet
 	if c.player.IdentifiedKey() != nil && !packet.Unsigned {
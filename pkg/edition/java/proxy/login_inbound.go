@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/gammazero/deque"
 	"go.minekube.com/common/minecraft/component"
@@ -59,6 +60,8 @@ type loginInboundConn struct {
 	onAllMessagesHandled func() error
 
 	playerKey crypto.IdentifiedKey
+
+	loginStart time.Time // When the ServerLogin packet was received.
 }
 
 func newLoginInboundConn(delegate *initialInbound) *loginInboundConn {
@@ -0,0 +1,291 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/robinbraemer/event"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/lite"
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/edition/java/netmc"
+	"go.minekube.com/gate/pkg/edition/java/ping"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// ErrMaintenanceServerNotFound is returned when toggling maintenance of an unknown server.
+var ErrMaintenanceServerNotFound = errors.New("server not found")
+
+// Maintenance is the maintenance mode of the whole network and of single servers,
+// see config.Maintenance.
+// The state starts out as configured and can be toggled at runtime,
+// which lasts until the maintenance settings of the config change.
+type Maintenance struct {
+	proxy *Proxy
+
+	mu      sync.RWMutex
+	applied bool               // whether current was applied
+	current config.Maintenance // last applied config
+	enabled bool
+	servers map[string]struct{} // lowercase server names or Lite route hosts
+}
+
+func newMaintenance(p *Proxy) *Maintenance {
+	m := &Maintenance{proxy: p}
+	m.apply(p.config().Maintenance)
+	return m
+}
+
+// Enabled returns true if the whole network is under maintenance.
+func (m *Maintenance) Enabled() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.enabled
+}
+
+// SetEnabled enables or disables maintenance of the whole network.
+// Enabling it kicks the online players that are not allowed to join
+// and returns the number of kicked players.
+func (m *Maintenance) SetEnabled(enabled bool) (kicked int) {
+	m.mu.Lock()
+	m.enabled = enabled
+	m.mu.Unlock()
+	if !enabled {
+		return 0
+	}
+	return m.kick()
+}
+
+// Servers returns the sorted names of the servers under maintenance.
+// In Lite mode these are route hosts.
+func (m *Maintenance) Servers() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	servers := make([]string, 0, len(m.servers))
+	for name := range m.servers {
+		servers = append(servers, name)
+	}
+	slices.Sort(servers)
+	return servers
+}
+
+// ServerEnabled returns true if the server or Lite route host is under maintenance.
+func (m *Maintenance) ServerEnabled(name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.servers[strings.ToLower(name)]
+	return ok
+}
+
+// SetServerEnabled enables or disables maintenance of a registered server,
+// or of a route host in Lite mode.
+// Players already on the server stay connected.
+func (m *Maintenance) SetServerEnabled(name string, enabled bool) error {
+	if !m.serverExists(name) {
+		return ErrMaintenanceServerNotFound
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if enabled {
+		m.servers[strings.ToLower(name)] = struct{}{}
+	} else {
+		delete(m.servers, strings.ToLower(name))
+	}
+	return nil
+}
+
+func (m *Maintenance) serverExists(name string) bool {
+	cfg := m.proxy.config()
+	if !cfg.Lite.Enabled {
+		return m.proxy.Server(name) != nil
+	}
+	for _, route := range cfg.Lite.Routes {
+		for _, host := range route.Host {
+			if strings.EqualFold(host, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Allowed returns true if the player is whitelisted or has the bypass permission
+// and may join the network and servers under maintenance.
+func (m *Maintenance) Allowed(player Player) bool {
+	cfg := m.proxy.config().Maintenance
+	if cfg.BypassPermission != "" && player.HasPermission(cfg.BypassPermission) {
+		return true
+	}
+	for _, entry := range cfg.Whitelist {
+		if id, err := uuid.Parse(entry); err == nil {
+			if id == player.ID() {
+				return true
+			}
+		} else if strings.EqualFold(entry, player.Username()) {
+			return true
+		}
+	}
+	return false
+}
+
+// blocks returns true if the player may not connect to the server as it is under maintenance.
+func (m *Maintenance) blocks(player Player, server string) bool {
+	return m.ServerEnabled(server) && !m.Allowed(player)
+}
+
+// kick disconnects the online players that are not allowed to join.
+func (m *Maintenance) kick() (kicked int) {
+	reason := m.proxy.config().Maintenance.KickMessage.C()
+	for _, player := range m.proxy.Players() {
+		if !m.Allowed(player) {
+			player.Disconnect(reason)
+			kicked++
+		}
+	}
+	return kicked
+}
+
+// start denies logins and server connections of players that may not bypass the maintenance
+// and applies the configured maintenance state, again whenever a config reload changes it.
+// The returned stop function removes these checks.
+func (m *Maintenance) start() (stop func()) {
+	unsubscribe := []func(){
+		event.Subscribe(m.proxy.event, enforcePriority, func(e *LoginEvent) {
+			if !e.Allowed() || !m.Enabled() || m.Allowed(e.Player()) {
+				return
+			}
+			e.Deny(m.proxy.config().Maintenance.KickMessage.C())
+		}),
+		event.Subscribe(m.proxy.event, enforcePriority, func(e *ServerPreConnectEvent) {
+			if !e.Allowed() || e.Server() == nil || !m.blocks(e.Player(), e.Server().ServerInfo().Name()) {
+				return
+			}
+			e.Deny()
+			msg := m.proxy.config().Maintenance.ServerMessage.C()
+			if e.Player().CurrentServer() == nil {
+				// Initial connection, there is no server to stay on
+				e.Player().Disconnect(msg)
+				return
+			}
+			_ = e.Player().SendMessage(m.proxy.renderPlaceholders(e.Player(), msg))
+		}),
+		reload.Subscribe(m.proxy.event, func(e *javaConfigUpdateEvent) {
+			if e == nil || e.Config == nil {
+				return
+			}
+			m.apply(e.Config.Maintenance)
+		}),
+	}
+	m.apply(m.proxy.config().Maintenance)
+	return func() {
+		for _, unsub := range unsubscribe {
+			unsub()
+		}
+	}
+}
+
+// apply resets the maintenance state to the config if its state settings changed.
+func (m *Maintenance) apply(cfg config.Maintenance) {
+	m.mu.Lock()
+	if m.applied && m.current.Enabled == cfg.Enabled && slices.Equal(m.current.Servers, cfg.Servers) {
+		m.mu.Unlock()
+		return
+	}
+	m.applied, m.current = true, cfg
+	kick := cfg.Enabled && !m.enabled
+	m.enabled = cfg.Enabled
+	m.servers = make(map[string]struct{}, len(cfg.Servers))
+	for _, name := range cfg.Servers {
+		m.servers[strings.ToLower(name)] = struct{}{}
+	}
+	m.mu.Unlock()
+	if kick {
+		m.kick()
+	}
+}
+
+// applyStatus sets the configured maintenance MOTD and version text of the status response.
+func (m *Maintenance) applyStatus(p *ping.ServerPing) {
+	cfg := m.proxy.config().Maintenance
+	if cfg.Motd != nil {
+		p.Description = cfg.Motd.C()
+	}
+	if cfg.VersionName != "" {
+		// The client shows the version text instead of the player count for an incompatible protocol
+		p.Version.Protocol = -1
+		p.Version.Name = cfg.VersionName
+	}
+}
+
+// liteRoute returns true if the Lite route matching the handshake is under maintenance.
+func (m *Maintenance) liteRoute(routes []liteconfig.Route, handshake *packet.Handshake) bool {
+	host, route := lite.FindRoute(lite.ClearVirtualHost(handshake.ServerAddress), routes...)
+	if route == nil {
+		return false
+	}
+	return route.Maintenance || m.Enabled() || m.ServerEnabled(host)
+}
+
+// liteAllowed returns true if the username is on the maintenance whitelist.
+// Lite mode doesn't authenticate players, the backend server does by username,
+// so UUID entries and the bypass permission don't apply.
+func (m *Maintenance) liteAllowed(username string) bool {
+	for _, entry := range m.proxy.config().Maintenance.Whitelist {
+		if _, err := uuid.Parse(entry); err != nil && strings.EqualFold(entry, username) {
+			return true
+		}
+	}
+	return false
+}
+
+// liteMaintenanceLoginHandler waits for the login start packet of a client
+// joining a Lite route under maintenance to check the maintenance whitelist.
+type liteMaintenanceLoginHandler struct {
+	conn        netmc.MinecraftConn
+	maintenance *Maintenance
+	forward     func(login *proto.PacketContext) // forwards the allowed client
+	deny        func()                           // disconnects the denied client
+}
+
+func (h *liteMaintenanceLoginHandler) HandlePacket(pc *proto.PacketContext) {
+	login, ok := pc.Packet.(*packet.ServerLogin)
+	if !ok {
+		_ = h.conn.Close()
+		return
+	}
+	if h.maintenance.liteAllowed(login.Username) {
+		h.forward(pc)
+		return
+	}
+	h.deny()
+}
+
+func (h *liteMaintenanceLoginHandler) Disconnected() {}
+func (h *liteMaintenanceLoginHandler) Activated()    {}
+func (h *liteMaintenanceLoginHandler) Deactivated()  {}
+
+// liteStatus returns the maintenance status response of a Lite route
+// that is answered without dialing the backend.
+func (m *Maintenance) liteStatus(protocol proto.Protocol) (*packet.StatusResponse, error) {
+	pong := &ping.ServerPing{
+		Version: ping.Version{
+			Protocol: protocol,
+			Name:     versionName,
+		},
+		Players: &ping.Players{},
+	}
+	m.applyStatus(pong)
+	pong.Description = m.proxy.renderPlaceholders(nil, pong.Description)
+	status, err := json.Marshal(pong)
+	if err != nil {
+		return nil, err
+	}
+	return &packet.StatusResponse{Status: string(status)}, nil
+}
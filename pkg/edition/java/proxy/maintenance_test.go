@@ -0,0 +1,127 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/ping"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/configutil"
+)
+
+func newTestMaintenance(t *testing.T) (*Proxy, *Maintenance) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"lobby":    "localhost:25566",
+		"survival": "localhost:25567",
+	}, nil, []string{"lobby", "survival"})
+	proxy.cfg.Maintenance = config.Maintenance{
+		Servers:          []string{"Survival"},
+		Whitelist:        []string{"Alice"},
+		BypassPermission: "gate.maintenance.bypass",
+	}
+	return proxy, newMaintenance(proxy)
+}
+
+func TestMaintenanceAllowed(t *testing.T) {
+	proxy, m := newTestMaintenance(t)
+
+	assert.True(t, m.Allowed(newTestQueuePlayer("alice")))
+	assert.True(t, m.Allowed(newTestQueuePlayer("staff", "gate.maintenance.bypass")))
+	assert.False(t, m.Allowed(newTestQueuePlayer("bob")))
+
+	byID := newTestQueuePlayer("carol")
+	assert.False(t, m.Allowed(byID))
+	proxy.cfg.Maintenance.Whitelist = append(proxy.cfg.Maintenance.Whitelist, byID.ID().String())
+	assert.True(t, m.Allowed(byID))
+}
+
+func TestLiteMaintenanceLogin(t *testing.T) {
+	proxy, m := newTestMaintenance(t)
+	id := newTestQueuePlayer("carol").ID()
+	proxy.cfg.Maintenance.Whitelist = append(proxy.cfg.Maintenance.Whitelist, id.String())
+
+	assert.True(t, m.liteAllowed("ALICE"))
+	assert.False(t, m.liteAllowed("bob"))
+	assert.False(t, m.liteAllowed(id.String()), "claimed UUIDs are not authenticated in Lite mode")
+
+	conn := &statusLogTestConn{}
+	var forwarded *proto.PacketContext
+	var denied int
+	h := &liteMaintenanceLoginHandler{
+		conn:        conn,
+		maintenance: m,
+		forward:     func(login *proto.PacketContext) { forwarded = login },
+		deny:        func() { denied++ },
+	}
+
+	login := &proto.PacketContext{Packet: &packet.ServerLogin{Username: "alice"}}
+	h.HandlePacket(login)
+	assert.Same(t, login, forwarded, "whitelisted players are forwarded with their login packet")
+
+	h.HandlePacket(&proto.PacketContext{Packet: &packet.ServerLogin{Username: "bob"}})
+	assert.Equal(t, 1, denied)
+
+	h.HandlePacket(&proto.PacketContext{Packet: &packet.StatusRequest{}})
+	assert.Equal(t, 1, conn.closes)
+}
+
+func TestMaintenanceServers(t *testing.T) {
+	proxy, m := newTestMaintenance(t)
+
+	assert.False(t, m.Enabled())
+	assert.Equal(t, []string{"survival"}, m.Servers())
+	assert.True(t, m.blocks(newTestQueuePlayer("bob"), "survival"))
+	assert.False(t, m.blocks(newTestQueuePlayer("alice"), "survival"))
+	assert.False(t, m.blocks(newTestQueuePlayer("bob"), "lobby"))
+
+	require.NoError(t, m.SetServerEnabled("lobby", true))
+	require.NoError(t, m.SetServerEnabled("survival", false))
+	assert.Equal(t, []string{"lobby"}, m.Servers())
+	assert.ErrorIs(t, m.SetServerEnabled("unknown", true), ErrMaintenanceServerNotFound)
+
+	// Runtime changes last until the maintenance settings of the config change
+	cfg := proxy.cfg.Maintenance
+	cfg.Motd = &configutil.Component{Value: &component.Text{Content: "changed"}}
+	m.apply(cfg)
+	assert.Equal(t, []string{"lobby"}, m.Servers())
+	cfg.Enabled = true
+	m.apply(cfg)
+	assert.True(t, m.Enabled())
+	assert.Equal(t, []string{"survival"}, m.Servers())
+}
+
+func TestNextServerToTrySkipsMaintenance(t *testing.T) {
+	proxy, m := newTestMaintenance(t)
+	proxy.maintenance = m
+	require.NoError(t, m.SetServerEnabled("lobby", true))
+
+	player := newTestQueuePlayer("bob")
+	player.sessionHandlerDeps = &sessionHandlerDeps{
+		proxy:          proxy,
+		configProvider: &testConfigProvider{cfg: proxy.cfg},
+	}
+	assert.Nil(t, player.nextServerToTry(nil))
+
+	require.NoError(t, m.SetServerEnabled("survival", false))
+	player.tryIndex = 0
+	next := player.nextServerToTry(nil)
+	require.NotNil(t, next)
+	assert.Equal(t, "survival", next.ServerInfo().Name())
+}
+
+func TestMaintenanceApplyStatus(t *testing.T) {
+	proxy, m := newTestMaintenance(t)
+	proxy.cfg.Maintenance.Motd = &configutil.Component{Value: &component.Text{Content: "Maintenance"}}
+	proxy.cfg.Maintenance.VersionName = "§cMaintenance"
+
+	pong := &ping.ServerPing{Version: ping.Version{Protocol: 767, Name: "Gate"}}
+	m.applyStatus(pong)
+	assert.Equal(t, "Maintenance", pong.Description.(*component.Text).Content)
+	assert.Equal(t, "§cMaintenance", pong.Version.Name)
+	assert.EqualValues(t, -1, pong.Version.Protocol)
+}
@@ -2,8 +2,10 @@ package proxy
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
@@ -12,6 +14,63 @@ var (
 	tracer = otel.Tracer("java/proxy")
 )
 
+// Instruments fall back to no-ops if they could not be created.
+var (
+	loginDuration, _ = meter.Float64Histogram(
+		"gate.login.duration",
+		metric.WithDescription("The time from a player's login start until the login success was sent"),
+		metric.WithUnit("s"),
+	)
+	loginFailures, _ = meter.Int64Counter(
+		"gate.login.failures",
+		metric.WithDescription("The player logins that failed or were denied, by reason"),
+		metric.WithUnit("{login}"),
+	)
+	serverSwitchDuration, _ = meter.Float64Histogram(
+		"gate.server.switch.duration",
+		metric.WithDescription("The time it took to connect a player to a server, by server and result"),
+		metric.WithUnit("s"),
+	)
+	quotaRejections, _ = meter.Int64Counter(
+		"gate.quota.rejections",
+		metric.WithDescription("The connections and logins rejected by the per-IP quotas"),
+		metric.WithUnit("{connection}"),
+	)
+)
+
+// Reasons of the gate.login.failures metric.
+const (
+	loginFailureAuthUnavailable  = "auth_unavailable"  // Authentication with Mojang failed.
+	loginFailureOfflineMode      = "offline_mode"      // An offline-mode player joined the online-mode proxy.
+	loginFailureInvalidToken     = "invalid_token"     // The encryption response failed verification.
+	loginFailureInvalidKey       = "invalid_key"       // The player's public key is expired or invalid.
+	loginFailureDenied           = "denied"            // A PreLoginEvent or LoginEvent subscriber denied the login.
+	loginFailureAlreadyConnected = "already_connected" // A player with the same name or ID is already connected.
+)
+
+// recordLoginFailure increments the gate.login.failures metric.
+func recordLoginFailure(ctx context.Context, reason string) {
+	loginFailures.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+}
+
+// recordQuotaRejection increments the gate.quota.rejections metric of the named quota.
+func recordQuotaRejection(ctx context.Context, quota string) {
+	quotaRejections.Add(ctx, 1, metric.WithAttributes(attribute.String("quota", quota)))
+}
+
+// recordServerSwitch records the gate.server.switch.duration metric of a connection attempt.
+func recordServerSwitch(ctx context.Context, start time.Time, server string, initial bool, result *connectionResult, err error) {
+	status := "error"
+	if err == nil && result != nil {
+		status = result.Status().String()
+	}
+	serverSwitchDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("server", server),
+		attribute.String("result", status),
+		attribute.Bool("initial", initial),
+	))
+}
+
 func (p *Proxy) initMeter() error {
 	// player count metric
 	_, err := meter.Int64ObservableGauge(
@@ -39,6 +98,22 @@ func (p *Proxy) initMeter() error {
 	if err != nil {
 		return err
 	}
+	// per server player count metric
+	_, err = meter.Int64ObservableGauge(
+		"gate.server.player_count",
+		metric.WithInt64Callback(func(ctx context.Context, o metric.Int64Observer) error {
+			for _, s := range p.Servers() {
+				o.Observe(int64(s.Players().Len()),
+					metric.WithAttributes(attribute.String("server", s.ServerInfo().Name())))
+			}
+			return nil
+		}),
+		metric.WithDescription("The current player count of each registered server"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,79 @@
+package proxy
+
+import (
+	"strconv"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/util/placeholder"
+)
+
+// Placeholders returns the registry of placeholders rendered in the status MOTD,
+// tab list header and footer, disconnect and shutdown messages.
+// Plugins can register their own placeholder providers.
+//
+// The proxy registers the following placeholders, the subject of the context
+// is the Player the text is rendered for, if any:
+//
+//	{online}          number of players on the proxy
+//	{online:<server>} number of players on the server
+//	{max}             the configured max player count of the status response
+//	{player}          the player's username
+//	{server}          the name of the player's current server
+//	{ping}            the player's ping in milliseconds
+func (p *Proxy) Placeholders() *placeholder.Registry {
+	return p.placeholders
+}
+
+// renderPlaceholders replaces the placeholders of the component for the subject, which may be nil.
+func (p *Proxy) renderPlaceholders(subject any, c component.Component) component.Component {
+	if c == nil {
+		return nil
+	}
+	return p.placeholders.Component(&placeholder.Context{Subject: subject}, c)
+}
+
+func registerBuiltinPlaceholders(p *Proxy) {
+	providers := map[string]placeholder.Provider{
+		"online": func(_ *placeholder.Context, arg string) (string, bool) {
+			if arg == "" {
+				return strconv.Itoa(p.PlayerCount()), true
+			}
+			s := p.Server(arg)
+			if s == nil {
+				return "", false
+			}
+			return strconv.Itoa(s.Players().Len()), true
+		},
+		"max": func(*placeholder.Context, string) (string, bool) {
+			return strconv.Itoa(p.config().Status.ShowMaxPlayers), true
+		},
+		"player": func(ctx *placeholder.Context, _ string) (string, bool) {
+			player, ok := ctx.Subject.(Player)
+			if !ok {
+				return "", false
+			}
+			return player.Username(), true
+		},
+		"server": func(ctx *placeholder.Context, _ string) (string, bool) {
+			player, ok := ctx.Subject.(Player)
+			if !ok {
+				return "", false
+			}
+			if s := player.CurrentServer(); s != nil {
+				return s.Server().ServerInfo().Name(), true
+			}
+			return "", true
+		},
+		"ping": func(ctx *placeholder.Context, _ string) (string, bool) {
+			player, ok := ctx.Subject.(Player)
+			if !ok {
+				return "", false
+			}
+			return strconv.FormatInt(max(player.Ping(), 0).Milliseconds(), 10), true
+		},
+	}
+	for name, provider := range providers {
+		_ = p.placeholders.Register(name, provider)
+	}
+}
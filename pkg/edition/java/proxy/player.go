@@ -27,6 +27,7 @@ import (
 	"go.minekube.com/common/minecraft/component/codec/legacy"
 	"go.uber.org/atomic"
 
+	"go.minekube.com/gate/pkg/edition/bedrock/geyser/floodgate"
 	"go.minekube.com/gate/pkg/edition/java/config"
 	"go.minekube.com/gate/pkg/edition/java/forge/modinfo"
 	"go.minekube.com/gate/pkg/edition/java/lite"
@@ -76,6 +77,7 @@ type Player interface { // TODO convert to struct(?) bc this is a lot of methods
 	GameProfile() profile.GameProfile // Returns the player's game profile.
 	Settings() player.Settings        // The player's client settings. Returns player.DefaultSettings if unknown.
 	// Disconnect disconnects the player with a reason.
+	// Placeholders in the reason are replaced, see Proxy.Placeholders.
 	// Once called, further interface calls to this player become undefined.
 	Disconnect(reason component.Component)
 	// SpoofChatInput sends chats input onto the player's current server as if
@@ -95,10 +97,21 @@ type Player interface { // TODO convert to struct(?) bc this is a lot of methods
 	// Used for modifying the player's tab list and header/footer.
 	TabList() tablist.TabList
 	ClientBrand() string // Returns the player's client brand. Empty if unspecified.
+	// BedrockData returns the data of a Bedrock Edition player joining through Geyser/Floodgate,
+	// e.g. the XUID, device OS and input mode. Returns nil for Java Edition players.
+	BedrockData() *floodgate.BedrockData
 	// TransferToHost transfers the player to the specified host.
 	// The host should be in the format of "host:port" or just "host" in which case the port defaults to 25565.
 	// If the player is from a version lower than 1.20.5, this method will return ErrTransferUnsupportedClientProtocol.
 	TransferToHost(addr string) error
+	// SetServerLinks sets the links shown in the pause menu of 1.21+ clients,
+	// combined with the links of the backend server according to the configured policy.
+	// A nil slice resets the links to the configured ones.
+	SetServerLinks(links []ServerLink) error
+	// SetReportDetails sets the details added to crash and disconnect reports of 1.21+ clients,
+	// combined with the details of the backend server according to the configured policy.
+	// A nil map resets the details to the configured ones.
+	SetReportDetails(details map[string]string) error
 
 	// AppliedResourcePack returns the resource pack that was applied to the player.
 	// Returns nil if no resource pack was applied.
@@ -125,6 +138,7 @@ type Player interface { // TODO convert to struct(?) bc this is a lot of methods
 	//  - https://pkg.go.dev/go.minekube.com/gate/pkg/edition/java/bossbar
 	//  - https://pkg.go.dev/go.minekube.com/gate/pkg/edition/java/title
 	//  - https://pkg.go.dev/go.minekube.com/gate/pkg/edition/java/cookie
+	//  - https://pkg.go.dev/go.minekube.com/gate/pkg/edition/java/dialog
 	//  - https://pkg.go.dev/go.minekube.com/gate/pkg/edition/java/sound
 	//  - https://pkg.go.dev/go.minekube.com/gate/pkg/edition/java/proxy/tablist
 }
@@ -152,6 +166,7 @@ type connectedPlayer struct {
 
 	tabList        internaltablist.InternalTabList // Player's tab list
 	bossBarManager *bossBarManager                 // Boss bar manager for 1.20.2+
+	serverLinks    serverLinks                     // Server links and report details for 1.21+
 
 	mu                   sync.RWMutex // Protects following fields
 	connectedServer_     *serverConnection
@@ -164,7 +179,8 @@ type connectedPlayer struct {
 	forgeReplayRelay     *modernForgeReplayRelay // non-nil during server switch FML replay
 	forgeLoginCache      []forgeLoginExchange    // cached FML exchanges from initial connection
 
-	clientBrand string // may be empty
+	clientBrand string                 // may be empty
+	bedrockData *floodgate.BedrockData // nil for Java Edition players
 
 	serversToTry []string // names of servers to try if we got disconnected from previous
 	tryIndex     int
@@ -200,6 +216,7 @@ func newConnectedPlayer(
 		ping:               ping,
 		permFunc:           func(string) permission.TriState { return permission.Undefined },
 		playerKey:          playerKey,
+		bedrockData:        floodgate.FromContext(conn.Context()),
 	}
 	p.resourcePackHandler = resourcepack.NewHandler(p, p.eventMgr)
 	p.bundleHandler = &resourcepack.BundleDelimiterHandler{Player: p}
@@ -211,6 +228,8 @@ func newConnectedPlayer(
 
 func (p *connectedPlayer) IdentifiedKey() crypto.IdentifiedKey { return p.playerKey }
 
+func (p *connectedPlayer) BedrockData() *floodgate.BedrockData { return p.bedrockData }
+
 // BossBarManager returns the player's boss bar manager.
 // It is used to handle proxy-level boss bars during server transitions on 1.20.2+.
 func (p *connectedPlayer) BossBarManager() *bossBarManager { return p.bossBarManager }
@@ -495,21 +514,35 @@ func (p *connectedPlayer) SendPluginMessage(identifier message.ChannelIdentifier
 // Finds another server to attempt to log into, if we were unexpectedly disconnected from the server.
 // current is the current server of the player is on, so we skip this server and not connect to it.
 // current can be nil if there is no current server.
+// Server groups are expanded to their servers in the order of the group's strategy.
+// Bedrock players are routed by the Bedrock forced hosts and try list first, if configured.
+// Servers that are down as per the health checks or under maintenance are skipped.
 // MAY RETURN NIL if no next server available!
 func (p *connectedPlayer) nextServerToTry(current RegisteredServer) RegisteredServer {
+	// Checked before locking as permission functions may access the player
+	maintenanceAllowed := p.proxy.maintenance.Allowed(p)
+
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	if len(p.serversToTry) == 0 {
 		// Extract hostname from virtual host and convert to lowercase
 		virtualHostStr := p.getVirtualHostname()
-		p.serversToTry = p.config().ForcedHosts[virtualHostStr]
+		if p.bedrockData != nil {
+			p.serversToTry = p.proxy.resolveServersToTry(p.config(), p.config().BedrockForcedHosts[virtualHostStr])
+		}
+		if len(p.serversToTry) == 0 {
+			p.serversToTry = p.proxy.resolveServersToTry(p.config(), p.config().ForcedHosts[virtualHostStr])
+		}
 	}
 	if len(p.serversToTry) == 0 {
 		connOrder := p.config().Try
+		if p.bedrockData != nil && len(p.config().BedrockTry) != 0 {
+			connOrder = p.config().BedrockTry
+		}
 		if len(connOrder) == 0 {
 			return nil
 		} else {
-			p.serversToTry = connOrder
+			p.serversToTry = p.proxy.resolveServersToTry(p.config(), connOrder)
 		}
 	}
 
@@ -526,7 +559,10 @@ func (p *connectedPlayer) nextServerToTry(current RegisteredServer) RegisteredSe
 		}
 
 		p.tryIndex = i
-		if s := p.proxy.Server(toTry); s != nil {
+		if !maintenanceAllowed && p.proxy.maintenance.ServerEnabled(toTry) {
+			continue
+		}
+		if s := p.proxy.Server(toTry); s != nil && HealthOf(s) != ServerHealthDown {
 			return s
 		}
 	}
@@ -535,7 +571,12 @@ func (p *connectedPlayer) nextServerToTry(current RegisteredServer) RegisteredSe
 
 // getVirtualHostname extracts the hostname from the virtual host address and converts it to lowercase.
 func (p *connectedPlayer) getVirtualHostname() string {
-	if p.virtualHost == nil {
+	return virtualHostname(p.virtualHost)
+}
+
+// virtualHostname returns the lowercase hostname of a virtual host for matching config keys.
+func virtualHostname(virtualHost net.Addr) string {
+	if virtualHost == nil {
 		return ""
 	}
 
@@ -543,7 +584,7 @@ func (p *connectedPlayer) getVirtualHostname() string {
 	// 1. Clear virtual host (removes forge separators, TCPShield separators, etc.)
 	// 2. Extract hostname (removes port)
 	// 3. Convert to lowercase for consistent matching
-	virtualHostStr := p.virtualHost.String()
+	virtualHostStr := virtualHost.String()
 	cleanedHost := lite.ClearVirtualHost(virtualHostStr)
 	hostname := netutil.HostStr(cleanedHost)
 
@@ -607,7 +648,7 @@ func (p *connectedPlayer) Disconnect(reason component.Component) {
 	if !p.Active() {
 		return
 	}
-	reason = normalizeDisconnectReason(reason)
+	reason = p.proxy.renderPlaceholders(p, normalizeDisconnectReason(reason))
 
 	var r string
 	b := new(strings.Builder)
@@ -655,7 +696,8 @@ func (p *connectedPlayer) SetModInfo(info *modinfo.ModInfo) {
 func (p *connectedPlayer) setConnectedServer(conn *serverConnection) {
 	p.mu.Lock()
 	p.connectedServer_ = conn
-	p.tryIndex = 0 // reset since we got connected to a server
+	p.tryIndex = 0       // reset since we got connected to a server
+	p.serversToTry = nil // server groups are ordered again on the next failover
 	if conn == p.connInFlight {
 		p.connInFlight = nil
 	}
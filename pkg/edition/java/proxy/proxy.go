@@ -37,10 +37,17 @@ import (
 	"go.minekube.com/gate/pkg/internal/reload"
 	"go.minekube.com/gate/pkg/util/errs"
 	"go.minekube.com/gate/pkg/util/netutil"
+	"go.minekube.com/gate/pkg/util/placeholder"
 	"go.minekube.com/gate/pkg/util/uuid"
 	"go.minekube.com/gate/pkg/util/validation"
 )
 
+// enforcePriority is the priority of the subscribers enforcing the built-in
+// punishments, whitelist and maintenance mode. They run before plugin subscribers
+// with the default priority so plugins can still override the result,
+// e.g. to let a player join anyway.
+const enforcePriority = 100
+
 // Proxy is Gate's Java edition Minecraft proxy.
 type Proxy struct {
 	log logr.Logger
@@ -64,6 +71,7 @@ type Proxy struct {
 	muS           sync.RWMutex                 // Protects following fields
 	servers       map[string]*registeredServer // registered backend servers: by lower case names
 	configServers map[string]bool              // tracks which servers came from config (vs API)
+	serverGroups  serverGroups                 // strategy state of the configured server groups
 
 	muP         sync.RWMutex                   // Protects following fields
 	playerNames map[string]*connectedPlayer    // lower case usernames map
@@ -81,8 +89,17 @@ type Proxy struct {
 
 	proxyProtocol atomic.Pointer[proxyProtocol] // PROXY protocol wrapper for accepted connections
 
-	lite *lite.Lite // lite mode functionality
-	via  *viaManagedRunner
+	lite   *lite.Lite // lite mode functionality
+	via    *viaManagedRunner
+	queues *Queues // connection queues for full or offline servers
+
+	punishments   *Punishments   // built-in bans and mutes
+	whitelist     *Whitelist     // proxy-level whitelist
+	maintenance   *Maintenance   // maintenance mode of the network and servers
+	chatChannels  *ChatChannels  // proxy-level chat channels
+	globalTabList *GlobalTabList // network-wide tab list
+
+	placeholders *placeholder.Registry // rendered in configured messages
 }
 
 type runtimeConfigSnapshot struct {
@@ -136,8 +153,16 @@ func New(options Options) (p *Proxy, err error) {
 		authenticator:    authn,
 		lite:             lite.NewLite(), // create lite mode functionality for this proxy instance
 		via:              newViaManagedRunner(options.Config),
+		placeholders:     placeholder.NewRegistry(),
 	}
+	registerBuiltinPlaceholders(p)
 	p.currentCfg.Store(&runtimeConfigSnapshot{cfg: options.Config})
+	p.queues = newQueues(p)
+	p.punishments = newPunishments(p)
+	p.whitelist = newWhitelist(p)
+	p.maintenance = newMaintenance(p)
+	p.chatChannels = newChatChannels(p)
+	p.globalTabList = newGlobalTabList(p)
 
 	// Connection & login rate limiters
 	p.initQuota(&options.Config.Quota)
@@ -235,6 +260,15 @@ func (p *Proxy) Start(ctx context.Context) error {
 		p.Shutdown(p.config().ShutdownReason.T()) // disconnects players
 	}()
 
+	// Enforce bans and mutes if enabled, before accepting connections
+	defer p.punishments.start(ctx)()
+
+	// Enforce the whitelist if enabled, before accepting connections
+	defer p.whitelist.start(ctx)()
+
+	// Enforce maintenance mode of the network and servers, before accepting connections
+	defer p.maintenance.start()()
+
 	eg, ctx := errgroup.WithContext(ctx)
 	listen := func(addr string) context.CancelFunc {
 		lnCtx, stop := context.WithCancel(ctx)
@@ -247,6 +281,21 @@ func (p *Proxy) Start(ctx context.Context) error {
 
 	_ = listen(p.config().Bind)
 
+	// Serve GameSpy 4 query requests if enabled
+	go newQueryServer(p).run(ctx)
+
+	// Ping registered servers to check their health if enabled
+	go newHealthChecker(p).run(ctx)
+
+	// Connect queued players once their server is available
+	go p.queues.run(ctx)
+
+	// Register chat channel commands if enabled
+	go p.chatChannels.run(ctx)
+
+	// Show the players of all servers in tab lists if enabled
+	go p.globalTabList.run(ctx)
+
 	// Listen for config reloads until we exit
 	defer reload.Subscribe(p.event, func(e *javaConfigUpdateEvent) {
 		if e == nil || e.Config == nil {
@@ -311,6 +360,7 @@ func (p *Proxy) ApplyLiveConfig(candidate *config.Config) error {
 		generation++
 	}
 	p.currentCfg.Store(&runtimeConfigSnapshot{cfg: &published, generation: generation})
+	p.event.FireParallel(&ConfigAppliedEvent{config: &published})
 	return nil
 }
 
@@ -472,6 +522,36 @@ func (p *Proxy) Event() event.Manager {
 	return p.event
 }
 
+// Queues returns the Proxy's connection queues for full or offline servers.
+func (p *Proxy) Queues() *Queues {
+	return p.queues
+}
+
+// Punishments returns the Proxy's built-in bans and mutes.
+func (p *Proxy) Punishments() *Punishments {
+	return p.punishments
+}
+
+// Whitelist returns the Proxy's proxy-level whitelist.
+func (p *Proxy) Whitelist() *Whitelist {
+	return p.whitelist
+}
+
+// Maintenance returns the Proxy's maintenance mode.
+func (p *Proxy) Maintenance() *Maintenance {
+	return p.maintenance
+}
+
+// ChatChannels returns the Proxy's chat channels.
+func (p *Proxy) ChatChannels() *ChatChannels {
+	return p.chatChannels
+}
+
+// GlobalTabList returns the Proxy's network-wide tab list.
+func (p *Proxy) GlobalTabList() *GlobalTabList {
+	return p.globalTabList
+}
+
 // Command returns the Proxy's command manager.
 func (p *Proxy) Command() *command.Manager {
 	return &p.command
@@ -731,6 +811,7 @@ func (p *Proxy) listenAndServe(ctx context.Context, addr string) error {
 // that has not had any I/O performed on it yet.
 func (p *Proxy) HandleConn(raw net.Conn) {
 	if p.connectionsQuota != nil && p.connectionsQuota.Blocked(netutil.Host(raw.RemoteAddr())) {
+		recordQuotaRejection(context.Background(), "connections")
 		p.log.Info("connection exceeded rate limit, closed", "remoteAddr", raw.RemoteAddr())
 		_ = raw.Close()
 		return
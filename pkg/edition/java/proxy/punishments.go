@@ -0,0 +1,277 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/robinbraemer/event"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/componentutil"
+	"go.minekube.com/gate/pkg/util/netutil"
+	"go.minekube.com/gate/pkg/util/punishment"
+)
+
+// ErrPunishmentsDisabled is returned when modifying punishments while they are disabled in the config.
+var ErrPunishmentsDisabled = errors.New("punishments are disabled")
+
+// Punishments enforces the bans and mutes of the built-in punishment store,
+// see config.Punishments.
+// Bans deny the login of matching players and mutes deny their chat messages,
+// including those to proxy chat channels.
+type Punishments struct {
+	proxy *Proxy
+
+	mu      sync.Mutex
+	current config.Punishments
+	store   *punishment.Store  // nil if disabled or the file could not be loaded
+	stop    context.CancelFunc // stops watching the file
+}
+
+func newPunishments(p *Proxy) *Punishments {
+	return &Punishments{proxy: p}
+}
+
+// Store returns the punishment store or nil if punishments are disabled.
+func (ps *Punishments) Store() *punishment.Store {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.store
+}
+
+// Punish adds the punishment to the store and applies it to matching online players.
+// Banned players are disconnected and muted players are notified.
+func (ps *Punishments) Punish(p punishment.Punishment) (punishment.Punishment, error) {
+	store := ps.Store()
+	if store == nil {
+		return punishment.Punishment{}, ErrPunishmentsDisabled
+	}
+	p, err := store.Add(p)
+	if err != nil {
+		return punishment.Punishment{}, err
+	}
+	for _, player := range ps.proxy.Players() {
+		if !p.Matches(playerTarget(player)) {
+			continue
+		}
+		switch p.Type {
+		case punishment.Ban:
+			player.Disconnect(banMessage(player.Protocol(), p))
+		case punishment.Mute:
+			_ = player.SendMessage(muteMessage(player.Protocol(), p))
+		}
+	}
+	return p, nil
+}
+
+// Revoke removes the active punishments of the type whose player UUID,
+// username or IP equals key and returns the removed punishments.
+func (ps *Punishments) Revoke(typ punishment.Type, key string) ([]punishment.Punishment, error) {
+	store := ps.Store()
+	if store == nil {
+		return nil, ErrPunishmentsDisabled
+	}
+	return store.Revoke(typ, key)
+}
+
+// Find returns the active punishment of the type of the player.
+func (ps *Punishments) Find(typ punishment.Type, player Player) (punishment.Punishment, bool) {
+	store := ps.Store()
+	if store == nil {
+		return punishment.Punishment{}, false
+	}
+	return store.Find(typ, playerTarget(player))
+}
+
+// start enforces the punishments and keeps in sync with config updates
+// until the returned stop function is called.
+// It must be called before the proxy accepts connections.
+func (ps *Punishments) start(ctx context.Context) (stop func()) {
+	unsubscribe := []func(){
+		event.Subscribe(ps.proxy.event, enforcePriority, func(e *PreLoginEvent) {
+			if e.Result() == DeniedPreLogin {
+				return
+			}
+			if ps.unavailable() {
+				e.Deny(punishmentsUnavailableMessage)
+				return
+			}
+			id, _ := e.ID()
+			t := punishment.Target{ID: id, Name: e.Username(), IP: addrIP(e.Conn().RemoteAddr())}
+			if p, ok := ps.find(punishment.Ban, t); ok {
+				e.Deny(banMessage(e.Conn().Protocol(), p))
+			}
+		}),
+		event.Subscribe(ps.proxy.event, enforcePriority, func(e *LoginEvent) {
+			if !e.Allowed() {
+				return
+			}
+			if ps.unavailable() {
+				e.Deny(punishmentsUnavailableMessage)
+				return
+			}
+			if p, ok := ps.Find(punishment.Ban, e.Player()); ok {
+				e.Deny(banMessage(e.Player().Protocol(), p))
+			}
+		}),
+		event.Subscribe(ps.proxy.event, enforcePriority, func(e *PlayerChatEvent) {
+			if !e.Allowed() {
+				return
+			}
+			if p, ok := ps.Find(punishment.Mute, e.Player()); ok {
+				e.SetAllowed(false)
+				_ = e.Player().SendMessage(muteMessage(e.Player().Protocol(), p))
+			}
+		}),
+		// Mutes also apply to proxy chat channels, e.g. sent with a channel command
+		event.Subscribe(ps.proxy.event, enforcePriority, func(e *ChatChannelMessageEvent) {
+			player, ok := e.Source().(Player)
+			if !ok || !e.Allowed() {
+				return
+			}
+			if p, ok := ps.Find(punishment.Mute, player); ok {
+				e.SetAllowed(false)
+				_ = player.SendMessage(muteMessage(player.Protocol(), p))
+			}
+		}),
+		reload.Subscribe(ps.proxy.event, func(e *javaConfigUpdateEvent) {
+			if e == nil || e.Config == nil {
+				return
+			}
+			ps.apply(ctx, e.Config.Punishments)
+		}),
+	}
+	ps.apply(ctx, ps.proxy.config().Punishments)
+	return func() {
+		for _, unsub := range unsubscribe {
+			unsub()
+		}
+		ps.apply(ctx, config.Punishments{})
+	}
+}
+
+// punishmentsUnavailableMessage denies logins while the punishments file could not be loaded.
+var punishmentsUnavailableMessage = &component.Text{
+	Content: "Bans could not be checked, please try again later.",
+	S:       component.Style{Color: color.Red},
+}
+
+// unavailable returns true if punishments are enabled but the file could not be loaded.
+// Logins are denied meanwhile, so banned players can't join.
+func (ps *Punishments) unavailable() bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return ps.current.Enabled && ps.store == nil
+}
+
+func (ps *Punishments) find(typ punishment.Type, t punishment.Target) (punishment.Punishment, bool) {
+	store := ps.Store()
+	if store == nil {
+		return punishment.Punishment{}, false
+	}
+	return store.Find(typ, t)
+}
+
+// apply opens and watches the punishments file if the config changed.
+func (ps *Punishments) apply(ctx context.Context, cfg config.Punishments) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.current == cfg && (ps.store != nil || !cfg.Enabled) {
+		return
+	}
+	ps.current = cfg
+	if ps.stop != nil {
+		ps.stop()
+		ps.stop = nil
+	}
+	ps.store = nil
+	if !cfg.Enabled || ctx.Err() != nil {
+		return
+	}
+
+	log := ps.proxy.log.WithName("punishments").WithValues("file", cfg.File)
+	store, err := punishment.Open(cfg.File)
+	if err != nil {
+		log.Error(err, "error loading punishments file, all logins are denied")
+		return
+	}
+	ps.store = store
+	log.Info("loaded punishments file", "punishments", len(store.List("")))
+
+	var watchCtx context.Context
+	watchCtx, ps.stop = context.WithCancel(ctx)
+	if err = reload.Watch(watchCtx, cfg.File, func() error {
+		if err := store.Reload(); err != nil {
+			log.Error(err, "error reloading punishments file, keeping previous punishments")
+			return reload.Reject("invalid")
+		}
+		return nil
+	}); err != nil {
+		log.Error(err, "error watching punishments file for changes")
+	}
+}
+
+// playerTarget returns the punishment target of the player.
+func playerTarget(player Player) punishment.Target {
+	return punishment.Target{
+		ID:   player.ID(),
+		Name: player.Username(),
+		IP:   addrIP(player.RemoteAddr()),
+	}
+}
+
+// addrIP returns the IP of the address or the zero value if it has none.
+func addrIP(addr net.Addr) netip.Addr {
+	if addr == nil {
+		return netip.Addr{}
+	}
+	ip, _ := netip.ParseAddr(netutil.Host(addr))
+	return ip.Unmap()
+}
+
+// punishmentReason renders the reason of the punishment.
+func punishmentReason(protocol proto.Protocol, p punishment.Punishment) component.Component {
+	if p.Reason == "" {
+		return nil
+	}
+	if c, err := componentutil.ParseComponent(protocol, p.Reason); err == nil {
+		return c
+	}
+	return &component.Text{Content: p.Reason}
+}
+
+// punishmentMessage renders the headline, reason and expiry of the punishment.
+func punishmentMessage(protocol proto.Protocol, headline string, p punishment.Punishment, sep string) component.Component {
+	msg := &component.Text{
+		Content: headline,
+		S:       component.Style{Color: color.Red},
+	}
+	if reason := punishmentReason(protocol, p); reason != nil {
+		msg.Extra = append(msg.Extra,
+			&component.Text{Content: sep + "Reason: ", S: component.Style{Color: color.Gray}},
+			reason)
+	}
+	expiry := "This is permanent."
+	if !p.Permanent() {
+		expiry = fmt.Sprintf("Expires in %s.", punishment.FormatDuration(time.Until(p.Expires)))
+	}
+	msg.Extra = append(msg.Extra, &component.Text{Content: sep + expiry, S: component.Style{Color: color.Gray}})
+	return msg
+}
+
+func banMessage(protocol proto.Protocol, p punishment.Punishment) component.Component {
+	return punishmentMessage(protocol, "You are banned from this server.", p, "\n\n")
+}
+
+func muteMessage(protocol proto.Protocol, p punishment.Punishment) component.Component {
+	return punishmentMessage(protocol, "You are muted.", p, " ")
+}
@@ -0,0 +1,33 @@
+package proxy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+)
+
+func TestPunishmentsUnavailable(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{"lobby": "localhost:25566"}, nil, nil)
+	ps := newPunishments(proxy)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.False(t, ps.unavailable(), "punishments disabled")
+
+	file := filepath.Join(t.TempDir(), "punishments.yml")
+	require.NoError(t, os.WriteFile(file, []byte("{invalid"), 0o600))
+	ps.apply(ctx, config.Punishments{Enabled: true, File: file})
+	assert.True(t, ps.unavailable(), "logins must be denied if the file is invalid")
+
+	ps.apply(ctx, config.Punishments{Enabled: true, File: filepath.Join(t.TempDir(), "new.yml")})
+	assert.False(t, ps.unavailable())
+	assert.NotNil(t, ps.Store())
+
+	ps.apply(ctx, config.Punishments{})
+	assert.False(t, ps.unavailable())
+}
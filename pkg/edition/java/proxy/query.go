@@ -0,0 +1,412 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/errs"
+	gateversion "go.minekube.com/gate/pkg/version"
+)
+
+// QueryType is the type of GameSpy 4 query request.
+type QueryType uint8
+
+const (
+	// BasicQuery is a basic stat query that only includes
+	// the motd, map, player count and host address.
+	BasicQuery QueryType = iota
+	// FullQuery is a full stat query that additionally includes
+	// the player list, version information and plugins.
+	FullQuery
+)
+
+func (t QueryType) String() string {
+	switch t {
+	case BasicQuery:
+		return "basic"
+	case FullQuery:
+		return "full"
+	default:
+		return "unknown"
+	}
+}
+
+// QueryResponse is the response to a GameSpy 4 (Minecraft query protocol) request.
+// It can be modified in the ProxyQueryEvent.
+type QueryResponse struct {
+	Hostname       string        // The plain text motd of the proxy.
+	GameVersion    string        // The supported Minecraft versions.
+	Map            string        // The map name.
+	CurrentPlayers int           // The number of online players.
+	MaxPlayers     int           // The maximum number of players.
+	ProxyHost      string        // The host the proxy is listening on.
+	ProxyPort      int           // The port the proxy is listening on.
+	Players        []string      // The names of online players (full query only).
+	ProxyVersion   string        // The proxy software and version (full query only).
+	Plugins        []QueryPlugin // The plugins shown (full query only).
+}
+
+// QueryPlugin is a plugin shown in a full QueryResponse.
+type QueryPlugin struct {
+	Name    string
+	Version string // May be empty.
+}
+
+func (p QueryPlugin) String() string {
+	if p.Version == "" {
+		return p.Name
+	}
+	return p.Name + " " + p.Version
+}
+
+// Query protocol constants.
+const (
+	queryMagicFirst  = 0xFE
+	queryMagicSecond = 0xFD
+
+	queryTypeHandshake = 0x09
+	queryTypeStat      = 0x00
+
+	queryChallengeTTL = 30 * time.Second
+	queryMaxPacket    = 1460
+)
+
+var (
+	// Sent after the session id of a full stat response.
+	queryFullPadding = []byte{0x73, 0x70, 0x6C, 0x69, 0x74, 0x6E, 0x75, 0x6D, 0x00, 0x80, 0x00}
+	// Sent before the player list of a full stat response.
+	queryFullPlayersPadding = []byte{0x01, 0x70, 0x6C, 0x61, 0x79, 0x65, 0x72, 0x5F, 0x00, 0x00}
+)
+
+var errInvalidQuery = errors.New("invalid query packet")
+
+// queryHandler implements the GameSpy 4 query protocol independent of the transport.
+type queryHandler struct {
+	// response builds the response for a validated stat request.
+	response func(t QueryType, querier net.Addr) *QueryResponse
+	now      func() time.Time
+
+	mu         sync.Mutex
+	challenges map[netip.Addr]queryChallenge
+}
+
+type queryChallenge struct {
+	token   int32
+	expires time.Time
+}
+
+func newQueryHandler(response func(QueryType, net.Addr) *QueryResponse) *queryHandler {
+	return &queryHandler{
+		response:   response,
+		now:        time.Now,
+		challenges: map[netip.Addr]queryChallenge{},
+	}
+}
+
+// handle handles a single query packet and returns the response packet.
+// A nil response means nothing should be sent back.
+func (h *queryHandler) handle(querier *net.UDPAddr, req []byte) ([]byte, error) {
+	if len(req) < 7 || req[0] != queryMagicFirst || req[1] != queryMagicSecond {
+		return nil, errInvalidQuery
+	}
+	typ := req[2]
+	sessionID := req[3:7]
+	addr := querier.AddrPort().Addr().Unmap()
+
+	switch typ {
+	case queryTypeHandshake:
+		token, err := h.newChallenge(addr)
+		if err != nil {
+			return nil, err
+		}
+		b := new(bytes.Buffer)
+		b.WriteByte(queryTypeHandshake)
+		b.Write(sessionID)
+		writeQueryString(b, strconv.FormatInt(int64(token), 10))
+		return b.Bytes(), nil
+	case queryTypeStat:
+		if len(req) < 11 {
+			return nil, errInvalidQuery
+		}
+		token := int32(binary.BigEndian.Uint32(req[7:11]))
+		if !h.validChallenge(addr, token) {
+			return nil, nil // silently drop like vanilla
+		}
+		t := BasicQuery
+		if len(req) >= 15 {
+			t = FullQuery
+		}
+		res := h.response(t, querier)
+		if res == nil {
+			return nil, nil
+		}
+		return encodeQueryResponse(t, sessionID, res), nil
+	default:
+		return nil, errInvalidQuery
+	}
+}
+
+func (h *queryHandler) newChallenge(addr netip.Addr) (int32, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, err
+	}
+	token := int32(binary.BigEndian.Uint32(b[:]))
+	now := h.now()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for a, c := range h.challenges {
+		if now.After(c.expires) {
+			delete(h.challenges, a)
+		}
+	}
+	h.challenges[addr] = queryChallenge{token: token, expires: now.Add(queryChallengeTTL)}
+	return token, nil
+}
+
+func (h *queryHandler) validChallenge(addr netip.Addr, token int32) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c, ok := h.challenges[addr]
+	if !ok {
+		return false
+	}
+	if h.now().After(c.expires) {
+		delete(h.challenges, addr)
+		return false
+	}
+	return c.token == token
+}
+
+func encodeQueryResponse(t QueryType, sessionID []byte, res *QueryResponse) []byte {
+	b := new(bytes.Buffer)
+	b.WriteByte(queryTypeStat)
+	b.Write(sessionID)
+
+	if t == BasicQuery {
+		writeQueryString(b, res.Hostname)
+		writeQueryString(b, "SMP")
+		writeQueryString(b, res.Map)
+		writeQueryString(b, strconv.Itoa(res.CurrentPlayers))
+		writeQueryString(b, strconv.Itoa(res.MaxPlayers))
+		_ = binary.Write(b, binary.LittleEndian, uint16(res.ProxyPort))
+		writeQueryString(b, res.ProxyHost)
+		return b.Bytes()
+	}
+
+	b.Write(queryFullPadding)
+	kv := func(k, v string) {
+		writeQueryString(b, k)
+		writeQueryString(b, v)
+	}
+	kv("hostname", res.Hostname)
+	kv("gametype", "SMP")
+	kv("game_id", "MINECRAFT")
+	kv("version", res.GameVersion)
+	kv("plugins", queryPluginsString(res.ProxyVersion, res.Plugins))
+	kv("map", res.Map)
+	kv("numplayers", strconv.Itoa(res.CurrentPlayers))
+	kv("maxplayers", strconv.Itoa(res.MaxPlayers))
+	kv("hostport", strconv.Itoa(res.ProxyPort))
+	kv("hostip", res.ProxyHost)
+	writeQueryString(b, "")
+
+	b.Write(queryFullPlayersPadding)
+	for _, name := range res.Players {
+		writeQueryString(b, name)
+	}
+	writeQueryString(b, "")
+	return b.Bytes()
+}
+
+// queryPluginsString formats plugins like vanilla Bukkit servers do,
+// e.g. "Gate 1.0.0: PluginA 1.0; PluginB".
+func queryPluginsString(proxyVersion string, plugins []QueryPlugin) string {
+	if len(plugins) == 0 {
+		return proxyVersion
+	}
+	s := make([]string, 0, len(plugins))
+	for _, pl := range plugins {
+		s = append(s, pl.String())
+	}
+	return proxyVersion + ": " + strings.Join(s, "; ")
+}
+
+func writeQueryString(b *bytes.Buffer, s string) {
+	b.WriteString(s)
+	b.WriteByte(0)
+}
+
+//
+//
+//
+//
+//
+
+// queryServer runs the GameSpy 4 query listener of a Proxy
+// and restarts it when the query config changes.
+type queryServer struct {
+	proxy *Proxy
+
+	mu      sync.Mutex
+	current config.Query
+	addr    string
+	stop    context.CancelFunc
+}
+
+func newQueryServer(p *Proxy) *queryServer {
+	return &queryServer{proxy: p}
+}
+
+// run starts the query listener if enabled and keeps it in sync
+// with config updates until ctx is canceled.
+func (s *queryServer) run(ctx context.Context) {
+	defer reload.Subscribe(s.proxy.event, func(e *javaConfigUpdateEvent) {
+		if e == nil || e.Config == nil {
+			return
+		}
+		s.apply(ctx, e.Config)
+	})()
+
+	s.apply(ctx, s.proxy.config())
+	<-ctx.Done()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		s.stop()
+		s.stop = nil
+	}
+}
+
+func (s *queryServer) apply(ctx context.Context, cfg *config.Config) {
+	addr := queryBindAddr(cfg.Bind, cfg.Query.Port)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stop != nil && s.current == cfg.Query && s.addr == addr {
+		return // no change
+	}
+	s.current = cfg.Query
+	s.addr = addr
+
+	if s.stop != nil {
+		s.stop()
+		s.stop = nil
+	}
+	if !cfg.Query.Enabled {
+		return
+	}
+
+	var runCtx context.Context
+	runCtx, s.stop = context.WithCancel(ctx)
+	go func() {
+		if err := s.proxy.listenAndServeQuery(runCtx, addr); err != nil {
+			s.proxy.log.Error(err, "error serving query listener", "addr", addr)
+		}
+	}()
+}
+
+// queryBindAddr returns the query listen address using the host of the proxy bind address.
+func queryBindAddr(bind string, port int) string {
+	host, _, err := net.SplitHostPort(bind)
+	if err != nil {
+		host = ""
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// listenAndServeQuery serves GameSpy 4 query requests on the UDP addr until ctx is canceled.
+func (p *Proxy) listenAndServeQuery(ctx context.Context, addr string) error {
+	var lc net.ListenConfig
+	pc, err := lc.ListenPacket(ctx, "udp", addr)
+	if err != nil {
+		return fmt.Errorf("error listening for query requests: %w", err)
+	}
+	conn := pc.(*net.UDPConn)
+	go func() { <-ctx.Done(); _ = conn.Close() }()
+
+	log := p.log.WithName("query")
+	log.Info("listening for query requests", "addr", addr)
+	defer log.Info("stopped listening for query requests", "addr", addr)
+
+	h := newQueryHandler(p.queryResponse)
+	buf := make([]byte, queryMaxPacket)
+	for {
+		n, querier, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if ctx.Err() != nil || errs.IsConnClosedErr(err) {
+				return nil
+			}
+			return fmt.Errorf("error reading query request: %w", err)
+		}
+		res, err := h.handle(querier, buf[:n])
+		if err != nil {
+			log.V(1).Info("dropped query request", "querier", querier, "error", err)
+			continue
+		}
+		if res == nil {
+			continue
+		}
+		if _, err = conn.WriteToUDP(res, querier); err != nil {
+			log.V(1).Info("error writing query response", "querier", querier, "error", err)
+		}
+	}
+}
+
+// queryResponse builds the QueryResponse from the live proxy state
+// and fires the ProxyQueryEvent.
+func (p *Proxy) queryResponse(t QueryType, querier net.Addr) *QueryResponse {
+	cfg := p.config()
+
+	host, portStr, _ := net.SplitHostPort(cfg.Bind)
+	port, _ := strconv.Atoi(portStr)
+	motd, _, _ := cfg.Status.Resolve("", time.Now())
+	hostname, err := util.MarshalPlain(p.renderPlaceholders(nil, motd.C()))
+	if err != nil {
+		p.log.V(1).Info("error marshal motd to plain text", "error", err)
+	}
+
+	res := &QueryResponse{
+		Hostname:       hostname,
+		GameVersion:    version.SupportedVersionsString,
+		Map:            "Gate",
+		CurrentPlayers: p.PlayerCount(),
+		MaxPlayers:     cfg.Status.ShowMaxPlayers,
+		ProxyHost:      host,
+		ProxyPort:      port,
+		ProxyVersion:   "Gate " + gateversion.String(),
+	}
+	for _, player := range p.Players() {
+		res.Players = append(res.Players, player.Username())
+	}
+	if cfg.Query.ShowPlugins {
+		for _, pl := range Plugins {
+			res.Plugins = append(res.Plugins, QueryPlugin{Name: pl.Name})
+		}
+	}
+
+	e := &ProxyQueryEvent{
+		queryType: t,
+		querier:   querier,
+		response:  res,
+	}
+	p.event.Fire(e)
+	return e.response
+}
@@ -0,0 +1,106 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func queryTestHandshake(t *testing.T, h *queryHandler, querier *net.UDPAddr) []byte {
+	t.Helper()
+	res, err := h.handle(querier, []byte{0xFE, 0xFD, 0x09, 0, 0, 0, 1})
+	require.NoError(t, err)
+	require.Equal(t, byte(0x09), res[0])
+	require.Equal(t, []byte{0, 0, 0, 1}, res[1:5])
+	require.Equal(t, byte(0), res[len(res)-1])
+
+	token, err := strconv.ParseInt(string(res[5:len(res)-1]), 10, 32)
+	require.NoError(t, err)
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(int32(token)))
+	return b
+}
+
+func TestQueryHandler(t *testing.T) {
+	querier := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000}
+	var gotType QueryType
+	h := newQueryHandler(func(typ QueryType, _ net.Addr) *QueryResponse {
+		gotType = typ
+		return &QueryResponse{
+			Hostname:       "A Gate Proxy",
+			GameVersion:    "1.8-1.21",
+			Map:            "Gate",
+			CurrentPlayers: 2,
+			MaxPlayers:     100,
+			ProxyHost:      "0.0.0.0",
+			ProxyPort:      25565,
+			Players:        []string{"alice", "bob"},
+			ProxyVersion:   "Gate v1",
+			Plugins:        []QueryPlugin{{Name: "a", Version: "1.0"}, {Name: "b"}},
+		}
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		token := queryTestHandshake(t, h, querier)
+		req := append([]byte{0xFE, 0xFD, 0x00, 0, 0, 0, 1}, token...)
+		res, err := h.handle(querier, req)
+		require.NoError(t, err)
+		require.Equal(t, BasicQuery, gotType)
+
+		want := new(bytes.Buffer)
+		want.Write([]byte{0x00, 0, 0, 0, 1})
+		want.WriteString("A Gate Proxy\x00SMP\x00Gate\x002\x00100\x00")
+		want.Write([]byte{0xDD, 0x63}) // 25565 little endian
+		want.WriteString("0.0.0.0\x00")
+		require.Equal(t, want.Bytes(), res)
+	})
+
+	t.Run("full", func(t *testing.T) {
+		token := queryTestHandshake(t, h, querier)
+		req := append(append([]byte{0xFE, 0xFD, 0x00, 0, 0, 0, 1}, token...), 0, 0, 0, 0)
+		res, err := h.handle(querier, req)
+		require.NoError(t, err)
+		require.Equal(t, FullQuery, gotType)
+		require.True(t, bytes.HasPrefix(res[5:], queryFullPadding))
+		require.Contains(t, string(res), "plugins\x00Gate v1: a 1.0; b\x00")
+		require.Contains(t, string(res), "numplayers\x002\x00maxplayers\x00100\x00")
+		require.True(t, bytes.HasSuffix(res, append(queryFullPlayersPadding, "alice\x00bob\x00\x00"...)))
+	})
+
+	t.Run("invalid challenge", func(t *testing.T) {
+		other := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 2), Port: 50000}
+		token := queryTestHandshake(t, h, querier)
+		req := append([]byte{0xFE, 0xFD, 0x00, 0, 0, 0, 1}, token...)
+		res, err := h.handle(other, req)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("expired challenge", func(t *testing.T) {
+		token := queryTestHandshake(t, h, querier)
+		h.now = func() time.Time { return time.Now().Add(queryChallengeTTL + time.Second) }
+		defer func() { h.now = time.Now }()
+		req := append([]byte{0xFE, 0xFD, 0x00, 0, 0, 0, 1}, token...)
+		res, err := h.handle(querier, req)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := h.handle(querier, []byte{0xFE, 0xFD, 0x00})
+		require.ErrorIs(t, err, errInvalidQuery)
+		_, err = h.handle(querier, []byte{0x00, 0x00, 0x09, 0, 0, 0, 1})
+		require.ErrorIs(t, err, errInvalidQuery)
+	})
+}
+
+func TestQueryBindAddr(t *testing.T) {
+	require.Equal(t, "0.0.0.0:25577", queryBindAddr("0.0.0.0:25565", 25577))
+	require.Equal(t, "[::1]:1234", queryBindAddr("[::1]:25565", 1234))
+	require.Equal(t, ":25577", queryBindAddr("invalid", 25577))
+}
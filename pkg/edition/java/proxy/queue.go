@@ -0,0 +1,480 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robinbraemer/event"
+	"go.minekube.com/common/minecraft/color"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/bossbar"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// QueueBypassPermission is the permission that lets players connect
+// to full or offline servers without being queued.
+const QueueBypassPermission = "gate.queue.bypass"
+
+// queueRefusedBackoff is how long a player the server disconnected waits
+// before the next attempt, letting the players behind it try meanwhile.
+const queueRefusedBackoff = 30 * time.Second
+
+// ErrQueueDisabled is returned when queuing a player while queues are disabled in the config.
+var ErrQueueDisabled = errors.New("queues are disabled")
+
+// Queues manages the per-server connection queues of players waiting
+// for a full or offline server. Queued players are connected automatically
+// once the server has a free slot or is back up.
+//
+// Players are queued instead of getting an error when a connection request
+// with indication (e.g. the /server command) hits a full or offline server
+// while they are connected to another server.
+// Whether a server is full or offline is determined by the health checks,
+// see config.HealthCheck.
+type Queues struct {
+	proxy *Proxy
+
+	mu      sync.Mutex
+	queues  map[string][]*queueEntry // by lower case server name, ordered by position
+	players map[uuid.UUID]*queueEntry
+}
+
+type queueEntry struct {
+	player     *connectedPlayer
+	server     RegisteredServer
+	priority   int
+	joined     time.Time
+	connecting bool            // Whether a connection to the server is in flight.
+	refused    bool            // Whether the server disconnected the player on the last attempt.
+	retryAt    time.Time       // The player is not connected again before this time after being refused.
+	bar        bossbar.BossBar // nil if boss bars are disabled
+}
+
+// QueueEntry is a player waiting in the queue of a server.
+type QueueEntry struct {
+	Player   Player
+	Server   RegisteredServer
+	Position int // The 1-based position in the server's queue.
+	Size     int // The number of players in the server's queue.
+	Priority int // Players with higher priority are served first.
+	Joined   time.Time
+}
+
+func newQueues(p *Proxy) *Queues {
+	return &Queues{
+		proxy:   p,
+		queues:  map[string][]*queueEntry{},
+		players: map[uuid.UUID]*queueEntry{},
+	}
+}
+
+func (q *Queues) config() config.Queue {
+	return q.proxy.config().Queue
+}
+
+// Enqueue adds the player to the queue of the server with the highest priority
+// of the configured priority permissions the player has.
+// A player is only queued for one server at a time, queuing for another server
+// moves the player to the other queue.
+func (q *Queues) Enqueue(player Player, server RegisteredServer) (QueueEntry, error) {
+	return q.EnqueueWithPriority(player, server, q.permissionPriority(player))
+}
+
+// EnqueueWithPriority adds the player to the queue of the server with the given priority.
+// If the player is already queued for the server, only the priority is updated.
+func (q *Queues) EnqueueWithPriority(player Player, server RegisteredServer, priority int) (QueueEntry, error) {
+	if !q.config().Enabled {
+		return QueueEntry{}, ErrQueueDisabled
+	}
+	p, ok := player.(*connectedPlayer)
+	if !ok || server == nil {
+		return QueueEntry{}, errors.New("invalid player or server")
+	}
+
+	q.mu.Lock()
+	en, queued := q.players[p.ID()]
+	var removedBar bossbar.BossBar
+	if queued && !RegisteredServerEqual(en.server, server) {
+		removedBar = en.bar
+		q.remove(en)
+		queued = false
+	}
+	if !queued {
+		en = &queueEntry{player: p, server: server, joined: time.Now()}
+		if q.config().BossBar {
+			en.bar = bossbar.New(&component.Text{}, bossbar.MinProgress, bossbar.YellowColor, bossbar.ProgressOverlay)
+		}
+	} else {
+		q.remove(en)
+	}
+	en.priority = priority
+	q.insert(en)
+	entry := q.entry(en)
+	q.mu.Unlock()
+
+	if removedBar != nil {
+		_ = removedBar.RemoveViewer(p)
+	}
+	if en.bar != nil {
+		_ = en.bar.AddViewer(p)
+	}
+	q.notify(en, entry.Position, entry.Size)
+	return entry, nil
+}
+
+// Dequeue removes the player from its queue and returns false if the player was not queued.
+func (q *Queues) Dequeue(player Player) bool {
+	q.mu.Lock()
+	en, ok := q.players[player.ID()]
+	if ok {
+		q.remove(en)
+	}
+	q.mu.Unlock()
+	if ok && en.bar != nil {
+		_ = en.bar.RemoveViewer(en.player)
+	}
+	return ok
+}
+
+// Entry returns the queue entry of the player and false if the player is not queued.
+func (q *Queues) Entry(player Player) (QueueEntry, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	en, ok := q.players[player.ID()]
+	if !ok {
+		return QueueEntry{}, false
+	}
+	return q.entry(en), true
+}
+
+// Entries returns the players queued for the server in order.
+func (q *Queues) Entries(server string) []QueueEntry {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	queue := q.queues[strings.ToLower(server)]
+	entries := make([]QueueEntry, 0, len(queue))
+	for i, en := range queue {
+		entries = append(entries, q.entryAt(en, i, len(queue)))
+	}
+	return entries
+}
+
+// Servers returns the names of the servers with queued players.
+func (q *Queues) Servers() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	names := make([]string, 0, len(q.queues))
+	for _, queue := range q.queues {
+		names = append(names, queue[0].server.ServerInfo().Name())
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Clear removes all players from the queue of the server and returns the number of removed players.
+func (q *Queues) Clear(server string) int {
+	q.mu.Lock()
+	queue := slices.Clone(q.queues[strings.ToLower(server)])
+	for _, en := range queue {
+		q.remove(en)
+	}
+	q.mu.Unlock()
+	for _, en := range queue {
+		if en.bar != nil {
+			_ = en.bar.RemoveViewer(en.player)
+		}
+	}
+	return len(queue)
+}
+
+// insert adds the entry behind all entries with a higher or the same priority that joined earlier.
+// q.mu must be held.
+func (q *Queues) insert(en *queueEntry) {
+	key := strings.ToLower(en.server.ServerInfo().Name())
+	queue := q.queues[key]
+	i := slices.IndexFunc(queue, func(other *queueEntry) bool {
+		return other.priority < en.priority ||
+			other.priority == en.priority && other.joined.After(en.joined)
+	})
+	if i == -1 {
+		i = len(queue)
+	}
+	q.queues[key] = slices.Insert(queue, i, en)
+	q.players[en.player.ID()] = en
+}
+
+// remove removes the entry from its queue. q.mu must be held.
+func (q *Queues) remove(en *queueEntry) {
+	key := strings.ToLower(en.server.ServerInfo().Name())
+	queue := slices.DeleteFunc(q.queues[key], func(other *queueEntry) bool { return other == en })
+	if len(queue) == 0 {
+		delete(q.queues, key)
+	} else {
+		q.queues[key] = queue
+	}
+	if q.players[en.player.ID()] == en {
+		delete(q.players, en.player.ID())
+	}
+}
+
+// entry returns the snapshot of the entry. q.mu must be held.
+func (q *Queues) entry(en *queueEntry) QueueEntry {
+	queue := q.queues[strings.ToLower(en.server.ServerInfo().Name())]
+	return q.entryAt(en, slices.Index(queue, en), len(queue))
+}
+
+func (q *Queues) entryAt(en *queueEntry, index, size int) QueueEntry {
+	return QueueEntry{
+		Player:   en.player,
+		Server:   en.server,
+		Position: index + 1,
+		Size:     size,
+		Priority: en.priority,
+		Joined:   en.joined,
+	}
+}
+
+// permissionPriority returns the highest configured priority of the permissions the player has.
+func (q *Queues) permissionPriority(player Player) int {
+	var priority int
+	for permission, p := range q.config().Priorities {
+		if p > priority && player.HasPermission(permission) {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// freeSlots returns the number of players that may be sent to the server now.
+// It is 0 if the server is down or full and 1 if the server's capacity is unknown.
+func freeSlots(server RegisteredServer) int {
+	if HealthOf(server) == ServerHealthDown {
+		return 0
+	}
+	rs, ok := server.(*registeredServer)
+	if !ok {
+		return 1
+	}
+	maxPlayers := int(rs.pingMax.Load())
+	if maxPlayers <= 0 {
+		return 1
+	}
+	return max(0, maxPlayers-int(rs.pingOnline.Load()))
+}
+
+// queueIfUnavailable queues the player if the server is full or offline
+// and returns true if the player was queued.
+func (q *Queues) queueIfUnavailable(player *connectedPlayer, server RegisteredServer) bool {
+	if !q.shouldQueue(player, server) || freeSlots(server) > 0 {
+		return false
+	}
+	reason := "full"
+	if HealthOf(server) == ServerHealthDown {
+		reason = "offline"
+	}
+	return q.queue(player, server, reason)
+}
+
+// queueAfterFailure queues the player after the connection to the server failed
+// and returns true if the player was queued.
+func (q *Queues) queueAfterFailure(player *connectedPlayer, server RegisteredServer) bool {
+	return q.shouldQueue(player, server) && q.queue(player, server, "unreachable")
+}
+
+// shouldQueue returns true if queues are enabled and the player can wait on its current server.
+func (q *Queues) shouldQueue(player *connectedPlayer, server RegisteredServer) bool {
+	if q == nil || !q.config().Enabled || player.HasPermission(QueueBypassPermission) {
+		return false
+	}
+	current := player.CurrentServer()
+	return current != nil && !RegisteredServerEqual(current.Server(), server)
+}
+
+func (q *Queues) queue(player *connectedPlayer, server RegisteredServer, reason string) bool {
+	entry, err := q.Enqueue(player, server)
+	if err != nil {
+		return false
+	}
+	_ = player.SendMessage(&component.Text{
+		Content: fmt.Sprintf("%s is %s, you were added to its queue at position %d of %d.",
+			server.ServerInfo().Name(), reason, entry.Position, entry.Size),
+		S: component.Style{Color: color.Yellow},
+	})
+	return true
+}
+
+// run connects queued players and notifies them about their position until ctx is canceled.
+func (q *Queues) run(ctx context.Context) {
+	defer event.Subscribe(q.proxy.event, 0, func(e *DisconnectEvent) {
+		q.Dequeue(e.Player())
+	})()
+	defer event.Subscribe(q.proxy.event, 0, func(e *ServerPostConnectEvent) {
+		q.dequeueIfConnected(e.Player())
+	})()
+	defer event.Subscribe(q.proxy.event, 0, func(e *ServerUnregisteredEvent) {
+		q.Clear(e.ServerInfo().Name())
+	})()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		cfg := q.config()
+		if cfg.Enabled {
+			q.tick()
+		}
+		interval := time.Duration(cfg.Interval)
+		if interval <= 0 {
+			interval = time.Duration(config.DefaultConfig.Queue.Interval)
+		}
+		timer.Reset(interval)
+	}
+}
+
+// dequeueIfConnected removes the player from its queue if it is connected to the queued server.
+func (q *Queues) dequeueIfConnected(player Player) {
+	current := player.CurrentServer()
+	if current == nil {
+		return
+	}
+	if entry, ok := q.Entry(player); ok && RegisteredServerEqual(entry.Server, current.Server()) {
+		q.Dequeue(player)
+	}
+}
+
+// tick connects the first players of each queue for which the server has free slots
+// and notifies all queued players about their position.
+func (q *Queues) tick() {
+	type notification struct {
+		en             *queueEntry
+		position, size int
+	}
+	var (
+		connect []*queueEntry
+		notify  []notification
+	)
+	now := time.Now()
+	q.mu.Lock()
+	for _, queue := range q.queues {
+		connect = append(connect, nextConnects(queue, freeSlots(queue[0].server), now)...)
+		for i, en := range queue {
+			notify = append(notify, notification{en: en, position: i + 1, size: len(queue)})
+		}
+	}
+	q.mu.Unlock()
+
+	for _, en := range connect {
+		go q.connect(en)
+	}
+	for _, n := range notify {
+		q.notify(n.en, n.position, n.size)
+	}
+}
+
+// nextConnects marks the first entries of the queue that fit into the free slots as connecting
+// and returns them. Entries already connecting take a slot, while refused entries waiting
+// for their retry are skipped so they don't block the queue. q.mu must be held.
+func nextConnects(queue []*queueEntry, free int, now time.Time) []*queueEntry {
+	var connect []*queueEntry
+	for _, en := range queue {
+		if free <= 0 {
+			break
+		}
+		switch {
+		case en.connecting:
+			free--
+		case now.Before(en.retryAt):
+		default:
+			en.connecting = true
+			connect = append(connect, en)
+			free--
+		}
+	}
+	return connect
+}
+
+// connect connects the queued player to the server and removes the player from the queue
+// if the connection succeeded. Otherwise, the player stays queued.
+//
+// A server disconnecting the player (e.g. because it is full) is considered full
+// until the next health check reports its player count again, and the player is retried
+// after queueRefusedBackoff.
+func (q *Queues) connect(en *queueEntry) {
+	ctx, cancel := withConnectionTimeout(en.player.Context(), q.proxy.config())
+	defer cancel()
+	result, err := en.player.CreateConnectionRequest(en.server).Connect(ctx)
+
+	q.mu.Lock()
+	en.connecting = false
+	q.mu.Unlock()
+
+	switch {
+	case err != nil:
+		en.player.log.V(1).Info("could not connect queued player", "server", en.server.ServerInfo().Name(), "error", err)
+	case result.Status().Successful(), result.Status() == AlreadyConnectedConnectionStatus:
+		if rs, ok := en.server.(*registeredServer); ok {
+			rs.pingOnline.Inc() // count the player until the next health check
+		}
+		q.dequeueEntry(en)
+	case result.Status() == ServerDisconnectedConnectionStatus:
+		if rs, ok := en.server.(*registeredServer); ok {
+			rs.pingOnline.Store(rs.pingMax.Load())
+		}
+		q.mu.Lock()
+		notify := !en.refused
+		en.refused = true
+		en.retryAt = time.Now().Add(queueRefusedBackoff)
+		q.mu.Unlock()
+		if !notify {
+			return
+		}
+		reason := result.Reason()
+		if reason == nil {
+			reason = internalServerConnectionError
+		}
+		_ = en.player.SendMessage(&component.Text{
+			Content: fmt.Sprintf("Could not join %s, you stay in its queue: ", en.server.ServerInfo().Name()),
+			S:       component.Style{Color: color.Red},
+			Extra:   []component.Component{reason},
+		})
+	}
+}
+
+// dequeueEntry removes the entry if it is still the player's entry.
+func (q *Queues) dequeueEntry(en *queueEntry) {
+	q.mu.Lock()
+	current, ok := q.players[en.player.ID()]
+	if ok && current == en {
+		q.remove(en)
+	}
+	q.mu.Unlock()
+	if ok && current == en && en.bar != nil {
+		_ = en.bar.RemoveViewer(en.player)
+	}
+}
+
+// notify shows the player its queue position as configured.
+func (q *Queues) notify(en *queueEntry, position, size int) {
+	msg := &component.Text{
+		Content: fmt.Sprintf("Queued for %s: position %d of %d", en.server.ServerInfo().Name(), position, size),
+		S:       component.Style{Color: color.Yellow},
+	}
+	if q.config().ActionBar {
+		_ = en.player.SendActionBar(msg)
+	}
+	if en.bar != nil {
+		en.bar.SetName(msg)
+		en.bar.SetPercent(max(bossbar.MinProgress, min(bossbar.MaxProgress, 1-float32(position-1)/float32(size))))
+	}
+}
@@ -0,0 +1,169 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/profile"
+	"go.minekube.com/gate/pkg/util/permission"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func newTestQueues(t *testing.T) (*Proxy, *Queues) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"lobby":    "localhost:25566",
+		"survival": "localhost:25567",
+	}, nil, nil)
+	proxy.cfg.Queue = config.Queue{
+		Enabled:    true,
+		Priorities: map[string]int{"queue.vip": 10, "queue.staff": 100},
+	}
+	return proxy, newQueues(proxy)
+}
+
+func newTestQueuePlayer(name string, permissions ...string) *connectedPlayer {
+	return &connectedPlayer{
+		profile: &profile.GameProfile{ID: uuid.New(), Name: name},
+		permFunc: func(perm string) permission.TriState {
+			for _, p := range permissions {
+				if p == perm {
+					return permission.True
+				}
+			}
+			return permission.Undefined
+		},
+	}
+}
+
+func queuedNames(entries []QueueEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Player.Username())
+	}
+	return names
+}
+
+func TestQueuesOrder(t *testing.T) {
+	proxy, q := newTestQueues(t)
+	lobby := proxy.Server("lobby")
+
+	for _, p := range []*connectedPlayer{
+		newTestQueuePlayer("alice"),
+		newTestQueuePlayer("bob", "queue.vip"),
+		newTestQueuePlayer("carol"),
+		newTestQueuePlayer("dave", "queue.vip", "queue.staff"),
+	} {
+		_, err := q.Enqueue(p, lobby)
+		require.NoError(t, err)
+	}
+
+	entries := q.Entries("LOBBY")
+	assert.Equal(t, []string{"dave", "bob", "alice", "carol"}, queuedNames(entries))
+	assert.Equal(t, 100, entries[0].Priority)
+	for i, e := range entries {
+		assert.Equal(t, i+1, e.Position)
+		assert.Equal(t, 4, e.Size)
+	}
+	assert.Equal(t, []string{"lobby"}, q.Servers())
+}
+
+func TestQueuesEnqueueMovesPlayer(t *testing.T) {
+	proxy, q := newTestQueues(t)
+	alice := newTestQueuePlayer("alice")
+	bob := newTestQueuePlayer("bob")
+
+	_, err := q.Enqueue(alice, proxy.Server("lobby"))
+	require.NoError(t, err)
+	_, err = q.Enqueue(bob, proxy.Server("lobby"))
+	require.NoError(t, err)
+
+	// Changing the priority keeps the player in the same queue.
+	entry, err := q.EnqueueWithPriority(bob, proxy.Server("lobby"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, entry.Position)
+	assert.Equal(t, []string{"bob", "alice"}, queuedNames(q.Entries("lobby")))
+
+	// A player is only queued for one server at a time.
+	entry, err = q.Enqueue(alice, proxy.Server("survival"))
+	require.NoError(t, err)
+	assert.Equal(t, "survival", entry.Server.ServerInfo().Name())
+	assert.Equal(t, []string{"bob"}, queuedNames(q.Entries("lobby")))
+	assert.Equal(t, []string{"lobby", "survival"}, q.Servers())
+}
+
+func TestQueuesDequeueAndClear(t *testing.T) {
+	proxy, q := newTestQueues(t)
+	alice := newTestQueuePlayer("alice")
+	bob := newTestQueuePlayer("bob")
+	carol := newTestQueuePlayer("carol")
+	for _, p := range []*connectedPlayer{alice, bob, carol} {
+		_, err := q.Enqueue(p, proxy.Server("lobby"))
+		require.NoError(t, err)
+	}
+
+	assert.True(t, q.Dequeue(bob))
+	assert.False(t, q.Dequeue(bob))
+	_, ok := q.Entry(bob)
+	assert.False(t, ok)
+
+	entry, ok := q.Entry(carol)
+	require.True(t, ok)
+	assert.Equal(t, 2, entry.Position)
+
+	assert.Equal(t, 2, q.Clear("lobby"))
+	assert.Empty(t, q.Entries("lobby"))
+	assert.Empty(t, q.Servers())
+	_, ok = q.Entry(alice)
+	assert.False(t, ok)
+}
+
+func TestQueuesDisabled(t *testing.T) {
+	proxy, q := newTestQueues(t)
+	proxy.cfg.Queue.Enabled = false
+
+	_, err := q.Enqueue(newTestQueuePlayer("alice"), proxy.Server("lobby"))
+	assert.ErrorIs(t, err, ErrQueueDisabled)
+}
+
+func TestFreeSlots(t *testing.T) {
+	proxy, _ := newTestQueues(t)
+	lobby := proxy.server("lobby")
+
+	// Unknown capacity lets one player through at a time.
+	assert.Equal(t, 1, freeSlots(lobby))
+
+	lobby.pingMax.Store(10)
+	lobby.pingOnline.Store(7)
+	assert.Equal(t, 3, freeSlots(lobby))
+
+	lobby.pingOnline.Store(12)
+	assert.Equal(t, 0, freeSlots(lobby))
+
+	lobby.pingOnline.Store(0)
+	lobby.health.Store(int32(ServerHealthDown))
+	assert.Equal(t, 0, freeSlots(lobby))
+}
+
+func TestNextConnects(t *testing.T) {
+	now := time.Now()
+	alice := &queueEntry{player: newTestQueuePlayer("alice"), refused: true, retryAt: now.Add(time.Second)}
+	bob := &queueEntry{player: newTestQueuePlayer("bob")}
+	carol := &queueEntry{player: newTestQueuePlayer("carol")}
+	queue := []*queueEntry{alice, bob, carol}
+
+	// A refused player waiting for its retry doesn't block the players behind it.
+	assert.Equal(t, []*queueEntry{bob}, nextConnects(queue, 1, now))
+	assert.True(t, bob.connecting)
+
+	// Players still connecting take their slot.
+	assert.Empty(t, nextConnects(queue, 1, now))
+	assert.Equal(t, []*queueEntry{carol}, nextConnects(queue, 2, now))
+
+	bob.connecting, carol.connecting = false, false
+	assert.Equal(t, []*queueEntry{alice}, nextConnects(queue, 1, now.Add(time.Second)))
+	assert.Empty(t, nextConnects(queue, 0, now.Add(time.Second)))
+}
@@ -181,6 +181,12 @@ func RegisteredServerEqual(a, b RegisteredServer) bool {
 type registeredServer struct {
 	info    ServerInfo
 	players *players
+
+	health      atomic.Int32 // ServerHealth
+	latency     atomic.Int64 // Latency of the last successful health check ping in nanoseconds.
+	pingOnline  atomic.Int32 // Online players reported by the last successful health check ping.
+	pingMax     atomic.Int32 // Max players reported by the last successful health check ping, 0 if unknown.
+	failedPings int          // Consecutive failed health check pings, only used by the healthChecker.
 }
 
 func newRegisteredServer(info ServerInfo) *registeredServer {
@@ -195,6 +201,11 @@ func (r *registeredServer) Players() Players {
 	return r.players
 }
 
+// Health returns the health of the server, ServerHealthUnknown if not checked.
+func (r *registeredServer) Health() ServerHealth {
+	return ServerHealth(r.health.Load())
+}
+
 var _ RegisteredServer = (*registeredServer)(nil)
 
 // BroadcastPluginMessage sends the plugin message to all players on the server.
@@ -0,0 +1,97 @@
+package proxy
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+)
+
+// serverGroups orders the servers of the configured server groups by the group's strategy.
+// The zero value is ready to use.
+type serverGroups struct {
+	mu         sync.Mutex
+	roundRobin map[string]int // next start index by group name
+}
+
+// resolveServersToTry returns the server names to try in order,
+// with server groups expanded to their servers in the order of the group's strategy.
+// Each server is only returned once.
+func (p *Proxy) resolveServersToTry(cfg *config.Config, names []string) []string {
+	if len(cfg.ServerGroups) == 0 {
+		return names
+	}
+	resolved := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	add := func(name string) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		resolved = append(resolved, name)
+	}
+	for _, name := range names {
+		group, ok := cfg.ServerGroups[name]
+		if !ok {
+			add(name)
+			continue
+		}
+		for _, server := range p.orderServerGroup(name, group) {
+			add(server)
+		}
+	}
+	return resolved
+}
+
+// orderServerGroup returns the servers of the group in the order of the group's strategy.
+func (p *Proxy) orderServerGroup(name string, group config.ServerGroup) []string {
+	servers := slices.Clone(group.Servers)
+	switch group.Strategy {
+	case liteconfig.StrategyRandom:
+		rand.Shuffle(len(servers), func(i, j int) {
+			servers[i], servers[j] = servers[j], servers[i]
+		})
+	case liteconfig.StrategyRoundRobin:
+		if len(servers) == 0 {
+			break
+		}
+		g := &p.serverGroups
+		g.mu.Lock()
+		if g.roundRobin == nil {
+			g.roundRobin = map[string]int{}
+		}
+		start := g.roundRobin[name] % len(servers)
+		g.roundRobin[name] = start + 1
+		g.mu.Unlock()
+		servers = slices.Concat(servers[start:], servers[:start])
+	case liteconfig.StrategyLeastConnections:
+		// Unregistered servers are tried last.
+		players := func(name string) int {
+			if s := p.server(name); s != nil {
+				return s.players.Len()
+			}
+			return math.MaxInt
+		}
+		slices.SortStableFunc(servers, func(a, b string) int {
+			return cmp.Compare(players(a), players(b))
+		})
+	case liteconfig.StrategyLowestLatency:
+		// Servers without a health check latency are tried last.
+		latency := func(name string) int64 {
+			if s := p.server(name); s != nil {
+				if l := s.latency.Load(); l > 0 {
+					return l
+				}
+			}
+			return math.MaxInt64
+		}
+		slices.SortStableFunc(servers, func(a, b string) int {
+			return cmp.Compare(latency(a), latency(b))
+		})
+	}
+	return servers
+}
@@ -0,0 +1,112 @@
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	liteconfig "go.minekube.com/gate/pkg/edition/java/lite/config"
+	"go.minekube.com/gate/pkg/edition/java/profile"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestResolveServersToTry(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"lobby1":   "localhost:25566",
+		"lobby2":   "localhost:25567",
+		"lobby3":   "localhost:25568",
+		"survival": "localhost:25569",
+	}, nil, nil)
+	cfg := &config.Config{ServerGroups: map[string]config.ServerGroup{
+		"lobbies": {Servers: []string{"lobby1", "lobby2", "lobby3"}},
+	}}
+
+	// Groups are expanded in place and servers are only tried once.
+	resolved := proxy.resolveServersToTry(cfg, []string{"lobby2", "lobbies", "survival"})
+	assert.Equal(t, []string{"lobby2", "lobby1", "lobby3", "survival"}, resolved)
+}
+
+func TestOrderServerGroup(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"lobby1": "localhost:25566",
+		"lobby2": "localhost:25567",
+		"lobby3": "localhost:25568",
+	}, nil, nil)
+	servers := []string{"lobby1", "lobby2", "lobby3"}
+	group := func(strategy liteconfig.Strategy) config.ServerGroup {
+		return config.ServerGroup{Servers: servers, Strategy: strategy}
+	}
+
+	t.Run("sequential", func(t *testing.T) {
+		assert.Equal(t, servers, proxy.orderServerGroup("lobbies", group(liteconfig.StrategySequential)))
+		assert.Equal(t, servers, proxy.orderServerGroup("lobbies", group("")))
+	})
+
+	t.Run("random", func(t *testing.T) {
+		assert.ElementsMatch(t, servers, proxy.orderServerGroup("lobbies", group(liteconfig.StrategyRandom)))
+	})
+
+	t.Run("round-robin", func(t *testing.T) {
+		g := group(liteconfig.StrategyRoundRobin)
+		assert.Equal(t, []string{"lobby1", "lobby2", "lobby3"}, proxy.orderServerGroup("rr", g))
+		assert.Equal(t, []string{"lobby2", "lobby3", "lobby1"}, proxy.orderServerGroup("rr", g))
+		assert.Equal(t, []string{"lobby3", "lobby1", "lobby2"}, proxy.orderServerGroup("rr", g))
+		assert.Equal(t, []string{"lobby1", "lobby2", "lobby3"}, proxy.orderServerGroup("rr", g))
+		assert.Equal(t, []string{"lobby1", "lobby2", "lobby3"}, servers, "config must not be modified")
+	})
+
+	t.Run("least-connections", func(t *testing.T) {
+		addPlayers := func(server string, n int) {
+			for range n {
+				proxy.server(server).players.add(&connectedPlayer{profile: &profile.GameProfile{ID: uuid.New()}})
+			}
+		}
+		addPlayers("lobby1", 3)
+		addPlayers("lobby3", 1)
+		assert.Equal(t, []string{"lobby2", "lobby3", "lobby1"},
+			proxy.orderServerGroup("lobbies", group(liteconfig.StrategyLeastConnections)))
+	})
+
+	t.Run("lowest-latency", func(t *testing.T) {
+		proxy.server("lobby2").latency.Store(int64(50 * time.Millisecond))
+		proxy.server("lobby3").latency.Store(int64(10 * time.Millisecond))
+		assert.Equal(t, []string{"lobby3", "lobby2", "lobby1"},
+			proxy.orderServerGroup("lobbies", group(liteconfig.StrategyLowestLatency)))
+	})
+}
+
+func TestNextServerToTryUsesServerGroups(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"lobby1": "localhost:25566",
+		"lobby2": "localhost:25567",
+	}, nil, []string{"lobbies"})
+	proxy.cfg.ServerGroups = map[string]config.ServerGroup{
+		"lobbies": {Servers: []string{"lobby1", "lobby2"}, Strategy: liteconfig.StrategyRoundRobin},
+	}
+
+	newPlayer := func() *connectedPlayer {
+		return &connectedPlayer{
+			sessionHandlerDeps: &sessionHandlerDeps{
+				proxy:          proxy,
+				configProvider: &testConfigProvider{cfg: proxy.cfg},
+			},
+		}
+	}
+
+	first := newPlayer().nextServerToTry(nil)
+	require.NotNil(t, first)
+	assert.Equal(t, "lobby1", first.ServerInfo().Name())
+
+	player := newPlayer()
+	second := player.nextServerToTry(nil)
+	require.NotNil(t, second)
+	assert.Equal(t, "lobby2", second.ServerInfo().Name())
+
+	// Fails over to the other server of the group.
+	next := player.nextServerToTry(second)
+	require.NotNil(t, next)
+	assert.Equal(t, "lobby1", next.ServerInfo().Name())
+}
@@ -0,0 +1,226 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/lite"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/internal/reload"
+)
+
+// ServerHealth is the health of a registered server
+// as determined by the periodic status pings of the proxy.
+type ServerHealth int32
+
+const (
+	// ServerHealthUnknown means the server was not checked yet or health checks are disabled.
+	ServerHealthUnknown ServerHealth = iota
+	// ServerHealthUp means the server responded to the last status ping in time.
+	ServerHealthUp
+	// ServerHealthDegraded means the server responded slowly or some of the last
+	// status pings failed. Degraded servers are still chosen for players.
+	ServerHealthDegraded
+	// ServerHealthDown means the configured number of consecutive status pings failed.
+	// Down servers are skipped when choosing a server from the try list or forced hosts.
+	ServerHealthDown
+)
+
+// String implements fmt.Stringer.
+func (h ServerHealth) String() string {
+	switch h {
+	case ServerHealthUp:
+		return "up"
+	case ServerHealthDegraded:
+		return "degraded"
+	case ServerHealthDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+// HealthOf returns the health of the registered server.
+// It is ServerHealthUnknown if the server was not checked
+// or is not registered with the proxy's health checker.
+func HealthOf(server RegisteredServer) ServerHealth {
+	if s, ok := server.(interface{ Health() ServerHealth }); ok {
+		return s.Health()
+	}
+	return ServerHealthUnknown
+}
+
+// healthChecker periodically pings all registered servers
+// and fires a ServerHealthChangedEvent when the health of a server changed.
+type healthChecker struct {
+	proxy *Proxy
+
+	mu      sync.Mutex
+	current config.HealthCheck
+	changed chan struct{} // signaled when the config changed
+}
+
+func newHealthChecker(p *Proxy) *healthChecker {
+	return &healthChecker{proxy: p, changed: make(chan struct{}, 1)}
+}
+
+// run checks the servers as configured and keeps in sync
+// with config updates until ctx is canceled.
+func (c *healthChecker) run(ctx context.Context) {
+	defer reload.Subscribe(c.proxy.event, func(e *javaConfigUpdateEvent) {
+		if e == nil || e.Config == nil {
+			return
+		}
+		c.apply(e.Config.HealthCheck)
+	})()
+	c.apply(c.proxy.config().HealthCheck)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-c.changed:
+			timer.Stop()
+		}
+		cfg := c.config()
+		if !cfg.Enabled {
+			c.reset()
+			continue
+		}
+		c.check(ctx, cfg)
+		timer.Reset(time.Duration(cfg.Interval))
+	}
+}
+
+func (c *healthChecker) apply(cfg config.HealthCheck) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.current == cfg {
+		return
+	}
+	c.current = cfg
+	select {
+	case c.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (c *healthChecker) config() config.HealthCheck {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current
+}
+
+// check pings all registered servers in parallel and waits for the results.
+func (c *healthChecker) check(ctx context.Context, cfg config.HealthCheck) {
+	var wg sync.WaitGroup
+	for _, s := range c.proxy.Servers() {
+		rs, ok := s.(*registeredServer)
+		if !ok {
+			continue
+		}
+		wg.Go(func() {
+			c.update(rs, c.ping(ctx, rs, cfg), cfg)
+		})
+	}
+	wg.Wait()
+}
+
+// ping returns the latency and reported players of a status ping to the server or the error.
+func (c *healthChecker) ping(ctx context.Context, rs *registeredServer, cfg config.HealthCheck) healthPing {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(cfg.Timeout))
+	defer cancel()
+	start := time.Now()
+	res, err := lite.PingStatus(ctx, rs.ServerInfo().Addr().String(), version.MaximumVersion.Protocol)
+	if err != nil {
+		return healthPing{latency: time.Since(start), err: err}
+	}
+	hp := healthPing{latency: time.Since(start)}
+	var status struct {
+		Players *struct {
+			Online int `json:"online"`
+			Max    int `json:"max"`
+		} `json:"players"`
+	}
+	if json.Unmarshal([]byte(res.Status), &status) == nil && status.Players != nil {
+		hp.online, hp.max = status.Players.Online, status.Players.Max
+	}
+	return hp
+}
+
+type healthPing struct {
+	latency     time.Duration
+	online, max int // Players reported by the server, max is 0 if unknown.
+	err         error
+}
+
+// update sets the health of the server from the ping result.
+func (c *healthChecker) update(rs *registeredServer, res healthPing, cfg config.HealthCheck) {
+	var health ServerHealth
+	switch {
+	case res.err != nil:
+		rs.failedPings++
+		if rs.failedPings >= cfg.FailureThreshold {
+			health = ServerHealthDown
+		} else {
+			health = ServerHealthDegraded
+		}
+	case cfg.DegradedLatency > 0 && res.latency > time.Duration(cfg.DegradedLatency):
+		rs.failedPings = 0
+		rs.latency.Store(int64(res.latency))
+		health = ServerHealthDegraded
+	default:
+		rs.failedPings = 0
+		rs.latency.Store(int64(res.latency))
+		health = ServerHealthUp
+	}
+	if res.err == nil {
+		rs.pingOnline.Store(int32(res.online))
+		rs.pingMax.Store(int32(res.max))
+	}
+	if rs.Health() == ServerHealthUnknown && health == ServerHealthDegraded && res.err != nil {
+		// Not yet seen up, a single failed ping is not worth a change.
+		return
+	}
+	c.set(rs, health, res)
+}
+
+// reset marks all servers as unknown, e.g. when health checks were disabled.
+func (c *healthChecker) reset() {
+	for _, s := range c.proxy.Servers() {
+		if rs, ok := s.(*registeredServer); ok {
+			rs.failedPings = 0
+			rs.latency.Store(0)
+			rs.pingMax.Store(0)
+			c.set(rs, ServerHealthUnknown, healthPing{})
+		}
+	}
+}
+
+func (c *healthChecker) set(rs *registeredServer, health ServerHealth, res healthPing) {
+	previous := ServerHealth(rs.health.Swap(int32(health)))
+	if previous == health {
+		return
+	}
+	log := c.proxy.log.WithName("health").WithValues(
+		"server", rs.ServerInfo().Name(), "previous", previous, "health", health)
+	switch {
+	case res.err != nil:
+		log.Info("server health changed", "error", res.err)
+	case health == ServerHealthUnknown:
+		log.V(1).Info("server health changed")
+	default:
+		log.Info("server health changed", "latency", res.latency.Round(time.Millisecond))
+	}
+	c.proxy.event.Fire(&ServerHealthChangedEvent{
+		server:   rs,
+		previous: previous,
+		health:   health,
+	})
+}
@@ -0,0 +1,87 @@
+package proxy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/util/netutil"
+)
+
+func TestHealthCheckerUpdate(t *testing.T) {
+	mgr := event.New()
+	var changes []*ServerHealthChangedEvent
+	event.Subscribe(mgr, 0, func(e *ServerHealthChangedEvent) {
+		changes = append(changes, e)
+	})
+	p := &Proxy{event: mgr, log: logr.Discard()}
+	c := newHealthChecker(p)
+	rs := newRegisteredServer(NewServerInfo("lobby", netutil.NewAddr("localhost:25566", "tcp")))
+	cfg := config.HealthCheck{
+		Enabled:          true,
+		DegradedLatency:  config.DefaultConfig.HealthCheck.DegradedLatency,
+		FailureThreshold: 2,
+	}
+	failed := healthPing{err: errors.New("connection refused")}
+
+	// A single failed ping of an unchecked server is not worth a change.
+	c.update(rs, failed, cfg)
+	assert.Equal(t, ServerHealthUnknown, rs.Health())
+	assert.Empty(t, changes)
+
+	c.update(rs, healthPing{latency: time.Millisecond}, cfg)
+	assert.Equal(t, ServerHealthUp, rs.Health())
+
+	c.update(rs, healthPing{latency: 2 * time.Second}, cfg)
+	assert.Equal(t, ServerHealthDegraded, rs.Health())
+
+	c.update(rs, failed, cfg)
+	assert.Equal(t, ServerHealthDegraded, rs.Health())
+	c.update(rs, failed, cfg)
+	assert.Equal(t, ServerHealthDown, rs.Health())
+
+	c.update(rs, healthPing{latency: time.Millisecond}, cfg)
+	assert.Equal(t, ServerHealthUp, rs.Health())
+	assert.Equal(t, ServerHealthUp, HealthOf(rs))
+
+	mgr.Wait()
+	require.Len(t, changes, 4)
+	assert.Equal(t, ServerHealthDegraded, changes[2].PreviousHealth())
+	assert.Equal(t, ServerHealthDown, changes[2].Health())
+	assert.Equal(t, "lobby", changes[2].Server().ServerInfo().Name())
+}
+
+// customServer is a RegisteredServer implemented outside the proxy without health.
+type customServer struct{ RegisteredServer }
+
+func TestHealthOfCustomServer(t *testing.T) {
+	assert.Equal(t, ServerHealthUnknown, HealthOf(customServer{}))
+}
+
+func TestNextServerToTrySkipsDownServers(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{
+		"server1": "localhost:25566",
+		"server2": "localhost:25567",
+	}, nil, []string{"server1", "server2"})
+	proxy.servers["server1"].health.Store(int32(ServerHealthDown))
+
+	player := &connectedPlayer{
+		sessionHandlerDeps: &sessionHandlerDeps{
+			proxy:          proxy,
+			configProvider: &testConfigProvider{cfg: proxy.cfg},
+		},
+	}
+	next := player.nextServerToTry(nil)
+	require.NotNil(t, next)
+	assert.Equal(t, "server2", next.ServerInfo().Name())
+
+	proxy.servers["server2"].health.Store(int32(ServerHealthDown))
+	player.tryIndex = 0
+	assert.Nil(t, player.nextServerToTry(nil))
+}
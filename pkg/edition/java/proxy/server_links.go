@@ -0,0 +1,273 @@
+package proxy
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet/chat"
+	"go.minekube.com/gate/pkg/edition/java/proto/state/states"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// ServerLinkType is a built-in server link type whose label is translated by the client.
+type ServerLinkType int
+
+// Built-in server link types ordered by their protocol id.
+const (
+	BugReportServerLink ServerLinkType = iota
+	CommunityGuidelinesServerLink
+	SupportServerLink
+	StatusServerLink
+	FeedbackServerLink
+	CommunityServerLink
+	WebsiteServerLink
+	ForumsServerLink
+	NewsServerLink
+	AnnouncementsServerLink
+)
+
+// ServerLink is a link shown in the pause menu of 1.21+ clients.
+type ServerLink struct {
+	Type  ServerLinkType      // Built-in type, used if Label is nil.
+	Label component.Component // Custom label, optional.
+	URL   string
+}
+
+// ErrTooManyReportDetails is returned by Player.SetReportDetails if more
+// than config.MaxReportDetails details are set.
+var ErrTooManyReportDetails = fmt.Errorf("clients accept at most %d report details", config.MaxReportDetails)
+
+const (
+	maxReportDetailKeyLength   = 128
+	maxReportDetailValueLength = 4096
+)
+
+// serverLinks holds the proxy-managed server links and report details of a player
+// and the ones sent by the current backend server to combine them.
+// The zero value is ready to use.
+type serverLinks struct {
+	mu             sync.Mutex
+	links          []ServerLink      // set by SetServerLinks, nil uses the config
+	details        map[string]string // set by SetReportDetails, nil uses the config
+	backendLinks   []*packet.ServerLink
+	backendDetails map[string]string
+}
+
+func (p *connectedPlayer) SetServerLinks(links []ServerLink) error {
+	p.serverLinks.mu.Lock()
+	p.serverLinks.links = slices.Clone(links)
+	p.serverLinks.mu.Unlock()
+	return p.sendServerLinks(true)
+}
+
+func (p *connectedPlayer) SetReportDetails(details map[string]string) error {
+	if len(details) > config.MaxReportDetails {
+		return ErrTooManyReportDetails
+	}
+	for k, v := range details {
+		if len(k) > maxReportDetailKeyLength || len(v) > maxReportDetailValueLength {
+			return fmt.Errorf("report detail %q exceeds the maximum key length of %d or value length of %d",
+				k, maxReportDetailKeyLength, maxReportDetailValueLength)
+		}
+	}
+	p.serverLinks.mu.Lock()
+	p.serverLinks.details = maps.Clone(details)
+	p.serverLinks.mu.Unlock()
+	return p.sendReportDetails(true)
+}
+
+// resetServerLinks forgets the values sent by the previous backend server and sends
+// the proxy-managed server links and report details. It is called when the player
+// enters the configuration state, before the next backend server sends its own.
+func (p *connectedPlayer) resetServerLinks() {
+	p.serverLinks.mu.Lock()
+	p.serverLinks.backendLinks = nil
+	p.serverLinks.backendDetails = nil
+	p.serverLinks.mu.Unlock()
+	if err := p.sendServerLinks(false); err != nil {
+		p.log.V(1).Info("error sending server links", "error", err)
+	}
+	if err := p.sendReportDetails(false); err != nil {
+		p.log.V(1).Info("error sending report details", "error", err)
+	}
+}
+
+// handleBackendServerLinks combines the server links sent by a backend server with the
+// proxy-managed ones. Returns false if the packet should be forwarded unmodified.
+func (p *connectedPlayer) handleBackendServerLinks(pkt *packet.ServerLinks) bool {
+	p.serverLinks.mu.Lock()
+	p.serverLinks.backendLinks = pkt.ServerLinks
+	p.serverLinks.mu.Unlock()
+	if len(p.proxyServerLinks()) == 0 {
+		return false
+	}
+	if err := p.sendServerLinks(true); err != nil {
+		p.log.V(1).Info("error sending server links", "error", err)
+	}
+	return true
+}
+
+// handleBackendReportDetails combines the report details sent by a backend server with the
+// proxy-managed ones. Returns false if the packet should be forwarded unmodified.
+func (p *connectedPlayer) handleBackendReportDetails(pkt *packet.CustomReportDetails) bool {
+	p.serverLinks.mu.Lock()
+	p.serverLinks.backendDetails = pkt.Details
+	p.serverLinks.mu.Unlock()
+	if len(p.proxyReportDetails()) == 0 {
+		return false
+	}
+	if err := p.sendReportDetails(true); err != nil {
+		p.log.V(1).Info("error sending report details", "error", err)
+	}
+	return true
+}
+
+// sendServerLinks sends the combined server links if the client supports them.
+// If force is false, nothing is sent when the proxy manages no links.
+func (p *connectedPlayer) sendServerLinks(force bool) error {
+	if !p.canSendServerLinks() {
+		return nil
+	}
+	proxyLinks := p.proxyServerLinks()
+	if !force && len(proxyLinks) == 0 {
+		return nil
+	}
+	p.serverLinks.mu.Lock()
+	backendLinks := p.serverLinks.backendLinks
+	p.serverLinks.mu.Unlock()
+	links := mergeServerLinks(p.Protocol(), p.config().ServerLinks.Policy, proxyLinks, backendLinks)
+	return p.WritePacket(&packet.ServerLinks{ServerLinks: links})
+}
+
+// sendReportDetails sends the combined report details if the client supports them.
+// If force is false, nothing is sent when the proxy manages no details.
+func (p *connectedPlayer) sendReportDetails(force bool) error {
+	if !p.canSendServerLinks() {
+		return nil
+	}
+	proxyDetails := p.proxyReportDetails()
+	if !force && len(proxyDetails) == 0 {
+		return nil
+	}
+	p.serverLinks.mu.Lock()
+	backendDetails := p.serverLinks.backendDetails
+	p.serverLinks.mu.Unlock()
+	details := mergeReportDetails(p.config().ReportDetails.Policy, proxyDetails, backendDetails)
+	return p.WritePacket(&packet.CustomReportDetails{Details: details})
+}
+
+// canSendServerLinks returns true if the player is a 1.21+ client in the configuration or play state.
+func (p *connectedPlayer) canSendServerLinks() bool {
+	if p.Protocol().Lower(version.Minecraft_1_21) {
+		return false
+	}
+	s := p.State().State
+	return s == states.ConfigState || s == states.PlayState
+}
+
+// proxyServerLinks returns the links set by SetServerLinks or else the configured ones.
+func (p *connectedPlayer) proxyServerLinks() []ServerLink {
+	p.serverLinks.mu.Lock()
+	links := p.serverLinks.links
+	p.serverLinks.mu.Unlock()
+	if links != nil {
+		return links
+	}
+	return serverLinksFromConfig(p.config().ServerLinks.Links)
+}
+
+// proxyReportDetails returns the details set by SetReportDetails or else the configured ones.
+func (p *connectedPlayer) proxyReportDetails() map[string]string {
+	p.serverLinks.mu.Lock()
+	details := p.serverLinks.details
+	p.serverLinks.mu.Unlock()
+	if details != nil {
+		return details
+	}
+	return p.config().ReportDetails.Details
+}
+
+func serverLinksFromConfig(links []config.ServerLink) []ServerLink {
+	out := make([]ServerLink, 0, len(links))
+	for _, link := range links {
+		l := ServerLink{URL: link.URL}
+		if i := slices.Index(config.ServerLinkTypes, link.Type); i >= 0 {
+			l.Type = ServerLinkType(i)
+		} else if link.Label != nil {
+			l.Label = link.Label.C()
+		} else {
+			continue // invalid, already reported by config validation
+		}
+		out = append(out, l)
+	}
+	return out
+}
+
+func (l *ServerLink) packet(protocol proto.Protocol) *packet.ServerLink {
+	if l.Label != nil {
+		return &packet.ServerLink{
+			ID:          -1,
+			DisplayName: *chat.FromComponentProtocol(l.Label, protocol),
+			URL:         l.URL,
+		}
+	}
+	return &packet.ServerLink{ID: int(l.Type), URL: l.URL}
+}
+
+// mergeServerLinks returns the proxy links followed by the backend links, unless the policy
+// is override. Backend links with the same built-in type or url as a proxy link are dropped.
+func mergeServerLinks(
+	protocol proto.Protocol,
+	policy config.BackendPolicy,
+	proxyLinks []ServerLink,
+	backendLinks []*packet.ServerLink,
+) []*packet.ServerLink {
+	out := make([]*packet.ServerLink, 0, len(proxyLinks)+len(backendLinks))
+	for i := range proxyLinks {
+		out = append(out, proxyLinks[i].packet(protocol))
+	}
+	if len(proxyLinks) != 0 && policy == config.OverrideBackendPolicy {
+		return out
+	}
+	proxyCount := len(out)
+	for _, link := range backendLinks {
+		if link == nil {
+			continue
+		}
+		if slices.ContainsFunc(out[:proxyCount], func(l *packet.ServerLink) bool {
+			return (link.ID >= 0 && l.ID == link.ID) || l.URL == link.URL
+		}) {
+			continue
+		}
+		out = append(out, link)
+	}
+	return out
+}
+
+// mergeReportDetails returns the backend details overwritten by the proxy details, unless the policy
+// is override. Backend details are dropped if they exceed the limit accepted by clients.
+func mergeReportDetails(policy config.BackendPolicy, proxyDetails, backendDetails map[string]string) map[string]string {
+	out := maps.Clone(proxyDetails)
+	if out == nil {
+		out = make(map[string]string)
+	}
+	if len(proxyDetails) != 0 && policy == config.OverrideBackendPolicy {
+		return out
+	}
+	for _, k := range slices.Sorted(maps.Keys(backendDetails)) {
+		if len(out) >= config.MaxReportDetails {
+			break
+		}
+		if _, ok := out[k]; !ok {
+			out[k] = backendDetails[k]
+		}
+	}
+	return out
+}
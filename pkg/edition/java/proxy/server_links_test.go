@@ -0,0 +1,67 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+)
+
+func TestMergeServerLinks(t *testing.T) {
+	protocol := version.Minecraft_1_21.Protocol
+	proxyLinks := []ServerLink{
+		{Type: WebsiteServerLink, URL: "https://example.com"},
+		{Label: &component.Text{Content: "Discord"}, URL: "https://discord.gg/example"},
+	}
+	backendLinks := []*packet.ServerLink{
+		{ID: int(WebsiteServerLink), URL: "https://backend.example.com"},
+		{ID: -1, URL: "https://discord.gg/example"},
+		{ID: int(SupportServerLink), URL: "https://support.example.com"},
+	}
+
+	links := mergeServerLinks(protocol, config.MergeBackendPolicy, proxyLinks, backendLinks)
+	require.Len(t, links, 3)
+	require.Equal(t, int(WebsiteServerLink), links[0].ID)
+	require.Equal(t, -1, links[1].ID)
+	require.Equal(t, "https://discord.gg/example", links[1].URL)
+	require.Equal(t, "https://support.example.com", links[2].URL)
+
+	links = mergeServerLinks(protocol, config.OverrideBackendPolicy, proxyLinks, backendLinks)
+	require.Len(t, links, 2)
+
+	// Without proxy links the backend links are kept regardless of the policy.
+	links = mergeServerLinks(protocol, config.OverrideBackendPolicy, nil, backendLinks)
+	require.Equal(t, backendLinks, links)
+}
+
+func TestMergeReportDetails(t *testing.T) {
+	proxyDetails := map[string]string{"network": "example", "region": "eu"}
+	backendDetails := map[string]string{"region": "us", "server": "lobby-1"}
+
+	details := mergeReportDetails(config.MergeBackendPolicy, proxyDetails, backendDetails)
+	require.Equal(t, map[string]string{"network": "example", "region": "eu", "server": "lobby-1"}, details)
+
+	details = mergeReportDetails(config.OverrideBackendPolicy, proxyDetails, backendDetails)
+	require.Equal(t, proxyDetails, details)
+
+	many := make(map[string]string, config.MaxReportDetails)
+	for i := range config.MaxReportDetails {
+		many[string(rune('a'+i))] = "x"
+	}
+	details = mergeReportDetails(config.MergeBackendPolicy, proxyDetails, many)
+	require.Len(t, details, config.MaxReportDetails)
+	require.Equal(t, "eu", details["region"])
+}
+
+func TestServerLinksFromConfig(t *testing.T) {
+	links := serverLinksFromConfig([]config.ServerLink{
+		{Type: "support", URL: "https://support.example.com"},
+		{URL: "https://invalid.example.com"},
+	})
+	require.Equal(t, []ServerLink{{Type: SupportServerLink, URL: "https://support.example.com"}}, links)
+	require.Len(t, config.ServerLinkTypes, int(AnnouncementsServerLink)+1)
+}
@@ -99,6 +99,14 @@ func (b *backendConfigSessionHandler) HandlePacket(pc *proto.PacketContext) {
 		b.handleCookieStore(p)
 	case *cookie.CookieRequest:
 		b.handleCookieRequest(p)
+	case *packet.ServerLinks:
+		if !b.serverConn.player.handleBackendServerLinks(p) {
+			b.forwardToPlayer(pc, nil)
+		}
+	case *packet.CustomReportDetails:
+		if !b.serverConn.player.handleBackendReportDetails(p) {
+			b.forwardToPlayer(pc, nil)
+		}
 	default:
 		b.forwardToPlayer(pc, nil)
 	}
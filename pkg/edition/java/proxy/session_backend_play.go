@@ -103,6 +103,18 @@ func (b *backendPlaySessionHandler) HandlePacket(pc *proto.PacketContext) {
 		b.handleCookieStore(p)
 	case *cookie.CookieRequest:
 		b.handleCookieRequest(p)
+	case *packet.ServerLinks:
+		if !b.serverConn.player.handleBackendServerLinks(p) {
+			b.forwardToPlayer(pc, nil)
+		}
+	case *packet.CustomReportDetails:
+		if !b.serverConn.player.handleBackendReportDetails(p) {
+			b.forwardToPlayer(pc, nil)
+		}
+	case *packet.HeaderAndFooter:
+		if !b.proxy().GlobalTabList().replacesHeaderFooter() {
+			b.forwardToPlayer(pc, nil)
+		}
 	default:
 		b.forwardToPlayer(pc, nil)
 	}
@@ -241,7 +253,8 @@ func (b *backendPlaySessionHandler) handlePluginMessage(packet *plugin.Message,
 }
 
 func (b *backendPlaySessionHandler) handleServerData(p *packet.ServerData) {
-	ping := newInitialPing(b.proxy(), b.serverConn.player.Protocol())
+	player := b.serverConn.player
+	ping := newInitialPing(b.proxy(), player.getVirtualHostname(), player.Protocol())
 	e := &PingEvent{
 		inbound: b.serverConn.player,
 		ping:    ping,
@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/robinbraemer/event"
@@ -17,6 +18,8 @@ import (
 	"go.minekube.com/gate/pkg/edition/java/proxy/phase"
 	"go.minekube.com/gate/pkg/gate/proto"
 	"go.minekube.com/gate/pkg/util/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 type authSessionHandler struct {
@@ -98,6 +101,7 @@ func (a *authSessionHandler) Activated() {
 	)
 	a.connectedPlayer = player
 	if !a.registrar.canRegisterConnection(player) {
+		recordLoginFailure(player.Context(), loginFailureAlreadyConnected)
 		player.Disconnect(alreadyConnected)
 		return
 	}
@@ -179,11 +183,13 @@ func (a *authSessionHandler) completeLoginProtocolPhaseAndInitialize(player *con
 	}
 
 	if !loginEvent.Allowed() {
+		recordLoginFailure(player.Context(), loginFailureDenied)
 		player.Disconnect(loginEvent.Reason())
 		return
 	}
 
 	if !a.registrar.registerConnection(player) {
+		recordLoginFailure(player.Context(), loginFailureAlreadyConnected)
 		player.Disconnect(alreadyConnected)
 		return
 	}
@@ -214,6 +220,7 @@ func (a *authSessionHandler) completeLoginProtocolPhaseAndInitialize(player *con
 
 		a.inbound.clearOnAllMessagesHandled()
 		a.loginState.Store(&acknowledgedAuthLoginState)
+		a.recordLoginDuration(player)
 
 		// Connect in a goroutine so the client's read loop can process
 		// LoginPluginResponse packets for the FML relay.
@@ -224,6 +231,7 @@ func (a *authSessionHandler) completeLoginProtocolPhaseAndInitialize(player *con
 	if player.WritePacket(loginSuccess) != nil {
 		return
 	}
+	a.recordLoginDuration(player)
 
 	a.loginState.Store(&successSentAuthLoginState)
 
@@ -237,6 +245,15 @@ func (a *authSessionHandler) completeLoginProtocolPhaseAndInitialize(player *con
 	}
 }
 
+// recordLoginDuration records the gate.login.duration metric of the player's login.
+func (a *authSessionHandler) recordLoginDuration(player *connectedPlayer) {
+	if a.inbound.loginStart.IsZero() {
+		return
+	}
+	loginDuration.Record(player.Context(), time.Since(a.inbound.loginStart).Seconds(),
+		metric.WithAttributes(attribute.Bool("online_mode", a.onlineMode)))
+}
+
 // connectToInitialServer connects the player to the initial server as per the player's information.
 // If the player is active and not already connected to a server, the connection is initiated.
 // If no initial server is found, the player is disconnected.
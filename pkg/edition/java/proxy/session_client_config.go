@@ -9,6 +9,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/robinbraemer/event"
 	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/gate/pkg/edition/java/dialog"
 	"go.minekube.com/gate/pkg/edition/java/netmc"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet"
 	"go.minekube.com/gate/pkg/edition/java/proto/packet/config"
@@ -50,6 +51,12 @@ func newClientConfigSessionHandler(
 	}
 }
 
+// Activated sends the proxy-managed server links and report details
+// before the backend server sends its own.
+func (h *clientConfigSessionHandler) Activated() {
+	h.player.resetServerLinks()
+}
+
 // Disconnected is called when the player disconnects.
 func (h *clientConfigSessionHandler) Disconnected() {
 	h.player.teardown()
@@ -85,6 +92,10 @@ func (h *clientConfigSessionHandler) HandlePacket(pc *proto.PacketContext) {
 		h.handleKnownPacks(p, pc)
 	case *cookie.CookieResponse:
 		h.handleCookieResponse(p)
+	case *packet.CustomClickActionPacket:
+		if handleCustomClickAction(pc, p, h.player, h.log) {
+			forwardToServer(pc, h.player)
+		}
 	default:
 		forwardToServer(pc, h.player)
 	}
@@ -119,6 +130,24 @@ func handleResourcePackResponse(p *packet.ResourcePackResponse, handler resource
 	return handled
 }
 
+// handleCustomClickAction fires the PlayerDialogResponseEvent and
+// returns whether the action should be forwarded to the server.
+func handleCustomClickAction(pc *proto.PacketContext, p *packet.CustomClickActionPacket, player *connectedPlayer, log logr.Logger) bool {
+	id, payload, err := p.Parse(pc.Protocol)
+	if err != nil {
+		log.V(1).Error(err, "Error parsing custom click action")
+		return true
+	}
+	response, err := dialog.NewResponse(id, payload)
+	if err != nil {
+		log.V(1).Error(err, "Error decoding dialog response", "dialog", id)
+		return true
+	}
+	e := newPlayerDialogResponseEvent(player, response)
+	player.proxy.event.Fire(e)
+	return e.Allowed()
+}
+
 func (h *clientConfigSessionHandler) handlePluginMessage(p *plugin.Message) {
 	if plugin.McBrand(p) {
 		brand := plugin.ReadBrandMessage(p.Data)
@@ -102,14 +102,38 @@ func (h *handshakeSessionHandler) handleHandshake(handshake *packet.Handshake, p
 	if cfg.Lite.Enabled {
 		h.conn.SetState(nextState)
 		dialTimeout := time.Duration(cfg.ConnectionTimeout)
+		maintenance := h.proxy.maintenance.liteRoute(cfg.Lite.Routes, handshake)
 		if nextState == state.Login {
-			// Lite mode enabled, pipe the connection.
-			lite.Forward(dialTimeout, cfg.Lite.Routes, h.log, h.conn, handshake, pc, h.proxy.Lite().StrategyManager())
+			forward := func(login *proto.PacketContext) {
+				// Lite mode enabled, pipe the connection.
+				lite.ForwardWithOptions(dialTimeout, cfg.Lite.Routes, h.log, h.conn, handshake, pc, h.proxy.Lite().StrategyManager(),
+					lite.ForwardOptions{EventMgr: h.eventMgr, Login: login})
+			}
+			if maintenance {
+				// Route under maintenance, read the username to check the maintenance whitelist
+				// and answer denied players without dialing the backend.
+				h.conn.SetActiveSessionHandler(state.Login, &liteMaintenanceLoginHandler{
+					conn:        h.conn,
+					maintenance: h.proxy.maintenance,
+					forward:     forward,
+					deny: func() {
+						reason := h.proxy.renderPlaceholders(nil, cfg.Maintenance.KickMessage.C())
+						_ = netmc.CloseWith(h.conn, packet.NewDisconnect(reason, h.conn.Protocol(), h.conn.State().State))
+					},
+				})
+				return
+			}
+			forward(nil)
 			return
 		}
 		// Resolve ping response for lite mode.
 		resolvePingResponse = func(log logr.Logger, statusRequestCtx *proto.PacketContext) (logr.Logger, *packet.StatusResponse, error) {
-			return lite.ResolveStatusResponseWithGeneration(dialTimeout, routeGeneration, cfg.Lite.Routes, log, h.conn, handshake, pc, statusRequestCtx, h.proxy.Lite().StrategyManager())
+			if maintenance {
+				res, err := h.proxy.maintenance.liteStatus(statusRequestCtx.Protocol)
+				return log, res, err
+			}
+			return lite.ResolveStatusResponseWithOptions(dialTimeout, cfg.Lite.Routes, log, h.conn, handshake, pc, statusRequestCtx, h.proxy.Lite().StrategyManager(),
+				lite.StatusOptions{RouteGeneration: routeGeneration, Placeholders: h.proxy.Placeholders(), EventMgr: h.eventMgr})
 		}
 	}
 
@@ -149,6 +173,7 @@ func (h *handshakeSessionHandler) handleLogin(p *packet.Handshake, inbound *init
 
 	// Client IP-block rate limiter preventing too fast logins hitting the Mojang API
 	if h.loginsQuota != nil && h.loginsQuota.Blocked(netutil.Host(inbound.RemoteAddr())) {
+		recordQuotaRejection(h.conn.Context(), "logins")
 		_ = netmc.CloseWith(h.conn, packet.NewDisconnect(&component.Text{
 			Content: "You are logging in too fast, please calm down and retry.",
 			S:       component.Style{Color: color.Red},
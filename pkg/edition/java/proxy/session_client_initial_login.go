@@ -98,6 +98,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 		return
 	}
 	l.currentState = loginPacketReceivedLoginState
+	l.inbound.loginStart = time.Now()
 
 	// Validate username format
 	if !playerNameRegex.MatchString(login.Username) {
@@ -109,6 +110,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 	if playerKey != nil {
 		if playerKey.Expired() {
 			l.log.V(1).Info("expired player public key")
+			recordLoginFailure(l.conn.Context(), loginFailureInvalidKey)
 			_ = l.inbound.disconnect(&component.Translation{
 				Key: "multiplayer.disconnect.invalid_public_key_signature",
 			})
@@ -124,6 +126,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 
 		if !isKeyValid {
 			l.log.V(1).Info("invalid player public key signature")
+			recordLoginFailure(l.conn.Context(), loginFailureInvalidKey)
 			_ = l.inbound.disconnect(&component.Translation{
 				Key: "multiplayer.disconnect.invalid_public_key",
 			})
@@ -148,6 +151,7 @@ func (l *initialLoginSessionHandler) handleServerLogin(login *packet.ServerLogin
 	}
 
 	if e.Result() == DeniedPreLogin {
+		recordLoginFailure(l.conn.Context(), loginFailureDenied)
 		_ = l.inbound.disconnect(e.Reason())
 		return
 	}
@@ -243,6 +247,7 @@ func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.Encry
 		valid := playerKey.VerifyDataSignature(resp.VerifyToken, l.verify, salt.Bytes())
 		if !valid {
 			l.log.Info("invalid client public signature")
+			recordLoginFailure(l.conn.Context(), loginFailureInvalidToken)
 			_ = l.conn.Close()
 			return
 		}
@@ -255,6 +260,7 @@ func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.Encry
 		}
 		if !valid {
 			l.log.Info("invalid verification token")
+			recordLoginFailure(l.conn.Context(), loginFailureInvalidToken)
 			_ = l.conn.Close()
 			return
 		}
@@ -298,12 +304,14 @@ func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.Encry
 			// The player disconnected before receiving authentication response.
 			return
 		}
+		recordLoginFailure(ctx, loginFailureAuthUnavailable)
 		_ = netmc.CloseWith(l.conn, packet.NewDisconnect(unableAuthWithMojang, l.conn.Protocol(), l.conn.State().State))
 		return
 	}
 
 	if !authResp.OnlineMode() {
 		log.Info("disconnect offline mode player")
+		recordLoginFailure(ctx, loginFailureOfflineMode)
 		// Apparently an offline-mode user logged onto this online-mode proxy.
 		_ = netmc.CloseWith(l.conn, packet.NewDisconnect(onlineModeOnly, l.conn.Protocol(), l.conn.State().State))
 		return
@@ -312,6 +320,7 @@ func (l *initialLoginSessionHandler) handleEncryptionResponse(resp *packet.Encry
 	// Extract game profile from response.
 	gameProfile, err := authResp.GameProfile()
 	if err != nil {
+		recordLoginFailure(ctx, loginFailureAuthUnavailable)
 		if netmc.CloseWith(l.conn, packet.NewDisconnect(unableAuthWithMojang, l.conn.Protocol(), l.conn.State().State)) == nil {
 			log.Error(err, "unable get GameProfile from Mojang authentication response")
 		}
@@ -130,6 +130,7 @@ func newClientPlaySessionHandler(player *connectedPlayer) *clientPlaySessionHand
 			player:         player,
 			cmdMgr:         player.proxy.Command(),
 			configProvider: player.proxy,
+			chatChannels:   player.proxy.ChatChannels(),
 		},
 	}
 	h.mu.serverBossBars = map[uuid.UUID]struct{}{}
@@ -178,6 +179,10 @@ func (c *clientPlaySessionHandler) HandlePacket(pc *proto.PacketContext) {
 		c.handleCookieResponse(p)
 	case *packet.JoinGame:
 		c.handleJoinGame(pc)
+	case *packet.CustomClickActionPacket:
+		if handleCustomClickAction(pc, p, c.player, c.log) {
+			c.forwardToServer(pc)
+		}
 	default:
 		c.forwardToServer(pc)
 	}
@@ -810,7 +815,9 @@ func (c *clientPlaySessionHandler) handleCommandTabComplete(p *packet.TabComplet
 	}
 
 	commandLabel := cmd[:cmdEndPosition]
-	if !c.proxy().command.Has(commandLabel) {
+	// Commands the player can't use are forwarded, like when executed,
+	// e.g. /ban while punishments are disabled is completed by the server.
+	if !c.proxy().command.CanUse(c.player.Context(), c.player, commandLabel) {
 		if c.player.Protocol().Lower(version.Minecraft_1_13) {
 			// Outstanding tab completes are recorded for use with 1.12 clients and below to provide
 			// additional tab completion support.
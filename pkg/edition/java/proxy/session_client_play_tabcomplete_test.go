@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/brigodier"
+
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/edition/java/proto/packet"
+	"go.minekube.com/gate/pkg/edition/java/proxy/phase"
+	"go.minekube.com/gate/pkg/gate/proto"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+// forwardRecordingConn records the payloads forwarded to a backend server.
+type forwardRecordingConn struct {
+	*testMinecraftConn
+	forwarded [][]byte
+}
+
+func (c *forwardRecordingConn) Write(payload []byte) error {
+	c.forwarded = append(c.forwarded, payload)
+	return nil
+}
+
+func TestCommandTabCompleteForwardsUnusableCommands(t *testing.T) {
+	enabled := false
+	p := &Proxy{}
+	p.command.RegisterWithAliases(brigodier.Literal("ban").
+		Requires(command.Requires(func(*command.RequiresContext) bool { return enabled })).
+		Then(brigodier.Argument("player", brigodier.String)))
+
+	backend := &forwardRecordingConn{testMinecraftConn: &testMinecraftConn{}}
+	player := &connectedPlayer{
+		MinecraftConn:      &testMinecraftConn{},
+		sessionHandlerDeps: &sessionHandlerDeps{proxy: p},
+		log:                logr.Discard(),
+		permFunc:           func(string) permission.TriState { return permission.Undefined },
+	}
+	player.connectedServer_ = &serverConnection{
+		player:     player,
+		log:        logr.Discard(),
+		connection: backend,
+		connPhase:  phase.VanillaBackendPhase,
+	}
+	h := &clientPlaySessionHandler{player: player, log: logr.Discard(), log1: logr.Discard()}
+	complete := func(cmd string) {
+		h.handleTabCompleteRequest(&packet.TabCompleteRequest{Command: cmd}, &proto.PacketContext{Payload: []byte(cmd)})
+	}
+
+	// Unknown commands are completed by the server
+	complete("/kill ")
+	require.Len(t, backend.forwarded, 1)
+
+	// So are commands whose requirements reject the player, e.g. of a disabled feature
+	complete("/ban ")
+	require.Len(t, backend.forwarded, 2)
+	require.Equal(t, []byte("/ban "), backend.forwarded[1])
+
+	// Usable commands are completed by the proxy
+	enabled = true
+	complete("/ban ")
+	require.Len(t, backend.forwarded, 2)
+}
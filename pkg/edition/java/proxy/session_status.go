@@ -7,6 +7,7 @@ import (
 	"io"
 	"net"
 	"syscall"
+	"time"
 
 	"github.com/go-logr/logr"
 	"go.minekube.com/gate/pkg/edition/java/forge/modinfo"
@@ -82,7 +83,10 @@ func (h *statusSessionHandler) HandlePacket(pc *proto.PacketContext) {
 
 var versionName = fmt.Sprintf("Gate %s", version.SupportedVersionsString)
 
-func newInitialPing(p *Proxy, protocol proto.Protocol) *ping.ServerPing {
+// newInitialPing returns the configured status response for a client
+// connecting with the virtual hostname and protocol.
+func newInitialPing(p *Proxy, host string, protocol proto.Protocol) *ping.ServerPing {
+	clientProtocol := protocol
 	if !version.Protocol(protocol).Supported() {
 		protocol = version.MaximumVersion.Protocol
 	}
@@ -90,19 +94,27 @@ func newInitialPing(p *Proxy, protocol proto.Protocol) *ping.ServerPing {
 	if p.config().AnnounceForge {
 		modInfo = modinfo.Default
 	}
-	return &ping.ServerPing{
+	status := p.config().Status
+	motd, fav, maxPlayers := status.Resolve(host, time.Now())
+	pong := &ping.ServerPing{
 		Version: ping.Version{
 			Protocol: protocol,
 			Name:     versionName,
 		},
 		Players: &ping.Players{
 			Online: p.PlayerCount(),
-			Max:    p.config().Status.ShowMaxPlayers,
+			Max:    maxPlayers,
 		},
-		Description: p.config().Status.Motd.C(),
-		Favicon:     p.config().Status.Favicon,
+		Description: motd.C(),
+		Favicon:     fav,
 		ModInfo:     modInfo,
 	}
+	status.Unsupported.Apply(pong, clientProtocol)
+	if p.maintenance.Enabled() {
+		p.maintenance.applyStatus(pong)
+	}
+	pong.Description = p.renderPlaceholders(nil, pong.Description)
+	return pong
 }
 
 func (h *statusSessionHandler) handleStatusRequest(pc *proto.PacketContext) {
@@ -119,7 +131,7 @@ func (h *statusSessionHandler) handleStatusRequest(pc *proto.PacketContext) {
 
 	log := h.log
 	if h.resolvePingResponse == nil {
-		e.ping = newInitialPing(h.proxy, pc.Protocol)
+		e.ping = newInitialPing(h.proxy, virtualHostname(h.inbound.VirtualHost()), pc.Protocol)
 	} else {
 		var err error
 		var res *packet.StatusResponse
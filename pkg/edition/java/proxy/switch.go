@@ -84,6 +84,24 @@ func (r ConnectionStatus) ServerDisconnected() bool {
 	return r == ServerDisconnectedConnectionStatus
 }
 
+// String returns the lowercase name of the status, e.g. "success".
+func (r ConnectionStatus) String() string {
+	switch r {
+	case SuccessConnectionStatus:
+		return "success"
+	case AlreadyConnectedConnectionStatus:
+		return "already_connected"
+	case InProgressConnectionStatus:
+		return "in_progress"
+	case CanceledConnectionStatus:
+		return "canceled"
+	case ServerDisconnectedConnectionStatus:
+		return "server_disconnected"
+	default:
+		return "unknown"
+	}
+}
+
 //
 //
 //
@@ -137,8 +155,16 @@ func (c *connectionRequest) Connect(ctx context.Context) (ConnectionResult, erro
 
 // ConnectWithIndication - See ConnectionRequest interface.
 func (c *connectionRequest) ConnectWithIndication(ctx context.Context) (successful bool) {
+	queues := c.player.proxy.queues
+	if queues.queueIfUnavailable(c.player, c.server) {
+		return false
+	}
 	result, err := c.internalConnect(ctx)
 	if err != nil {
+		if queues.queueAfterFailure(c.player, c.server) {
+			c.player.log.V(1).Info("could not connect player to server, queued", "server", c.server.ServerInfo().Name(), "error", err)
+			return false
+		}
 		c.player.handleConnectionErr(c.server, err, true)
 		return false
 	}
@@ -402,6 +428,10 @@ func (c *connectionRequest) internalConnect(ctx context.Context) (result *connec
 	conn := newServerConnection(server, c.previousServer, c.player)
 	c.player.setInFlightConnection(conn)
 	defer c.resetIfInFlightIs(conn)
+	start := time.Now()
+	defer func() {
+		recordServerSwitch(ctx, start, server.ServerInfo().Name(), c.previousServer == nil, result, err)
+	}()
 	return conn.connect(ctx)
 }
 
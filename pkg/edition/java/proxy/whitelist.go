@@ -0,0 +1,159 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/robinbraemer/event"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/util/uuid"
+	"go.minekube.com/gate/pkg/util/whitelist"
+)
+
+// ErrWhitelistDisabled is returned when modifying the whitelist while it is disabled in the config.
+var ErrWhitelistDisabled = errors.New("whitelist is disabled")
+
+// Whitelist enforces the proxy-level whitelist, see config.Whitelist.
+// Players that are not whitelisted are denied in the LoginEvent after authentication.
+type Whitelist struct {
+	proxy *Proxy
+
+	mu      sync.Mutex
+	enabled bool
+	file    string
+	store   *whitelist.Store   // nil if disabled or the file could not be loaded
+	stop    context.CancelFunc // stops watching the file
+}
+
+func newWhitelist(p *Proxy) *Whitelist {
+	return &Whitelist{proxy: p}
+}
+
+// Store returns the whitelist store or nil if the whitelist is disabled.
+func (w *Whitelist) Store() *whitelist.Store {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.store
+}
+
+// Add adds a player by username or UUID to the whitelist and returns the added entry.
+// The UUID and username of online players are both recorded.
+func (w *Whitelist) Add(usernameOrID string) (whitelist.Entry, error) {
+	store := w.Store()
+	if store == nil {
+		return whitelist.Entry{}, ErrWhitelistDisabled
+	}
+	return store.Add(w.entry(usernameOrID))
+}
+
+// Remove removes the entries whose UUID or username equals key and returns the removed entries.
+// Online players are not kicked.
+func (w *Whitelist) Remove(key string) ([]whitelist.Entry, error) {
+	store := w.Store()
+	if store == nil {
+		return nil, ErrWhitelistDisabled
+	}
+	return store.Remove(key)
+}
+
+// Allowed returns true if the whitelist doesn't apply to the player or the player is whitelisted.
+func (w *Whitelist) Allowed(player Player) bool {
+	cfg := w.proxy.config().Whitelist
+	if !cfg.Enabled || !whitelistApplies(cfg, virtualHostname(player.VirtualHost())) {
+		return true
+	}
+	store := w.Store()
+	// Deny everyone if the whitelist file could not be loaded
+	return store != nil && store.Allowed(player.ID(), player.Username())
+}
+
+// entry returns the whitelist entry of a player by username or UUID.
+func (w *Whitelist) entry(usernameOrID string) whitelist.Entry {
+	var player Player
+	if id, err := uuid.Parse(usernameOrID); err == nil {
+		if player = w.proxy.Player(id); player == nil {
+			return whitelist.Entry{ID: id.String()}
+		}
+	} else if player = w.proxy.PlayerByName(usernameOrID); player == nil {
+		return whitelist.Entry{Name: usernameOrID}
+	}
+	return whitelist.Entry{ID: player.ID().String(), Name: player.Username()}
+}
+
+// whitelistApplies returns true if the whitelist applies to players joining with the virtual host.
+func whitelistApplies(cfg config.Whitelist, host string) bool {
+	return len(cfg.Hosts) == 0 || slices.ContainsFunc(cfg.Hosts, func(h string) bool {
+		return strings.EqualFold(h, host)
+	})
+}
+
+// start denies logins of players not on the whitelist and opens the configured
+// whitelist file, reopening it when a config reload changes the file or toggles the whitelist.
+// The returned stop function removes the login check and closes the file.
+func (w *Whitelist) start(ctx context.Context) (stop func()) {
+	unsubscribe := []func(){
+		event.Subscribe(w.proxy.event, enforcePriority, func(e *LoginEvent) {
+			if !e.Allowed() || w.Allowed(e.Player()) {
+				return
+			}
+			e.Deny(w.proxy.config().Whitelist.KickMessage.C())
+		}),
+		reload.Subscribe(w.proxy.event, func(e *javaConfigUpdateEvent) {
+			if e == nil || e.Config == nil {
+				return
+			}
+			w.apply(ctx, e.Config.Whitelist)
+		}),
+	}
+	w.apply(ctx, w.proxy.config().Whitelist)
+	return func() {
+		for _, unsub := range unsubscribe {
+			unsub()
+		}
+		w.apply(ctx, config.Whitelist{})
+	}
+}
+
+// apply opens and watches the whitelist file if the config changed.
+func (w *Whitelist) apply(ctx context.Context, cfg config.Whitelist) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.enabled == cfg.Enabled && w.file == cfg.File && (w.store != nil || !cfg.Enabled) {
+		return
+	}
+	w.enabled, w.file = cfg.Enabled, cfg.File
+	if w.stop != nil {
+		w.stop()
+		w.stop = nil
+	}
+	w.store = nil
+	if !cfg.Enabled || ctx.Err() != nil {
+		return
+	}
+
+	log := w.proxy.log.WithName("whitelist").WithValues("file", cfg.File)
+	store, err := whitelist.Open(cfg.File)
+	if err != nil {
+		log.Error(err, "error loading whitelist file, all players are denied")
+		return
+	}
+	w.store = store
+	log.Info("loaded whitelist file", "players", len(store.List()))
+
+	var watchCtx context.Context
+	watchCtx, w.stop = context.WithCancel(ctx)
+	if err = reload.Watch(watchCtx, cfg.File, func() error {
+		if err := store.Reload(); err != nil {
+			log.Error(err, "error reloading whitelist file, keeping previous whitelist")
+			return reload.Reject("invalid")
+		}
+		return nil
+	}); err != nil {
+		log.Error(err, "error watching whitelist file for changes")
+	}
+}
@@ -0,0 +1,42 @@
+package proxy
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/config"
+)
+
+func TestWhitelistApplies(t *testing.T) {
+	assert.True(t, whitelistApplies(config.Whitelist{}, "play.example.com"))
+	cfg := config.Whitelist{Hosts: []string{"Event.example.com"}}
+	assert.True(t, whitelistApplies(cfg, "event.example.com"))
+	assert.False(t, whitelistApplies(cfg, "play.example.com"))
+}
+
+func TestWhitelistAllowed(t *testing.T) {
+	proxy := createTestProxyWithForcedHosts(t, map[string]string{"lobby": "localhost:25566"}, nil, nil)
+	w := newWhitelist(proxy)
+	steve := newTestQueuePlayer("Steve")
+	alex := newTestQueuePlayer("Alex")
+	assert.True(t, w.Allowed(alex), "whitelist disabled")
+
+	proxy.cfg.Whitelist = config.Whitelist{Enabled: true, File: filepath.Join(t.TempDir(), "whitelist.yml")}
+	assert.False(t, w.Allowed(steve), "whitelist not loaded")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.apply(ctx, proxy.cfg.Whitelist)
+	_, err := w.Add("steve")
+	require.NoError(t, err)
+	assert.True(t, w.Allowed(steve))
+	assert.False(t, w.Allowed(alex))
+
+	w.apply(ctx, config.Whitelist{})
+	_, err = w.Add("alex")
+	assert.ErrorIs(t, err, ErrWhitelistDisabled)
+}
@@ -49,7 +49,8 @@ func setupAPI(gate *Gate, cfg *config.Config, eventMgr event.Manager, initialEna
 
 			if c.Config.API.Enabled {
 				configHandler := NewConfigHandler(gate, configFilePath)
-				svc := api.NewService(initialEnable, configHandler)
+				svc := api.NewService(initialEnable, configHandler,
+					api.WithPermissionHandler(NewPermissionHandler(gate)))
 				srv := api.NewServer(c.Config.API.Config, svc)
 
 				var runCtx context.Context
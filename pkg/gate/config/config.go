@@ -16,6 +16,10 @@ var DefaultConfig = Config{
 		Enabled: false,
 		Bind:    "0.0.0.0:9090",
 	},
+	Metrics: Metrics{
+		Enabled: false,
+		Bind:    "0.0.0.0:9464",
+	},
 	Connect: connect.DefaultConfig,
 	API: API{
 		Enabled: false,
@@ -29,6 +33,8 @@ type Config struct {
 	Config jconfig.Config `json:"config,omitempty" yaml:"config,omitempty"`
 	// See HealthService struct.
 	HealthService HealthService `json:"healthService,omitempty" yaml:"healthService,omitempty"`
+	// See Metrics struct.
+	Metrics Metrics `json:"metrics,omitempty" yaml:"metrics,omitempty"`
 	// See Connect struct.
 	Connect connect.Config `json:"connect,omitempty" yaml:"connect,omitempty"`
 	// See API struct.
@@ -45,6 +51,16 @@ type HealthService struct {
 	Bind    string `json:"bind,omitempty" yaml:"bind,omitempty"`
 }
 
+// Metrics is an HTTP endpoint serving the OpenTelemetry metrics
+// in the Prometheus text format at /metrics, so they can be scraped
+// without running an OpenTelemetry collector.
+type Metrics struct {
+	Enabled bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Bind    string `json:"bind,omitempty" yaml:"bind,omitempty"`
+	// UseHealthService serves /metrics on the health service listener instead of Bind.
+	UseHealthService bool `json:"useHealthService,omitempty" yaml:"useHealthService,omitempty"`
+}
+
 // API is the configuration for the Gate API.
 type API struct {
 	Enabled bool       `json:"enabled,omitempty" yaml:"enabled,omitempty"`
@@ -65,6 +81,16 @@ func (c *Config) Validate() (warns []error, errs []error) {
 		}
 	}
 
+	if c.Metrics.Enabled {
+		if c.Metrics.UseHealthService {
+			if !c.HealthService.Enabled {
+				e("Metrics can only use the health service listener if the health service is enabled")
+			}
+		} else if err := validation.ValidHostPort(c.Metrics.Bind); err != nil {
+			e("Invalid metrics bind address %q: %v", c.Metrics.Bind, err)
+		}
+	}
+
 	prefix := func(p string, errs []error) (pErrs []error) {
 		for _, err := range errs {
 			pErrs = append(pErrs, fmt.Errorf("%s: %w", p, err))
@@ -83,10 +83,15 @@ func New(options Options) (gate *Gate, err error) {
 	})
 
 	gate = &Gate{
-		proc: process.New(process.Options{AllOrNothing: true}),
+		proc:        process.New(process.Options{AllOrNothing: true}),
+		health:      newHealthState(eventMgr),
+		permissions: newPermissionState(),
 	}
 
 	c := options.Config
+	if c.Metrics.Enabled {
+		gate.prometheus = otelutil.NewPrometheus()
+	}
 	gate.currentConfig.Store(c)
 	// Java proxy is always created (embedded config)
 	gate.javaProxy, err = jproxy.New(jproxy.Options{
@@ -98,7 +103,9 @@ func New(options Options) (gate *Gate, err error) {
 	}
 	if err = gate.proc.Add(process.RunnableFunc(func(ctx context.Context) error {
 		ctx = logr.NewContext(ctx, logr.FromContextOrDiscard(ctx).WithName("java"))
-		return gate.javaProxy.Start(ctx)
+		err := gate.javaProxy.Start(ctx)
+		gate.health.fail(edition.Java, err)
+		return err
 	})); err != nil {
 		return nil, err
 	}
@@ -126,6 +133,18 @@ func New(options Options) (gate *Gate, err error) {
 		return nil, err
 	}
 
+	if err = setupHealthService(gate.proc, c, eventMgr, gate); err != nil {
+		return nil, err
+	}
+
+	if err = setupMetricsService(gate.proc, c, eventMgr, gate); err != nil {
+		return nil, err
+	}
+
+	if err = setupPermissions(gate.proc, c, eventMgr, gate); err != nil {
+		return nil, err
+	}
+
 	if err = gate.proc.Add(setupAPI(gate, c, eventMgr, gate.Java(), options.ConfigFilePath)); err != nil {
 		return nil, err
 	}
@@ -135,9 +154,12 @@ func New(options Options) (gate *Gate, err error) {
 
 // Gate is the root holder of various child processes.
 type Gate struct {
-	javaProxy    *jproxy.Proxy      // The Java edition proxy.
-	bedrockProxy *bproxy.Proxy      // The Bedrock edition proxy.
-	proc         process.Collection // Parallel running proc.
+	javaProxy    *jproxy.Proxy        // The Java edition proxy.
+	bedrockProxy *bproxy.Proxy        // The Bedrock edition proxy.
+	proc         process.Collection   // Parallel running proc.
+	health       *healthState         // Serving status reported by the health service.
+	permissions  *permissionState     // The built-in permission provider.
+	prometheus   *otelutil.Prometheus // Collects metrics for the /metrics endpoint, nil if disabled.
 
 	// currentConfig is an immutable, atomically published runtime snapshot.
 	// reloadMu serializes validate/prepare/commit so readers only observe whole snapshots.
@@ -314,6 +336,7 @@ type startOptions struct {
 	conf                      *config.Config
 	autoShutdownOnSignal      bool
 	autoConfigReloadWatchPath string
+	console                   *bool
 }
 
 // WithConfig is a StartOption for Start
@@ -335,6 +358,17 @@ func WithAutoShutdownOnSignal(enabled bool) StartOption {
 	}
 }
 
+// WithConsole is a StartOption for Start
+// that reads commands from stdin and executes them
+// as proxy.ConsoleSource with all permissions.
+//
+// This setting is enabled by default if stdin is a terminal.
+func WithConsole(enabled bool) StartOption {
+	return func(o *startOptions) {
+		o.console = &enabled
+	}
+}
+
 // LoadConfigFunc is a function that loads in a config.Config.
 type LoadConfigFunc func() (*config.Config, error)
 
@@ -408,7 +442,7 @@ func Start(ctx context.Context, opts ...StartOption) error {
 	}
 
 	// Initialize OpenTelemetry
-	otelShutdown, err := otelutil.Init(ctx)
+	otelShutdown, err := otelutil.Init(ctx, gate.prometheus)
 	if err != nil {
 		return fmt.Errorf("error initializing OpenTelemetry: %w", err)
 	}
@@ -423,10 +457,25 @@ func Start(ctx context.Context, opts ...StartOption) error {
 		return fmt.Errorf("error setting up auto config reload: %w", err)
 	}
 
+	// Read commands from stdin if enabled.
+	if c.console == nil && stdinIsTerminal() || c.console != nil && *c.console {
+		go func() {
+			if err := gate.Java().RunConsole(ctx, os.Stdin, os.Stdout); err != nil {
+				log.Error(err, "error reading console commands")
+			}
+		}()
+	}
+
 	// Start everything
 	return gate.Start(ctx)
 }
 
+// stdinIsTerminal reports whether stdin is an interactive terminal.
+func stdinIsTerminal() bool {
+	fi, err := os.Stdin.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
 // setupAutoConfigReload sets up auto config reload if enabled.
 func setupAutoConfigReload(
 	ctx context.Context,
@@ -583,20 +632,25 @@ func finishConfigCandidate(v *viper.Viper, cfg *config.Config) *config.Config {
 	}
 
 	// Normalize forced hosts keys to lowercase
-	if len(cfg.Config.ForcedHosts) > 0 {
-		normalizedForcedHosts := make(map[string][]string, len(cfg.Config.ForcedHosts))
-		for host, servers := range cfg.Config.ForcedHosts {
-			// Convert hostname to lowercase for consistent lookup
-			normalizedHost := strings.ToLower(host)
-			normalizedForcedHosts[normalizedHost] = servers
-		}
-		cfg.Config.ForcedHosts = normalizedForcedHosts
-	}
+	cfg.Config.ForcedHosts = normalizeForcedHosts(cfg.Config.ForcedHosts)
+	cfg.Config.BedrockForcedHosts = normalizeForcedHosts(cfg.Config.BedrockForcedHosts)
 
 	// Java config is now embedded directly in cfg.Config
 	return cfg
 }
 
+// normalizeForcedHosts converts the hostnames of forced hosts to lowercase for consistent lookup.
+func normalizeForcedHosts(forcedHosts jconfig.ForcedHosts) jconfig.ForcedHosts {
+	if len(forcedHosts) == 0 {
+		return forcedHosts
+	}
+	normalized := make(jconfig.ForcedHosts, len(forcedHosts))
+	for host, servers := range forcedHosts {
+		normalized[strings.ToLower(host)] = servers
+	}
+	return normalized
+}
+
 func readDecodedConfig(v *viper.Viper, extension string, cfg *config.Config) error {
 	var (
 		marshal    func(any) ([]byte, error)
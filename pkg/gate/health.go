@@ -0,0 +1,317 @@
+package gate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.minekube.com/gate/pkg/edition"
+	jproxy "go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/gate/config"
+	"go.minekube.com/gate/pkg/internal/hashutil"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/runtime/process"
+)
+
+// healthRefreshInterval is how often subsystem failures
+// that are not signaled by events are picked up.
+const healthRefreshInterval = 5 * time.Second
+
+// healthState tracks whether Gate is able to serve players.
+//
+// Gate is ready once the Java proxy listener accepts connections (ReadyEvent)
+// and no longer ready once it starts shutting down (PreShutdownEvent)
+// or any of its subsystems failed.
+type healthState struct {
+	ready        atomic.Bool
+	shuttingDown atomic.Bool
+
+	mu       sync.Mutex
+	failures map[edition.Edition]error // failed subsystems
+
+	changed chan struct{} // signaled when the state changed
+}
+
+func newHealthState(eventMgr event.Manager) *healthState {
+	h := &healthState{
+		failures: map[edition.Edition]error{},
+		changed:  make(chan struct{}, 1),
+	}
+	event.Subscribe(eventMgr, 0, func(*jproxy.ReadyEvent) {
+		h.ready.Store(true)
+		h.notify()
+	})
+	event.Subscribe(eventMgr, 0, func(*jproxy.PreShutdownEvent) {
+		h.shuttingDown.Store(true)
+		h.notify()
+	})
+	return h
+}
+
+func (h *healthState) notify() {
+	select {
+	case h.changed <- struct{}{}:
+	default:
+	}
+}
+
+// fail marks a subsystem as failed. A nil error clears the failure.
+func (h *healthState) fail(e edition.Edition, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err == nil {
+		delete(h.failures, e)
+		return
+	}
+	if _, ok := h.failures[e]; !ok {
+		defer h.notify()
+	}
+	h.failures[e] = err
+}
+
+// failure returns the error of a failed subsystem or nil.
+func (h *healthState) failure(e edition.Edition) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failures[e]
+}
+
+// live returns an error if Gate failed in a way that requires a restart.
+// Only failures of the Java proxy count, as restarting it doesn't help
+// with the other subsystems (e.g. an unreachable Geyser), see readiness.
+func (h *healthState) live() error {
+	if err := h.failure(edition.Java); err != nil {
+		return fmt.Errorf("%s: %w", edition.Java, err)
+	}
+	return nil
+}
+
+// readiness returns an error if Gate is not ready to serve players.
+func (h *healthState) readiness() error {
+	switch {
+	case h.shuttingDown.Load():
+		return errors.New("shutting down")
+	case !h.ready.Load():
+		return errors.New("not ready")
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var errs []error
+	for e, err := range h.failures {
+		errs = append(errs, fmt.Errorf("%s: %w", e, err))
+	}
+	return errors.Join(errs...)
+}
+
+// setupHealthService sets up the health service with reload support.
+func setupHealthService(
+	coll process.Collection,
+	c *config.Config,
+	eventMgr event.Manager,
+	gate *Gate,
+) error {
+	return coll.Add(process.RunnableFunc(func(ctx context.Context) error {
+		log := logr.FromContextOrDiscard(ctx).WithName("health")
+		ctx = logr.NewContext(ctx, log)
+
+		var (
+			mu                sync.Mutex
+			stop              context.CancelFunc
+			currentConfigHash []byte
+		)
+		trigger := func(c *config.Config) {
+			metrics := gate.metricsHandler(c)
+			newConfigHash, err := hashutil.JsonHash(struct {
+				config.HealthService
+				Metrics bool
+			}{c.HealthService, metrics != nil})
+			if err != nil {
+				log.Error(err, "error hashing health service config")
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// check if config changed
+			if bytes.Equal(newConfigHash, currentConfigHash) {
+				return // no change
+			}
+			currentConfigHash = newConfigHash
+
+			if stop != nil {
+				stop()
+				stop = nil
+			}
+
+			if c.HealthService.Enabled {
+				srv := newHealthServer(c.HealthService, gate)
+				srv.metrics = metrics
+				var runCtx context.Context
+				runCtx, stop = context.WithCancel(ctx)
+				go func() {
+					if err := srv.Start(runCtx); err != nil {
+						log.Error(err, "failed to start health service")
+						return
+					}
+					log.Info("health service stopped")
+				}()
+			}
+		}
+
+		defer reload.Subscribe(eventMgr, func(c *reload.ConfigUpdateEvent[config.Config]) {
+			trigger(c.Config)
+		})()
+
+		trigger(c)
+
+		<-ctx.Done()
+		return nil
+	}))
+}
+
+// healthServer serves the standard gRPC health checking protocol (grpc.health.v1)
+// as well as the plain HTTP /healthz (liveness) and /readyz (readiness) endpoints
+// on the same address. If metrics is set, it is also served at /metrics.
+type healthServer struct {
+	cfg     config.HealthService
+	gate    *Gate
+	metrics http.Handler
+
+	grpcHealth *health.Server
+}
+
+func newHealthServer(cfg config.HealthService, gate *Gate) *healthServer {
+	return &healthServer{
+		cfg:        cfg,
+		gate:       gate,
+		grpcHealth: health.NewServer(),
+	}
+}
+
+// Start serves the health service until ctx is canceled.
+func (s *healthServer) Start(ctx context.Context) error {
+	log := logr.FromContextOrDiscard(ctx)
+	log.Info("starting health service", "bind", s.cfg.Bind)
+
+	grpcSrv := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcSrv, s.grpcHealth)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.probe(s.gate.health.live))
+	mux.HandleFunc("/readyz", s.probe(s.readiness))
+	if s.metrics != nil {
+		mux.Handle("/metrics", s.metrics)
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcSrv.ServeHTTP(w, r)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+
+	hs := &http.Server{
+		Addr: s.cfg.Bind,
+		Handler: h2c.NewHandler(handler, &http2.Server{
+			IdleTimeout: time.Second * 30,
+		}),
+		ReadHeaderTimeout: time.Second * 5,
+		IdleTimeout:       time.Second * 30,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		s.update()
+		ticker := time.NewTicker(healthRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				s.grpcHealth.Shutdown()
+				return nil
+			case <-ticker.C:
+				s.update()
+			case <-s.gate.health.changed:
+				s.update()
+			}
+		}
+	})
+	eg.Go(func() error {
+		<-ctx.Done()
+		stopCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		return hs.Shutdown(stopCtx)
+	})
+	eg.Go(func() error {
+		if err := hs.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+
+	return eg.Wait()
+}
+
+// readiness refreshes the gRPC serving status and returns the readiness error.
+func (s *healthServer) readiness() error {
+	s.update()
+	return s.gate.health.readiness()
+}
+
+// update sets the gRPC serving status of the overall server ("")
+// and of the individual editions.
+func (s *healthServer) update() {
+	h := s.gate.health
+	if s.gate.bedrockProxy != nil {
+		h.fail(edition.Bedrock, s.gate.bedrockProxy.Err())
+	}
+
+	status := func(err error) healthpb.HealthCheckResponse_ServingStatus {
+		if err != nil {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	ready := h.readiness()
+	s.grpcHealth.SetServingStatus("", status(ready))
+	javaErr := h.failure(edition.Java)
+	if javaErr == nil && (h.shuttingDown.Load() || !h.ready.Load()) {
+		javaErr = ready
+	}
+	s.grpcHealth.SetServingStatus(strings.ToLower(string(edition.Java)), status(javaErr))
+	if s.gate.bedrockProxy != nil {
+		s.grpcHealth.SetServingStatus(strings.ToLower(string(edition.Bedrock)), status(h.failure(edition.Bedrock)))
+	}
+}
+
+// probe returns an HTTP handler responding 200 if check returns nil and 503 otherwise.
+func (s *healthServer) probe(check func() error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if err := check(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintln(w, err)
+			return
+		}
+		_, _ = fmt.Fprintln(w, "ok")
+	}
+}
@@ -0,0 +1,71 @@
+package gate
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/robinbraemer/event"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.minekube.com/gate/pkg/edition"
+	jproxy "go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/gate/config"
+)
+
+func TestHealthStateTransitions(t *testing.T) {
+	mgr := event.New()
+	h := newHealthState(mgr)
+
+	require.Error(t, h.readiness(), "must not be ready before ReadyEvent")
+	require.NoError(t, h.live())
+
+	mgr.Fire(&jproxy.ReadyEvent{})
+	require.NoError(t, h.readiness())
+
+	h.fail(edition.Bedrock, errors.New("geyser down"))
+	require.ErrorContains(t, h.readiness(), "geyser down")
+	require.NoError(t, h.live(), "bedrock failures must not restart the java proxy")
+
+	h.fail(edition.Bedrock, nil)
+	require.NoError(t, h.readiness())
+
+	mgr.Fire(&jproxy.PreShutdownEvent{})
+	require.ErrorContains(t, h.readiness(), "shutting down")
+	require.NoError(t, h.live(), "shutting down is not a liveness failure")
+}
+
+func TestHealthServerProbes(t *testing.T) {
+	mgr := event.New()
+	g := &Gate{health: newHealthState(mgr)}
+	s := newHealthServer(config.HealthService{Enabled: true, Bind: "localhost:0"}, g)
+
+	probe := func(check func() error) int {
+		rec := httptest.NewRecorder()
+		s.probe(check)(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusOK, probe(g.health.live))
+	require.Equal(t, http.StatusServiceUnavailable, probe(s.readiness))
+	resp, err := s.grpcHealth.Check(t.Context(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+
+	mgr.Fire(&jproxy.ReadyEvent{})
+	require.Equal(t, http.StatusOK, probe(s.readiness))
+	resp, err = s.grpcHealth.Check(t.Context(), &healthpb.HealthCheckRequest{Service: "java"})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	g.health.fail(edition.Bedrock, errors.New("geyser down"))
+	require.Equal(t, http.StatusOK, probe(g.health.live))
+	require.Equal(t, http.StatusServiceUnavailable, probe(s.readiness))
+	g.health.fail(edition.Bedrock, nil)
+
+	g.health.fail(edition.Java, errors.New("listener failed"))
+	require.Equal(t, http.StatusServiceUnavailable, probe(g.health.live))
+	require.Equal(t, http.StatusServiceUnavailable, probe(s.readiness))
+}
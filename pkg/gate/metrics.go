@@ -0,0 +1,123 @@
+package gate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+
+	"go.minekube.com/gate/pkg/gate/config"
+	"go.minekube.com/gate/pkg/internal/hashutil"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/runtime/process"
+)
+
+// setupMetricsService sets up the Prometheus metrics endpoint with reload support.
+// If the endpoint shares the health service listener it is served by the health service instead.
+func setupMetricsService(
+	coll process.Collection,
+	c *config.Config,
+	eventMgr event.Manager,
+	gate *Gate,
+) error {
+	return coll.Add(process.RunnableFunc(func(ctx context.Context) error {
+		log := logr.FromContextOrDiscard(ctx).WithName("metrics")
+		ctx = logr.NewContext(ctx, log)
+
+		var (
+			mu                sync.Mutex
+			stop              context.CancelFunc
+			currentConfigHash []byte
+		)
+		trigger := func(c *config.Config) {
+			newConfigHash, err := hashutil.JsonHash(c.Metrics)
+			if err != nil {
+				log.Error(err, "error hashing metrics config")
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// check if config changed
+			if bytes.Equal(newConfigHash, currentConfigHash) {
+				return // no change
+			}
+			currentConfigHash = newConfigHash
+
+			if stop != nil {
+				stop()
+				stop = nil
+			}
+
+			if !c.Metrics.Enabled {
+				return
+			}
+			if gate.prometheus == nil {
+				log.Info("metrics were enabled after startup, restart Gate to serve them")
+				return
+			}
+			if c.Metrics.UseHealthService {
+				return // served by the health service
+			}
+			var runCtx context.Context
+			runCtx, stop = context.WithCancel(ctx)
+			go func() {
+				if err := serveMetrics(runCtx, c.Metrics.Bind, gate.prometheus.Handler()); err != nil {
+					log.Error(err, "failed to start metrics service")
+					return
+				}
+				log.Info("metrics service stopped")
+			}()
+		}
+
+		defer reload.Subscribe(eventMgr, func(c *reload.ConfigUpdateEvent[config.Config]) {
+			trigger(c.Config)
+		})()
+
+		trigger(c)
+
+		<-ctx.Done()
+		return nil
+	}))
+}
+
+// metricsHandler returns the handler the health service should serve at /metrics or nil.
+func (g *Gate) metricsHandler(c *config.Config) http.Handler {
+	if g.prometheus == nil || !c.Metrics.Enabled || !c.Metrics.UseHealthService {
+		return nil
+	}
+	return g.prometheus.Handler()
+}
+
+// serveMetrics serves the metrics handler at /metrics on bind until ctx is canceled.
+func serveMetrics(ctx context.Context, bind string, metrics http.Handler) error {
+	logr.FromContextOrDiscard(ctx).Info("starting metrics service", "bind", bind)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+
+	hs := &http.Server{
+		Addr:              bind,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second * 5,
+		IdleTimeout:       time.Second * 30,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		stopCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		_ = hs.Shutdown(stopCtx)
+	}()
+	if err := hs.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+package gate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	"github.com/robinbraemer/event"
+
+	jconfig "go.minekube.com/gate/pkg/edition/java/config"
+	jproxy "go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/gate/config"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/internal/hashutil"
+	"go.minekube.com/gate/pkg/internal/reload"
+	"go.minekube.com/gate/pkg/runtime/process"
+	"go.minekube.com/gate/pkg/util/permission"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// permissionsSetupPriority is the priority of the built-in permission provider's
+// PermissionsSetupEvent subscriber. It runs before plugin subscribers with the
+// default priority so they can still override or wrap the provided permissions.
+const permissionsSetupPriority = 100
+
+// permissionState is the state of the built-in permission provider.
+type permissionState struct {
+	provider *permission.Provider
+
+	mu      sync.Mutex // Serializes file modifications.
+	enabled bool
+	file    string
+	loadErr error // The last error loading file, modifications are refused to not overwrite it.
+}
+
+func newPermissionState() *permissionState {
+	return &permissionState{provider: new(permission.Provider)}
+}
+
+// config returns whether the provider is enabled and its file.
+func (s *permissionState) config() (enabled bool, file string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enabled, s.file
+}
+
+// load loads the permissions file and replaces the provided permissions.
+func (s *permissionState) load(file string) error {
+	perms, err := permission.LoadFile(file)
+	s.loadErr = err
+	if err != nil {
+		return err
+	}
+	s.provider.Set(perms)
+	return nil
+}
+
+// setupPermissions sets up the built-in permission provider with reload support.
+func setupPermissions(
+	coll process.Collection,
+	c *config.Config,
+	eventMgr event.Manager,
+	gate *Gate,
+) error {
+	state := gate.permissions
+	return coll.Add(process.RunnableFunc(func(ctx context.Context) error {
+		log := logr.FromContextOrDiscard(ctx).WithName("permissions")
+		ctx = logr.NewContext(ctx, log)
+
+		defer event.Subscribe(eventMgr, permissionsSetupPriority, func(e *jproxy.PermissionsSetupEvent) {
+			player, ok := e.Subject().(jproxy.Player)
+			if !ok {
+				return
+			}
+			// Permissions of a disabled provider are undefined and fall back to e.Func(),
+			// so the provider can be enabled later without players having to reconnect.
+			e.SetFunc(state.provider.Func(player.ID(), e.Func()))
+		})()
+
+		var (
+			mu                sync.Mutex
+			stop              context.CancelFunc
+			currentConfigHash []byte
+		)
+		trigger := func(c *jconfig.Config) {
+			newConfigHash, err := hashutil.JsonHash(c.Permissions)
+			if err != nil {
+				log.Error(err, "error hashing permissions config")
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			// check if config changed
+			if bytes.Equal(newConfigHash, currentConfigHash) {
+				return // no change
+			}
+			currentConfigHash = newConfigHash
+
+			if stop != nil {
+				stop()
+				stop = nil
+			}
+
+			state.mu.Lock()
+			defer state.mu.Unlock()
+			state.enabled, state.file, state.loadErr = c.Permissions.Enabled, c.Permissions.File, nil
+			if !c.Permissions.Enabled {
+				state.provider.Set(nil)
+				return
+			}
+
+			if err = state.load(state.file); err != nil {
+				log.Error(err, "error loading permissions file, all permissions are undefined", "file", state.file)
+				state.provider.Set(nil)
+			} else {
+				log.Info("loaded permissions file", "file", state.file)
+			}
+
+			var watchCtx context.Context
+			watchCtx, stop = context.WithCancel(ctx)
+			file := state.file
+			if err = reload.Watch(watchCtx, file, func() error {
+				state.mu.Lock()
+				defer state.mu.Unlock()
+				if err := state.load(file); err != nil {
+					log.Error(err, "error reloading permissions file, keeping previous permissions", "file", file)
+					return reload.Reject("invalid")
+				}
+				return nil
+			}); err != nil {
+				log.Error(err, "error watching permissions file for changes", "file", file)
+			}
+		}
+
+		defer reload.Subscribe(eventMgr, func(c *reload.ConfigUpdateEvent[config.Config]) {
+			trigger(&c.Config.Config)
+		})()
+
+		trigger(&c.Config)
+
+		<-ctx.Done()
+		return nil
+	}))
+}
+
+// PermissionHandlerImpl manages the built-in permission provider through the API.
+// Modifications are validated and persisted to the permissions file.
+type PermissionHandlerImpl struct {
+	gate *Gate
+}
+
+func NewPermissionHandler(gate *Gate) *PermissionHandlerImpl {
+	return &PermissionHandlerImpl{gate: gate}
+}
+
+var errPermissionsDisabled = connect.NewError(connect.CodeFailedPrecondition,
+	errors.New("built-in permission provider is disabled"))
+
+func (h *PermissionHandlerImpl) GetPermissions(context.Context, *pb.GetPermissionsRequest) (*pb.GetPermissionsResponse, error) {
+	state := h.gate.permissions
+	if enabled, _ := state.config(); !enabled {
+		return nil, errPermissionsDisabled
+	}
+	perms := state.provider.Permissions()
+	if perms == nil {
+		perms = &permission.Permissions{}
+	}
+
+	response := &pb.GetPermissionsResponse{}
+	for name, g := range perms.Groups {
+		response.Groups = append(response.Groups, permissionGroupToProto(name, g))
+	}
+	sort.Slice(response.Groups, func(i, j int) bool {
+		return response.Groups[i].Name < response.Groups[j].Name
+	})
+	for id, pl := range perms.Players {
+		response.Players = append(response.Players, playerPermissionsToProto(id, pl))
+	}
+	sort.Slice(response.Players, func(i, j int) bool {
+		return response.Players[i].Id < response.Players[j].Id
+	})
+	return response, nil
+}
+
+func (h *PermissionHandlerImpl) CheckPermission(_ context.Context, req *pb.CheckPermissionRequest) (*pb.CheckPermissionResponse, error) {
+	if strings.TrimSpace(req.GetPermission()) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("permission must not be empty"))
+	}
+	id, player, err := h.resolvePlayer(req.GetPlayer())
+	if err != nil {
+		return nil, err
+	}
+
+	var value permission.TriState
+	if player != nil {
+		value = player.PermissionValue(req.GetPermission())
+	} else {
+		if enabled, _ := h.gate.permissions.config(); !enabled {
+			return nil, errPermissionsDisabled
+		}
+		value = h.gate.permissions.provider.Value(id, req.GetPermission())
+	}
+	return &pb.CheckPermissionResponse{
+		Value:   permissionValueToProto(value),
+		Allowed: value.Bool(),
+	}, nil
+}
+
+func (h *PermissionHandlerImpl) SetPermissionGroup(_ context.Context, req *pb.SetPermissionGroupRequest) (*pb.SetPermissionGroupResponse, error) {
+	group := req.GetGroup()
+	if strings.TrimSpace(group.GetName()) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group name must not be empty"))
+	}
+	err := h.modify(func(perms *permission.Permissions) error {
+		if perms.Groups == nil {
+			perms.Groups = map[string]*permission.Group{}
+		}
+		perms.Groups[group.GetName()] = &permission.Group{
+			Parents:     slices.Clone(group.GetParents()),
+			Permissions: slices.Clone(group.GetPermissions()),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetPermissionGroupResponse{}, nil
+}
+
+func (h *PermissionHandlerImpl) DeletePermissionGroup(_ context.Context, req *pb.DeletePermissionGroupRequest) (*pb.DeletePermissionGroupResponse, error) {
+	err := h.modify(func(perms *permission.Permissions) error {
+		if _, ok := perms.Groups[req.GetName()]; !ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("group %q not found", req.GetName()))
+		}
+		delete(perms.Groups, req.GetName())
+		if errs := perms.Validate(); len(errs) != 0 {
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("group %q is still referenced: %w", req.GetName(), errors.Join(errs...)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeletePermissionGroupResponse{}, nil
+}
+
+func (h *PermissionHandlerImpl) SetPlayerPermissions(_ context.Context, req *pb.SetPlayerPermissionsRequest) (*pb.SetPlayerPermissionsResponse, error) {
+	id, player, err := h.resolvePlayer(req.GetPlayer())
+	if err != nil {
+		return nil, err
+	}
+
+	var result *permission.Player
+	err = h.modify(func(perms *permission.Permissions) error {
+		if len(req.GetGroups()) == 0 && len(req.GetPermissions()) == 0 {
+			delete(perms.Players, id.String())
+			result = &permission.Player{}
+			return nil
+		}
+		result = &permission.Player{
+			Groups:      slices.Clone(req.GetGroups()),
+			Permissions: slices.Clone(req.GetPermissions()),
+		}
+		if player != nil {
+			result.Name = player.Username()
+		} else if prev := perms.Players[id.String()]; prev != nil {
+			result.Name = prev.Name
+		}
+		if perms.Players == nil {
+			perms.Players = map[string]*permission.Player{}
+		}
+		perms.Players[id.String()] = result
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetPlayerPermissionsResponse{
+		Player: playerPermissionsToProto(id.String(), result),
+	}, nil
+}
+
+// resolvePlayer resolves a player by ID or the username of an online player.
+// The returned player is nil if the player is offline.
+func (h *PermissionHandlerImpl) resolvePlayer(s string) (uuid.UUID, jproxy.Player, error) {
+	p := h.gate.Java()
+	if id, err := uuid.Parse(s); err == nil {
+		if p == nil {
+			return id, nil, nil
+		}
+		return id, p.Player(id), nil
+	}
+	if p != nil {
+		if player := p.PlayerByName(s); player != nil {
+			return player.ID(), player, nil
+		}
+	}
+	return uuid.Nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("player %q not found", s))
+}
+
+// modify applies fn to a copy of the current permissions,
+// validates and persists the result and then provides it.
+func (h *PermissionHandlerImpl) modify(fn func(perms *permission.Permissions) error) error {
+	state := h.gate.permissions
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if !state.enabled {
+		return errPermissionsDisabled
+	}
+	if state.loadErr != nil {
+		return connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("permissions file could not be loaded, fix it before modifying permissions"))
+	}
+
+	perms := state.provider.Permissions().Clone()
+	if err := fn(perms); err != nil {
+		return err
+	}
+	if err := errors.Join(perms.Validate()...); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := permission.SaveFile(state.file, perms); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("error saving permissions file: %w", err))
+	}
+	state.provider.Set(perms)
+	return nil
+}
+
+func permissionGroupToProto(name string, g *permission.Group) *pb.PermissionGroup {
+	if g == nil {
+		return &pb.PermissionGroup{Name: name}
+	}
+	return &pb.PermissionGroup{
+		Name:        name,
+		Parents:     g.Parents,
+		Permissions: g.Permissions,
+	}
+}
+
+func playerPermissionsToProto(id string, pl *permission.Player) *pb.PlayerPermissions {
+	if pl == nil {
+		return &pb.PlayerPermissions{Id: id}
+	}
+	return &pb.PlayerPermissions{
+		Id:          id,
+		Name:        pl.Name,
+		Groups:      pl.Groups,
+		Permissions: pl.Permissions,
+	}
+}
+
+func permissionValueToProto(v permission.TriState) pb.PermissionValue {
+	switch v {
+	case permission.True:
+		return pb.PermissionValue_PERMISSION_VALUE_TRUE
+	case permission.False:
+		return pb.PermissionValue_PERMISSION_VALUE_FALSE
+	default:
+		return pb.PermissionValue_PERMISSION_VALUE_UNSPECIFIED
+	}
+}
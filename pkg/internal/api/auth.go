@@ -0,0 +1,229 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+
+	"go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1/gatev1connect"
+)
+
+// Role is the role of an API caller. Each role allows a set of RPCs
+// and includes the RPCs of the roles before it.
+type Role string
+
+const (
+	// RoleReadOnly allows reading players, servers and the proxy state.
+	RoleReadOnly Role = "read-only"
+	// RolePlayerOps additionally allows managing and messaging players, e.g. moving, kicking and banning them.
+	RolePlayerOps Role = "player-ops"
+	// RoleConfigAdmin allows all RPCs, including reading and changing the config
+	// as well as servers and permissions.
+	RoleConfigAdmin Role = "config-admin"
+)
+
+// Roles are the valid roles in the order of the RPCs they allow.
+var Roles = []Role{RoleReadOnly, RolePlayerOps, RoleConfigAdmin}
+
+// Valid returns true if the role is one of Roles.
+func (r Role) Valid() bool {
+	return slices.Contains(Roles, r)
+}
+
+// allows returns true if the role allows calling the RPC procedure.
+func (r Role) allows(procedure string) bool {
+	return slices.Index(Roles, r) >= slices.Index(Roles, procedureRole(procedure))
+}
+
+// procedureRoles are the roles required to call the RPCs.
+// RPCs not listed require RoleConfigAdmin.
+var procedureRoles = map[string]Role{
+	gatev1connect.GateServiceGetPlayerProcedure:        RoleReadOnly,
+	gatev1connect.GateServiceListPlayersProcedure:      RoleReadOnly,
+	gatev1connect.GateServiceListServersProcedure:      RoleReadOnly,
+	gatev1connect.GateServiceGetStatusProcedure:        RoleReadOnly,
+	gatev1connect.GateServiceGetPermissionsProcedure:   RoleReadOnly,
+	gatev1connect.GateServiceCheckPermissionProcedure:  RoleReadOnly,
+	gatev1connect.GateServiceListQueuesProcedure:       RoleReadOnly,
+	gatev1connect.GateServiceListPunishmentsProcedure:  RoleReadOnly,
+	gatev1connect.GateServiceListChatChannelsProcedure: RoleReadOnly,
+	gatev1connect.GateServiceGetMaintenanceProcedure:   RoleReadOnly,
+	gatev1connect.GateServiceListWhitelistProcedure:    RoleReadOnly,
+	gatev1connect.GateServiceWatchEventsProcedure:      RoleReadOnly,
+
+	gatev1connect.GateServiceConnectPlayerProcedure:          RolePlayerOps,
+	gatev1connect.GateServiceDisconnectPlayerProcedure:       RolePlayerOps,
+	gatev1connect.GateServiceStoreCookieProcedure:            RolePlayerOps,
+	gatev1connect.GateServiceRequestCookieProcedure:          RolePlayerOps,
+	gatev1connect.GateServiceEnqueuePlayerProcedure:          RolePlayerOps,
+	gatev1connect.GateServiceDequeuePlayerProcedure:          RolePlayerOps,
+	gatev1connect.GateServiceClearQueueProcedure:             RolePlayerOps,
+	gatev1connect.GateServiceAddPunishmentProcedure:          RolePlayerOps,
+	gatev1connect.GateServiceRemovePunishmentProcedure:       RolePlayerOps,
+	gatev1connect.GateServiceSendChatChannelMessageProcedure: RolePlayerOps,
+	gatev1connect.GateServiceAddWhitelistEntryProcedure:      RolePlayerOps,
+	gatev1connect.GateServiceRemoveWhitelistEntryProcedure:   RolePlayerOps,
+	gatev1connect.GateServiceSendMessageProcedure:            RolePlayerOps,
+	gatev1connect.GateServiceSendActionBarProcedure:          RolePlayerOps,
+	gatev1connect.GateServiceShowTitleProcedure:              RolePlayerOps,
+	gatev1connect.GateServicePlaySoundProcedure:              RolePlayerOps,
+	gatev1connect.GateServiceShowBossBarProcedure:            RolePlayerOps,
+	gatev1connect.GateServiceHideBossBarProcedure:            RolePlayerOps,
+	gatev1connect.GateServiceSendResourcePackProcedure:       RolePlayerOps,
+
+	// The config includes secrets, such as the API tokens
+	gatev1connect.GateServiceGetConfigProcedure:      RoleConfigAdmin,
+	gatev1connect.GateServiceValidateConfigProcedure: RoleConfigAdmin,
+	// Commands can do anything the permissions of the command source allow
+	gatev1connect.GateServiceExecuteCommandProcedure: RoleConfigAdmin,
+	gatev1connect.GateServiceSuggestCommandProcedure: RoleConfigAdmin,
+}
+
+// procedureRole returns the role required to call the RPC procedure.
+func procedureRole(procedure string) Role {
+	if r, ok := procedureRoles[procedure]; ok {
+		return r
+	}
+	return RoleConfigAdmin
+}
+
+// mutating returns true if the RPC procedure changes state and is audit logged.
+func mutating(procedure string) bool {
+	switch procedure {
+	case gatev1connect.GateServiceGetConfigProcedure,
+		gatev1connect.GateServiceValidateConfigProcedure:
+		return false
+	}
+	return procedureRole(procedure) != RoleReadOnly
+}
+
+// Caller is an authenticated API caller.
+type Caller struct {
+	Name string // Token name or client certificate common name.
+	Role Role
+}
+
+type callerKey struct{}
+
+// CallerFromContext returns the authenticated caller of an RPC.
+// Returns false if the API is unauthenticated.
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	c, ok := ctx.Value(callerKey{}).(Caller)
+	return c, ok
+}
+
+type clientCertKey struct{}
+
+// withClientCert adds the common name of a verified mTLS client certificate to the request context.
+func withClientCert(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) != 0 && len(r.TLS.VerifiedChains[0]) != 0 {
+			cn := r.TLS.VerifiedChains[0][0].Subject.CommonName
+			r = r.WithContext(context.WithValue(r.Context(), clientCertKey{}, cn))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+var (
+	errUnauthenticated = errors.New("missing or invalid credentials")
+	errDenied          = errors.New("role does not allow this RPC")
+)
+
+// authInterceptor authenticates callers by bearer token or mTLS client certificate,
+// authorizes the called RPC by the caller's role and audit logs mutating calls.
+type authInterceptor struct {
+	auth Auth
+	log  logr.Logger // audit log
+}
+
+var _ connect.Interceptor = (*authInterceptor)(nil)
+
+func newAuthInterceptor(auth Auth, log logr.Logger) *authInterceptor {
+	return &authInterceptor{auth: auth, log: log}
+}
+
+// authenticate returns the caller of a request.
+// A valid bearer token takes precedence over a client certificate.
+func (a *authInterceptor) authenticate(ctx context.Context, header http.Header) (Caller, bool) {
+	if token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer "); ok {
+		for _, t := range a.auth.Tokens {
+			if subtle.ConstantTimeCompare([]byte(t.Token), []byte(token)) == 1 {
+				return Caller{Name: t.Name, Role: t.Role}, true
+			}
+		}
+	}
+	if cn, ok := ctx.Value(clientCertKey{}).(string); ok {
+		for _, c := range a.auth.Clients {
+			if c.CommonName == cn {
+				return Caller{Name: c.CommonName, Role: c.Role}, true
+			}
+		}
+	}
+	return Caller{}, false
+}
+
+// authorize authenticates and authorizes a call and returns the context with the caller.
+func (a *authInterceptor) authorize(ctx context.Context, procedure string, header http.Header) (context.Context, Caller, error) {
+	if !a.auth.Enabled() {
+		return ctx, Caller{}, nil
+	}
+	caller, ok := a.authenticate(ctx, header)
+	if !ok {
+		return ctx, caller, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+	if !caller.Role.allows(procedure) {
+		return ctx, caller, connect.NewError(connect.CodePermissionDenied, errDenied)
+	}
+	return context.WithValue(ctx, callerKey{}, caller), caller, nil
+}
+
+// audit logs a mutating call.
+func (a *authInterceptor) audit(procedure, peer string, caller Caller, err error) {
+	if !mutating(procedure) {
+		return
+	}
+	kv := []any{"procedure", procedure, "peer", peer}
+	if a.auth.Enabled() {
+		kv = append(kv, "caller", caller.Name, "role", caller.Role)
+	}
+	if err != nil {
+		kv = append(kv, "code", connect.CodeOf(err).String())
+	}
+	a.log.Info("api call", kv...)
+}
+
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		ctx, caller, err := a.authorize(ctx, procedure, req.Header())
+		var res connect.AnyResponse
+		if err == nil {
+			res, err = next(ctx, req)
+		}
+		a.audit(procedure, req.Peer().Addr, caller, err)
+		return res, err
+	}
+}
+
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		ctx, caller, err := a.authorize(ctx, procedure, conn.RequestHeader())
+		if err == nil {
+			err = next(ctx, conn)
+		}
+		a.audit(procedure, conn.Peer().Addr, caller, err)
+		return err
+	}
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1/gatev1connect"
+)
+
+func TestRoleAllows(t *testing.T) {
+	tests := []struct {
+		procedure string
+		allowed   []Role
+	}{
+		{gatev1connect.GateServiceListPlayersProcedure, Roles},
+		{gatev1connect.GateServiceDisconnectPlayerProcedure, []Role{RolePlayerOps, RoleConfigAdmin}},
+		{gatev1connect.GateServiceAddPunishmentProcedure, []Role{RolePlayerOps, RoleConfigAdmin}},
+		{gatev1connect.GateServiceApplyConfigProcedure, []Role{RoleConfigAdmin}},
+		{gatev1connect.GateServiceGetConfigProcedure, []Role{RoleConfigAdmin}},
+		{gatev1connect.GateServiceRegisterServerProcedure, []Role{RoleConfigAdmin}},
+		{"/minekube.gate.v1.GateService/Unknown", []Role{RoleConfigAdmin}},
+	}
+	for _, tt := range tests {
+		for _, r := range Roles {
+			require.Equalf(t, slices.Contains(tt.allowed, r), r.allows(tt.procedure), "%s %s", r, tt.procedure)
+		}
+	}
+	require.False(t, Role("admin").allows(gatev1connect.GateServiceListPlayersProcedure))
+}
+
+func TestMutating(t *testing.T) {
+	require.False(t, mutating(gatev1connect.GateServiceListPlayersProcedure))
+	require.False(t, mutating(gatev1connect.GateServiceGetConfigProcedure))
+	require.True(t, mutating(gatev1connect.GateServiceDisconnectPlayerProcedure))
+	require.True(t, mutating(gatev1connect.GateServiceApplyConfigProcedure))
+}
+
+func TestAuthInterceptorAuthorize(t *testing.T) {
+	a := newAuthInterceptor(Auth{
+		Tokens: []Token{
+			{Name: "dashboard", Token: "read-secret", Role: RoleReadOnly},
+			{Name: "ci", Token: "admin-secret", Role: RoleConfigAdmin},
+		},
+		Clients: []Client{{CommonName: "moderation-bot", Role: RolePlayerOps}},
+	}, logr.Discard())
+
+	bearer := func(token string) http.Header {
+		h := http.Header{}
+		h.Set("Authorization", "Bearer "+token)
+		return h
+	}
+	disconnect := gatev1connect.GateServiceDisconnectPlayerProcedure
+
+	ctx, caller, err := a.authorize(context.Background(), disconnect, bearer("admin-secret"))
+	require.NoError(t, err)
+	require.Equal(t, Caller{Name: "ci", Role: RoleConfigAdmin}, caller)
+	fromCtx, ok := CallerFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, caller, fromCtx)
+
+	_, _, err = a.authorize(context.Background(), disconnect, bearer("read-secret"))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, _, err = a.authorize(context.Background(), disconnect, bearer("wrong"))
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	_, _, err = a.authorize(context.Background(), disconnect, http.Header{})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	// mTLS client certificate
+	certCtx := context.WithValue(context.Background(), clientCertKey{}, "moderation-bot")
+	_, caller, err = a.authorize(certCtx, disconnect, http.Header{})
+	require.NoError(t, err)
+	require.Equal(t, "moderation-bot", caller.Name)
+	_, _, err = a.authorize(certCtx, gatev1connect.GateServiceApplyConfigProcedure, http.Header{})
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	unknownCtx := context.WithValue(context.Background(), clientCertKey{}, "someone")
+	_, _, err = a.authorize(unknownCtx, disconnect, http.Header{})
+	require.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	// Unauthenticated API
+	ctx, _, err = newAuthInterceptor(Auth{}, logr.Discard()).authorize(context.Background(), disconnect, http.Header{})
+	require.NoError(t, err)
+	_, ok = CallerFromContext(ctx)
+	require.False(t, ok)
+}
+
+func TestConfigValidateAuth(t *testing.T) {
+	valid := Config{
+		Bind: "0.0.0.0:8080",
+		Auth: Auth{
+			Tokens:  []Token{{Name: "ci", Token: "secret", Role: RoleConfigAdmin}},
+			Clients: []Client{{CommonName: "bot", Role: RolePlayerOps}},
+		},
+		TLS: TLS{CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: "ca.pem"},
+	}
+	warns, errs := valid.Validate()
+	require.Empty(t, errs)
+	require.Empty(t, warns)
+
+	plaintext := valid
+	plaintext.TLS = TLS{}
+	plaintext.Auth.Clients = nil
+	warns, errs = plaintext.Validate()
+	require.Empty(t, errs)
+	require.Len(t, warns, 1, "tokens sent in plaintext")
+
+	plaintext.Bind = "localhost:8080"
+	warns, _ = plaintext.Validate()
+	require.Empty(t, warns)
+
+	invalid := valid
+	invalid.Auth.Tokens = []Token{
+		{Name: "ci", Token: "secret", Role: "admin"},
+		{Name: "ci", Token: "secret", Role: RoleReadOnly},
+		{Token: ""},
+	}
+	invalid.TLS = TLS{CertFile: "cert.pem"}
+	_, errs = invalid.Validate()
+	require.Len(t, errs, 8)
+}
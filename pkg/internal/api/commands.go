@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"go.minekube.com/brigodier"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+func (s *Service) ExecuteCommand(ctx context.Context, c *connect.Request[pb.ExecuteCommandRequest]) (*connect.Response[pb.ExecuteCommandResponse], error) {
+	cmd := strings.TrimPrefix(strings.TrimSpace(c.Msg.GetCommand()), "/")
+	if cmd == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("command must not be empty"))
+	}
+	src, captured, err := s.commandSource(c.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+
+	// Commands may outlive the call, e.g. when connecting a player to a server
+	err = s.p.Command().Do(context.WithoutCancel(ctx), src, cmd)
+	var sErr *brigodier.CommandSyntaxError
+	switch {
+	case err == nil:
+	case errors.Is(err, brigodier.ErrDispatcherUnknownCommand), errors.Is(err, command.ErrForward):
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("unknown command"))
+	case errors.As(err, &sErr):
+		return nil, connect.NewError(connect.CodeInvalidArgument, sErr)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error running command: %v", err))
+	}
+
+	messages := captured.list()
+	res := &pb.ExecuteCommandResponse{Messages: make([]*pb.CommandMessage, len(messages))}
+	for i, msg := range messages {
+		res.Messages[i] = &pb.CommandMessage{
+			Json:  componentJSON(msg),
+			Plain: componentPlain(msg),
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) SuggestCommand(ctx context.Context, c *connect.Request[pb.SuggestCommandRequest]) (*connect.Response[pb.SuggestCommandResponse], error) {
+	src, _, err := s.commandSource(c.Msg.GetSource())
+	if err != nil {
+		return nil, err
+	}
+	cmd := strings.TrimPrefix(strings.TrimLeft(c.Msg.GetCommand(), " "), "/")
+	suggestions, err := s.p.Command().OfferSuggestions(ctx, src, cmd)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("error suggesting command: %v", err))
+	}
+	return connect.NewResponse(&pb.SuggestCommandResponse{Suggestions: suggestions}), nil
+}
+
+// commandSource returns the command source of a request and the messages sent to it.
+func (s *Service) commandSource(src *pb.CommandSource) (command.Source, *capturedMessages, error) {
+	captured := &capturedMessages{}
+	if src.GetPlayer() != "" {
+		player := s.player(src.GetPlayer())
+		if player == nil {
+			return nil, nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+		}
+		return &impersonatedPlayer{Player: player, captured: captured}, captured, nil
+	}
+	return &apiCommandSource{
+		perms:    permission.NodesFunc(src.GetPermissions()),
+		captured: captured,
+	}, captured, nil
+}
+
+// capturedMessages are the messages sent to a command source.
+type capturedMessages struct {
+	mu       sync.Mutex
+	messages []component.Component
+}
+
+func (c *capturedMessages) add(msg component.Component) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.messages = append(c.messages, msg)
+}
+
+func (c *capturedMessages) list() []component.Component {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]component.Component(nil), c.messages...)
+}
+
+// apiCommandSource is the command source of ExecuteCommand without an impersonated player.
+type apiCommandSource struct {
+	perms    permission.Func
+	captured *capturedMessages
+}
+
+var _ command.Source = (*apiCommandSource)(nil)
+
+func (s *apiCommandSource) HasPermission(perm string) bool {
+	return s.perms(perm).Bool()
+}
+
+func (s *apiCommandSource) PermissionValue(perm string) permission.TriState {
+	return s.perms(perm)
+}
+
+func (s *apiCommandSource) SendMessage(msg component.Component, _ ...command.MessageOption) error {
+	s.captured.add(msg)
+	return nil
+}
+
+// impersonatedPlayer is a player as command source whose messages are captured
+// instead of sent to the player. Commands still see the source as proxy.Player.
+type impersonatedPlayer struct {
+	proxy.Player
+	captured *capturedMessages
+}
+
+func (p *impersonatedPlayer) SendMessage(msg component.Component, _ ...command.MessageOption) error {
+	p.captured.add(msg)
+	return nil
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/permission"
+)
+
+func TestAPICommandSource(t *testing.T) {
+	s := NewService(nil, nil)
+	src, captured, err := s.commandSource(&pb.CommandSource{Permissions: []string{"gate.command.*", "-gate.command.send"}})
+	require.NoError(t, err)
+	require.True(t, src.HasPermission("gate.command.server"))
+	require.False(t, src.HasPermission("gate.command.send"))
+	require.Equal(t, permission.Undefined, src.PermissionValue("other"))
+
+	require.NoError(t, src.SendMessage(&component.Text{Content: "a"}))
+	require.NoError(t, src.SendMessage(&component.Text{Content: "b"}))
+	require.Len(t, captured.list(), 2)
+
+	src, _, err = s.commandSource(nil)
+	require.NoError(t, err)
+	require.False(t, src.HasPermission("gate.command.server"))
+}
+
+func TestExecuteCommandRequiresCommand(t *testing.T) {
+	s := NewService(nil, nil)
+	_, err := s.ExecuteCommand(context.Background(), connect.NewRequest(&pb.ExecuteCommandRequest{Command: " / "}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
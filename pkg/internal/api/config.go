@@ -3,8 +3,10 @@ package api
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
+	"go.minekube.com/gate/pkg/util/netutil"
 	"go.minekube.com/gate/pkg/util/validation"
 )
 
@@ -18,15 +20,117 @@ type Config struct {
 	// Bind is the address to bind the API server to.
 	// Using a localhost address is recommended to avoid exposing the API to the public.
 	Bind string `json:"bind,omitempty" yaml:"bind,omitempty"`
+	// Auth configures the authentication of callers and the RPCs their roles allow.
+	Auth Auth `json:"auth,omitempty" yaml:"auth,omitempty"`
+	// TLS configures serving the API over TLS instead of plaintext.
+	TLS TLS `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// Auth configures the authentication of API callers.
+// If neither tokens nor clients are configured, the API is unauthenticated
+// and every caller is allowed to call every RPC.
+type Auth struct {
+	// Tokens are the bearer tokens allowed to call the API,
+	// sent in the "Authorization: Bearer <token>" header.
+	Tokens []Token `json:"tokens,omitempty" yaml:"tokens,omitempty"`
+	// Clients are the mTLS client certificates allowed to call the API,
+	// identified by their subject common name. Requires TLS.ClientCAFile.
+	Clients []Client `json:"clients,omitempty" yaml:"clients,omitempty"`
+}
+
+// Token is a named bearer token with a role.
+type Token struct {
+	Name  string `json:"name" yaml:"name"`   // Name of the caller, logged in audit logs.
+	Token string `json:"token" yaml:"token"` // The secret token.
+	Role  Role   `json:"role" yaml:"role"`
+}
+
+// Client is an mTLS client certificate with a role.
+type Client struct {
+	CommonName string `json:"commonName" yaml:"commonName"` // Subject common name of the certificate.
+	Role       Role   `json:"role" yaml:"role"`
+}
+
+// TLS configures serving the API over TLS.
+type TLS struct {
+	CertFile string `json:"certFile,omitempty" yaml:"certFile,omitempty"` // Server certificate PEM file.
+	KeyFile  string `json:"keyFile,omitempty" yaml:"keyFile,omitempty"`   // Server private key PEM file.
+	// ClientCAFile is a PEM file of the CAs verifying mTLS client certificates, see Auth.Clients.
+	ClientCAFile string `json:"clientCAFile,omitempty" yaml:"clientCAFile,omitempty"`
+}
+
+// Enabled returns true if authentication is configured.
+func (a Auth) Enabled() bool {
+	return len(a.Tokens) != 0 || len(a.Clients) != 0
+}
+
+// Enabled returns true if TLS is configured.
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" || t.KeyFile != ""
 }
 
 // Validate validates the API configuration.
 func (c Config) Validate() (warns []error, errs []error) {
+	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
+	w := func(m string, args ...any) { warns = append(warns, fmt.Errorf(m, args...)) }
+
 	if strings.TrimSpace(c.Bind) == "" {
 		return nil, []error{errors.New("bind address must not be empty")}
 	}
 	if err := validation.ValidHostPort(c.Bind); err != nil {
 		return nil, []error{fmt.Errorf("invalid bind %q: %v", c.Bind, err)}
 	}
-	return nil, nil
+
+	names := map[string]bool{}
+	tokens := map[string]bool{}
+	for i, t := range c.Auth.Tokens {
+		if t.Name == "" {
+			e("api.auth.tokens[%d]: name must not be empty", i)
+		} else if names[t.Name] {
+			e("api.auth.tokens[%d]: duplicate name %q", i, t.Name)
+		}
+		names[t.Name] = true
+		if t.Token == "" {
+			e("api.auth.tokens[%d] %q: token must not be empty", i, t.Name)
+		} else if tokens[t.Token] {
+			e("api.auth.tokens[%d] %q: token must be unique", i, t.Name)
+		}
+		tokens[t.Token] = true
+		if !t.Role.Valid() {
+			e("api.auth.tokens[%d] %q: invalid role %q, must be one of %v", i, t.Name, t.Role, Roles)
+		}
+	}
+	for i, cl := range c.Auth.Clients {
+		if cl.CommonName == "" {
+			e("api.auth.clients[%d]: commonName must not be empty", i)
+		}
+		if !cl.Role.Valid() {
+			e("api.auth.clients[%d] %q: invalid role %q, must be one of %v", i, cl.CommonName, cl.Role, Roles)
+		}
+	}
+
+	if c.TLS.Enabled() && (c.TLS.CertFile == "" || c.TLS.KeyFile == "") {
+		e("api.tls: certFile and keyFile must both be set")
+	}
+	if c.TLS.ClientCAFile != "" && !c.TLS.Enabled() {
+		e("api.tls.clientCAFile requires certFile and keyFile")
+	}
+	if len(c.Auth.Clients) != 0 && c.TLS.ClientCAFile == "" {
+		e("api.auth.clients requires api.tls.clientCAFile to verify client certificates")
+	}
+
+	if len(c.Auth.Tokens) != 0 && !c.TLS.Enabled() && !loopback(c.Bind) {
+		w("API bearer tokens are sent in plaintext to %q, configure api.tls.", c.Bind)
+	}
+	return warns, errs
+}
+
+// loopback returns true if the host of addr is a loopback address.
+func loopback(addr string) bool {
+	host := netutil.HostStr(addr)
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
 }
@@ -1,9 +1,21 @@
 package api
 
 import (
-	"go.minekube.com/gate/pkg/edition/bedrock/geyser"
+	"bytes"
+	"fmt"
+	"time"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/bedrock/geyser/floodgate"
+	"go.minekube.com/gate/pkg/edition/java/bossbar"
+	"go.minekube.com/gate/pkg/edition/java/config"
+	"go.minekube.com/gate/pkg/edition/java/proto/util"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
 	"go.minekube.com/gate/pkg/edition/java/proxy"
 	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/punishment"
+	"go.minekube.com/gate/pkg/util/whitelist"
 )
 
 func PlayersToProto(p []proxy.Player) []*pb.Player {
@@ -20,33 +32,28 @@ func PlayerToProto(p proxy.Player) *pb.Player {
 		Username: p.Username(),
 	}
 
-	// Check if this is a Bedrock player using the Geyser context helper
-	if bedrockData := extractBedrockData(p); bedrockData != nil {
-		player.Bedrock = bedrockData
+	if data := p.BedrockData(); data != nil {
+		player.Bedrock = BedrockDataToProto(data)
 	}
 
 	return player
 }
 
-// extractBedrockData attempts to extract Bedrock player data from a player.
-// This integrates with Gate's Floodgate system to get real BedrockData.
-func extractBedrockData(p proxy.Player) *pb.BedrockPlayerData {
-	// Try to get the Geyser connection from the player's context
-	geyserConn, isBedrock := geyser.FromContext(p.Context())
-	if !isBedrock || geyserConn.BedrockData == nil {
-		return nil // Not a Bedrock player or no Bedrock data available
-	}
-
-	bedrockData := geyserConn.BedrockData
-
+// BedrockDataToProto converts the data of a Bedrock player joining through Geyser/Floodgate.
+// The Floodgate account linking codes are not exposed.
+func BedrockDataToProto(d *floodgate.BedrockData) *pb.BedrockPlayerData {
 	return &pb.BedrockPlayerData{
-		Xuid:         bedrockData.Xuid,
-		DeviceOs:     convertDeviceOS(bedrockData.DeviceOS.ID),
-		Language:     bedrockData.Language,
-		UiProfile:    convertUIProfile(bedrockData.UIProfile),
-		InputMode:    convertInputMode(bedrockData.InputMode),
-		BehindProxy:  bedrockData.Proxy,
-		LinkedPlayer: bedrockData.LinkedPlayer,
+		Xuid:         d.Xuid,
+		DeviceOs:     convertDeviceOS(d.DeviceOS.ID),
+		Language:     d.Language,
+		UiProfile:    convertUIProfile(d.UIProfile),
+		InputMode:    convertInputMode(d.InputMode),
+		BehindProxy:  d.Proxy,
+		LinkedPlayer: d.LinkedPlayer,
+		Version:      d.Version,
+		Username:     d.Username,
+		DeviceOsName: d.DeviceOS.Name,
+		Ip:           d.IP,
 	}
 }
 
@@ -63,6 +70,118 @@ func ServerToProto(s proxy.RegisteredServer) *pb.Server {
 		Name:    s.ServerInfo().Name(),
 		Address: s.ServerInfo().Addr().String(),
 		Players: int32(s.Players().Len()),
+		Health:  convertServerHealth(proxy.HealthOf(s)),
+	}
+}
+
+// convertServerHealth converts from the proxy server health to protobuf enum
+func convertServerHealth(health proxy.ServerHealth) pb.ServerHealth {
+	switch health {
+	case proxy.ServerHealthUp:
+		return pb.ServerHealth_SERVER_HEALTH_UP
+	case proxy.ServerHealthDegraded:
+		return pb.ServerHealth_SERVER_HEALTH_DEGRADED
+	case proxy.ServerHealthDown:
+		return pb.ServerHealth_SERVER_HEALTH_DOWN
+	default:
+		return pb.ServerHealth_SERVER_HEALTH_UNSPECIFIED
+	}
+}
+
+func QueueEntriesToProto(entries []proxy.QueueEntry) []*pb.QueueEntry {
+	var out []*pb.QueueEntry
+	for _, entry := range entries {
+		out = append(out, QueueEntryToProto(entry))
+	}
+	return out
+}
+
+func QueueEntryToProto(e proxy.QueueEntry) *pb.QueueEntry {
+	return &pb.QueueEntry{
+		PlayerId:    e.Player.ID().String(),
+		Username:    e.Player.Username(),
+		Server:      e.Server.ServerInfo().Name(),
+		Position:    int32(e.Position),
+		Size:        int32(e.Size),
+		Priority:    int32(e.Priority),
+		WaitSeconds: int64(time.Since(e.Joined).Seconds()),
+	}
+}
+
+func PunishmentsToProto(list []punishment.Punishment) []*pb.Punishment {
+	var out []*pb.Punishment
+	for _, p := range list {
+		out = append(out, PunishmentToProto(p))
+	}
+	return out
+}
+
+func PunishmentToProto(p punishment.Punishment) *pb.Punishment {
+	out := &pb.Punishment{
+		Id:        p.ID,
+		Type:      punishmentTypeToProto(p.Type),
+		PlayerId:  p.Player,
+		Username:  p.Name,
+		Ip:        p.IP,
+		Reason:    p.Reason,
+		Issuer:    p.Issuer,
+		CreatedAt: p.Created.Unix(),
+	}
+	if !p.Permanent() {
+		out.ExpiresAt = p.Expires.Unix()
+	}
+	return out
+}
+
+func punishmentTypeToProto(typ punishment.Type) pb.PunishmentType {
+	switch typ {
+	case punishment.Ban:
+		return pb.PunishmentType_PUNISHMENT_TYPE_BAN
+	case punishment.Mute:
+		return pb.PunishmentType_PUNISHMENT_TYPE_MUTE
+	default:
+		return pb.PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED
+	}
+}
+
+func punishmentTypeFromProto(typ pb.PunishmentType) (punishment.Type, error) {
+	switch typ {
+	case pb.PunishmentType_PUNISHMENT_TYPE_BAN:
+		return punishment.Ban, nil
+	case pb.PunishmentType_PUNISHMENT_TYPE_MUTE:
+		return punishment.Mute, nil
+	default:
+		return "", fmt.Errorf("invalid punishment type %s", typ)
+	}
+}
+
+func WhitelistEntriesToProto(list []whitelist.Entry) []*pb.WhitelistEntry {
+	out := make([]*pb.WhitelistEntry, 0, len(list))
+	for _, e := range list {
+		out = append(out, WhitelistEntryToProto(e))
+	}
+	return out
+}
+
+func WhitelistEntryToProto(e whitelist.Entry) *pb.WhitelistEntry {
+	return &pb.WhitelistEntry{
+		Id:       e.ID,
+		Username: e.Name,
+	}
+}
+
+func ChatChannelToProto(name string, ch config.ChatChannel, members []proxy.Player) *pb.ChatChannel {
+	speakPermission := ch.SpeakPermission
+	if speakPermission == "" {
+		speakPermission = ch.Permission
+	}
+	return &pb.ChatChannel{
+		Name:            name,
+		Prefix:          ch.Prefix,
+		Command:         ch.Command,
+		Permission:      ch.Permission,
+		SpeakPermission: speakPermission,
+		Members:         PlayersToProto(members),
 	}
 }
 
@@ -135,3 +254,61 @@ func convertInputMode(inputMode int) pb.BedrockInputMode {
 		return pb.BedrockInputMode_BEDROCK_INPUT_MODE_UNKNOWN
 	}
 }
+
+func bossBarColorFromProto(c pb.BossBarColor) bossbar.Color {
+	switch c {
+	case pb.BossBarColor_BOSS_BAR_COLOR_BLUE:
+		return bossbar.BlueColor
+	case pb.BossBarColor_BOSS_BAR_COLOR_RED:
+		return bossbar.RedColor
+	case pb.BossBarColor_BOSS_BAR_COLOR_GREEN:
+		return bossbar.GreenColor
+	case pb.BossBarColor_BOSS_BAR_COLOR_YELLOW:
+		return bossbar.YellowColor
+	case pb.BossBarColor_BOSS_BAR_COLOR_PURPLE:
+		return bossbar.PurpleColor
+	case pb.BossBarColor_BOSS_BAR_COLOR_WHITE:
+		return bossbar.WhiteColor
+	default:
+		return bossbar.PinkColor
+	}
+}
+
+func bossBarOverlayFromProto(o pb.BossBarOverlay) bossbar.Overlay {
+	switch o {
+	case pb.BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_6:
+		return bossbar.Notched6Overlay
+	case pb.BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_10:
+		return bossbar.Notched10Overlay
+	case pb.BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_12:
+		return bossbar.Notched12Overlay
+	case pb.BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_20:
+		return bossbar.Notched20Overlay
+	default:
+		return bossbar.ProgressOverlay
+	}
+}
+
+// componentJSON returns the component as JSON text component or empty if nil.
+func componentJSON(c component.Component) string {
+	if c == nil {
+		return ""
+	}
+	b := new(bytes.Buffer)
+	if err := util.JsonCodec(version.MaximumVersion.Protocol).Marshal(b, c); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
+// componentPlain returns the component as plain text without formatting or empty if nil.
+func componentPlain(c component.Component) string {
+	if c == nil {
+		return ""
+	}
+	text, err := util.MarshalPlain(c)
+	if err != nil {
+		return ""
+	}
+	return text
+}
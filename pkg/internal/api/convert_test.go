@@ -2,9 +2,12 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.minekube.com/gate/pkg/edition/bedrock/geyser/floodgate"
 	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/punishment"
 )
 
 func TestBedrockEnumConversions(t *testing.T) {
@@ -15,3 +18,54 @@ func TestBedrockEnumConversions(t *testing.T) {
 	require.Equal(t, pb.BedrockInputMode_BEDROCK_INPUT_MODE_GAMEPAD, convertInputMode(3))
 	require.Equal(t, pb.BedrockInputMode_BEDROCK_INPUT_MODE_UNKNOWN, convertInputMode(999))
 }
+
+func TestBedrockDataToProto(t *testing.T) {
+	d := BedrockDataToProto(&floodgate.BedrockData{
+		Version:     "2.2.3",
+		Username:    "Steve",
+		Xuid:        2535400000000000,
+		DeviceOS:    floodgate.DeviceOSAndroid,
+		Language:    "en_US",
+		UIProfile:   1,
+		InputMode:   2,
+		IP:          "10.0.0.1",
+		Proxy:       true,
+		SubscribeID: "secret",
+		VerifyCode:  "secret",
+	})
+	require.Equal(t, "2.2.3", d.Version)
+	require.Equal(t, "Steve", d.Username)
+	require.Equal(t, int64(2535400000000000), d.Xuid)
+	require.Equal(t, pb.BedrockDeviceOS_BEDROCK_DEVICE_OS_ANDROID, d.DeviceOs)
+	require.Equal(t, floodgate.DeviceOSAndroid.Name, d.DeviceOsName)
+	require.Equal(t, "en_US", d.Language)
+	require.Equal(t, pb.BedrockUIProfile_BEDROCK_UI_PROFILE_POCKET, d.UiProfile)
+	require.Equal(t, pb.BedrockInputMode_BEDROCK_INPUT_MODE_TOUCH, d.InputMode)
+	require.Equal(t, "10.0.0.1", d.Ip)
+	require.True(t, d.BehindProxy)
+	require.NotContains(t, d.String(), "secret")
+}
+
+func TestPunishmentConversions(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	p := PunishmentToProto(punishment.Punishment{
+		ID:      "abc",
+		Type:    punishment.Ban,
+		IP:      "10.0.0.0/8",
+		Created: created,
+	})
+	require.Equal(t, pb.PunishmentType_PUNISHMENT_TYPE_BAN, p.Type)
+	require.Equal(t, "10.0.0.0/8", p.Ip)
+	require.Equal(t, created.Unix(), p.CreatedAt)
+	require.Zero(t, p.ExpiresAt, "permanent")
+
+	p = PunishmentToProto(punishment.Punishment{Type: punishment.Mute, Expires: created.Add(time.Hour)})
+	require.Equal(t, pb.PunishmentType_PUNISHMENT_TYPE_MUTE, p.Type)
+	require.Equal(t, created.Add(time.Hour).Unix(), p.ExpiresAt)
+
+	typ, err := punishmentTypeFromProto(pb.PunishmentType_PUNISHMENT_TYPE_MUTE)
+	require.NoError(t, err)
+	require.Equal(t, punishment.Mute, typ)
+	_, err = punishmentTypeFromProto(pb.PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED)
+	require.Error(t, err)
+}
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/robinbraemer/event"
+
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// watchBufferSize is the number of events buffered per WatchEvents call
+// before further events are dropped.
+const watchBufferSize = 256
+
+func (s *Service) WatchEvents(ctx context.Context, c *connect.Request[pb.WatchEventsRequest], stream *connect.ServerStream[pb.WatchEventsResponse]) error {
+	f, err := newEventFilter(c.Msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	b := newEventBuffer(watchBufferSize)
+	for _, unsub := range subscribeEvents(s.p.Event(), f, b.push) {
+		defer unsub()
+	}
+	return b.send(ctx, stream.Send)
+}
+
+// eventBuffer buffers the events of a WatchEvents call
+// and counts the events dropped while the buffer is full.
+type eventBuffer struct {
+	events  chan *pb.WatchEventsResponse
+	drops   chan struct{} // signaled when an event was dropped
+	dropped atomic.Uint64 // events dropped since the last sent event
+}
+
+func newEventBuffer(size int) *eventBuffer {
+	return &eventBuffer{
+		events: make(chan *pb.WatchEventsResponse, size),
+		drops:  make(chan struct{}, 1),
+	}
+}
+
+// push is called by the event handlers and must not block the proxy.
+func (b *eventBuffer) push(e *pb.WatchEventsResponse) {
+	e.Time = time.Now().UnixMilli()
+	e.Dropped = b.dropped.Swap(0)
+	select {
+	case b.events <- e:
+	default:
+		b.dropped.Add(e.Dropped + 1)
+		select {
+		case b.drops <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// send sends the buffered events until ctx is canceled.
+// Drops no event follows are sent without event once the buffer is drained.
+func (b *eventBuffer) send(ctx context.Context, send func(*pb.WatchEventsResponse) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e := <-b.events:
+			if err := send(e); err != nil {
+				return err
+			}
+		case <-b.drops:
+		}
+		if len(b.events) != 0 {
+			continue
+		}
+		if n := b.dropped.Swap(0); n != 0 {
+			if err := send(&pb.WatchEventsResponse{Time: time.Now().UnixMilli(), Dropped: n}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// subscribeEvents subscribes to the proxy events matching the filter
+// and pushes them as responses. It returns the unsubscribe functions.
+func subscribeEvents(mgr event.Manager, f *eventFilter, push func(*pb.WatchEventsResponse)) []func() {
+	return []func(){
+		event.Subscribe(mgr, 0, func(e *proxy.PostLoginEvent) {
+			if !f.match(pb.EventType_EVENT_TYPE_PLAYER_LOGIN, e.Player()) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type: pb.EventType_EVENT_TYPE_PLAYER_LOGIN,
+				Event: &pb.WatchEventsResponse_PlayerLogin{PlayerLogin: &pb.PlayerLoginEvent{
+					Player: PlayerToProto(e.Player()),
+				}},
+			})
+		}),
+		event.Subscribe(mgr, 0, func(e *proxy.DisconnectEvent) {
+			// Only players that completed the login are streamed as logged in
+			if e.LoginStatus() != proxy.SuccessfulLoginStatus && e.LoginStatus() != proxy.ConflictingLoginStatus {
+				return
+			}
+			server := currentServerName(e.Player())
+			if !f.match(pb.EventType_EVENT_TYPE_PLAYER_DISCONNECT, e.Player(), server) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type: pb.EventType_EVENT_TYPE_PLAYER_DISCONNECT,
+				Event: &pb.WatchEventsResponse_PlayerDisconnect{PlayerDisconnect: &pb.PlayerDisconnectEvent{
+					Player: PlayerToProto(e.Player()),
+					Server: server,
+				}},
+			})
+		}),
+		event.Subscribe(mgr, 0, func(e *proxy.ServerPostConnectEvent) {
+			server := currentServerName(e.Player())
+			var previous string
+			if e.PreviousServer() != nil {
+				previous = e.PreviousServer().ServerInfo().Name()
+			}
+			if !f.match(pb.EventType_EVENT_TYPE_PLAYER_SERVER_SWITCH, e.Player(), server, previous) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type: pb.EventType_EVENT_TYPE_PLAYER_SERVER_SWITCH,
+				Event: &pb.WatchEventsResponse_PlayerServerSwitch{PlayerServerSwitch: &pb.PlayerServerSwitchEvent{
+					Player:         PlayerToProto(e.Player()),
+					Server:         server,
+					PreviousServer: previous,
+				}},
+			})
+		}),
+		event.Subscribe(mgr, 0, func(e *proxy.KickedFromServerEvent) {
+			server := e.Server().ServerInfo().Name()
+			if !f.match(pb.EventType_EVENT_TYPE_PLAYER_KICKED, e.Player(), server) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type: pb.EventType_EVENT_TYPE_PLAYER_KICKED,
+				Event: &pb.WatchEventsResponse_PlayerKicked{PlayerKicked: &pb.PlayerKickedEvent{
+					Player:              PlayerToProto(e.Player()),
+					Server:              server,
+					Reason:              componentJSON(e.OriginalReason()),
+					DuringServerConnect: e.KickedDuringServerConnect(),
+				}},
+			})
+		}),
+		event.Subscribe(mgr, 0, func(e *proxy.ServerRegisteredEvent) {
+			if !f.match(pb.EventType_EVENT_TYPE_SERVER_REGISTERED, nil, e.Server().ServerInfo().Name()) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type: pb.EventType_EVENT_TYPE_SERVER_REGISTERED,
+				Event: &pb.WatchEventsResponse_ServerRegistered{ServerRegistered: &pb.ServerRegisteredEvent{
+					Server: ServerToProto(e.Server()),
+				}},
+			})
+		}),
+		event.Subscribe(mgr, 0, func(e *proxy.ServerUnregisteredEvent) {
+			info := e.ServerInfo()
+			if !f.match(pb.EventType_EVENT_TYPE_SERVER_UNREGISTERED, nil, info.Name()) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type: pb.EventType_EVENT_TYPE_SERVER_UNREGISTERED,
+				Event: &pb.WatchEventsResponse_ServerUnregistered{ServerUnregistered: &pb.ServerUnregisteredEvent{
+					Name:    info.Name(),
+					Address: info.Addr().String(),
+				}},
+			})
+		}),
+		event.Subscribe(mgr, 0, func(e *proxy.ConfigAppliedEvent) {
+			if !f.match(pb.EventType_EVENT_TYPE_CONFIG_APPLIED, nil) {
+				return
+			}
+			push(&pb.WatchEventsResponse{
+				Type:  pb.EventType_EVENT_TYPE_CONFIG_APPLIED,
+				Event: &pb.WatchEventsResponse_ConfigApplied{ConfigApplied: &pb.ConfigAppliedEvent{}},
+			})
+		}),
+	}
+}
+
+// eventFilter filters the events of a WatchEvents call.
+type eventFilter struct {
+	types     map[pb.EventType]bool
+	usernames map[string]bool // lowercase
+	ids       map[uuid.UUID]bool
+	servers   map[string]bool // lowercase
+}
+
+func newEventFilter(req *pb.WatchEventsRequest) (*eventFilter, error) {
+	f := &eventFilter{}
+	if len(req.GetTypes()) != 0 {
+		f.types = make(map[pb.EventType]bool, len(req.GetTypes()))
+		for _, t := range req.GetTypes() {
+			if _, ok := pb.EventType_name[int32(t)]; !ok || t == pb.EventType_EVENT_TYPE_UNSPECIFIED {
+				return nil, fmt.Errorf("unknown event type %d", t)
+			}
+			f.types[t] = true
+		}
+	}
+	if len(req.GetPlayers()) != 0 {
+		f.usernames = map[string]bool{}
+		f.ids = map[uuid.UUID]bool{}
+		for _, p := range req.GetPlayers() {
+			if id, err := uuid.Parse(p); err == nil {
+				f.ids[id] = true
+			} else {
+				f.usernames[strings.ToLower(p)] = true
+			}
+		}
+	}
+	if len(req.GetServers()) != 0 {
+		f.servers = make(map[string]bool, len(req.GetServers()))
+		for _, s := range req.GetServers() {
+			f.servers[strings.ToLower(s)] = true
+		}
+	}
+	return f, nil
+}
+
+// match returns true if an event of the type about the player (may be nil)
+// involving the servers (empty names are ignored) should be streamed.
+func (f *eventFilter) match(typ pb.EventType, player proxy.Player, servers ...string) bool {
+	if f.types != nil && !f.types[typ] {
+		return false
+	}
+	if f.ids != nil {
+		if player == nil || (!f.ids[player.ID()] && !f.usernames[strings.ToLower(player.Username())]) {
+			return false
+		}
+	}
+	if f.servers != nil {
+		for _, s := range servers {
+			if s != "" && f.servers[strings.ToLower(s)] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// currentServerName returns the name of the player's current server or empty if none.
+func currentServerName(p proxy.Player) string {
+	if s := p.CurrentServer(); s != nil {
+		return s.Server().ServerInfo().Name()
+	}
+	return ""
+}
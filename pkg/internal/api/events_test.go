@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/robinbraemer/event"
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+)
+
+func TestEventFilter(t *testing.T) {
+	f, err := newEventFilter(&pb.WatchEventsRequest{})
+	require.NoError(t, err)
+	require.True(t, f.match(pb.EventType_EVENT_TYPE_CONFIG_APPLIED, nil))
+	require.True(t, f.match(pb.EventType_EVENT_TYPE_SERVER_REGISTERED, nil, "lobby"))
+
+	f, err = newEventFilter(&pb.WatchEventsRequest{
+		Types:   []pb.EventType{pb.EventType_EVENT_TYPE_PLAYER_KICKED, pb.EventType_EVENT_TYPE_SERVER_REGISTERED},
+		Servers: []string{"Lobby"},
+	})
+	require.NoError(t, err)
+	require.True(t, f.match(pb.EventType_EVENT_TYPE_SERVER_REGISTERED, nil, "lobby"))
+	require.True(t, f.match(pb.EventType_EVENT_TYPE_SERVER_REGISTERED, nil, "", "LOBBY"))
+	require.False(t, f.match(pb.EventType_EVENT_TYPE_SERVER_REGISTERED, nil, "survival"))
+	require.False(t, f.match(pb.EventType_EVENT_TYPE_SERVER_REGISTERED, nil))
+	require.False(t, f.match(pb.EventType_EVENT_TYPE_SERVER_UNREGISTERED, nil, "lobby"))
+
+	f, err = newEventFilter(&pb.WatchEventsRequest{Players: []string{"Steve"}})
+	require.NoError(t, err)
+	require.False(t, f.match(pb.EventType_EVENT_TYPE_CONFIG_APPLIED, nil), "not about a player")
+
+	for _, typ := range []pb.EventType{pb.EventType_EVENT_TYPE_UNSPECIFIED, 100} {
+		_, err = newEventFilter(&pb.WatchEventsRequest{Types: []pb.EventType{typ}})
+		require.Error(t, err)
+	}
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	mgr := event.New()
+	f, err := newEventFilter(&pb.WatchEventsRequest{})
+	require.NoError(t, err)
+
+	var pushed []*pb.WatchEventsResponse
+	unsubs := subscribeEvents(mgr, f, func(e *pb.WatchEventsResponse) {
+		pushed = append(pushed, e)
+	})
+
+	mgr.Fire(&proxy.ConfigAppliedEvent{})
+	require.Len(t, pushed, 1)
+	require.Equal(t, pb.EventType_EVENT_TYPE_CONFIG_APPLIED, pushed[0].GetType())
+	require.NotNil(t, pushed[0].GetConfigApplied())
+
+	for _, unsub := range unsubs {
+		unsub()
+	}
+	mgr.Fire(&proxy.ConfigAppliedEvent{})
+	require.Len(t, pushed, 1)
+}
+
+func TestEventBufferReportsDrops(t *testing.T) {
+	b := newEventBuffer(1)
+	login := func() *pb.WatchEventsResponse {
+		return &pb.WatchEventsResponse{Type: pb.EventType_EVENT_TYPE_PLAYER_LOGIN}
+	}
+	b.push(login())
+	b.push(login()) // dropped
+	b.push(login()) // dropped
+
+	sent := make(chan *pb.WatchEventsResponse)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- b.send(ctx, func(e *pb.WatchEventsResponse) error {
+			sent <- e
+			return nil
+		})
+	}()
+
+	e := <-sent
+	require.Equal(t, pb.EventType_EVENT_TYPE_PLAYER_LOGIN, e.GetType())
+	require.Zero(t, e.GetDropped())
+
+	// Reported once the buffer is drained, without waiting for the next event
+	e = <-sent
+	require.Equal(t, pb.EventType_EVENT_TYPE_UNSPECIFIED, e.GetType())
+	require.Equal(t, uint64(2), e.GetDropped())
+	require.NotZero(t, e.GetTime())
+
+	b.push(login())
+	e = <-sent
+	require.Equal(t, pb.EventType_EVENT_TYPE_PLAYER_LOGIN, e.GetType())
+	require.Zero(t, e.GetDropped())
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -21,6 +21,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ServerHealth is the health of a backend server.
+type ServerHealth int32
+
+const (
+	ServerHealth_SERVER_HEALTH_UNSPECIFIED ServerHealth = 0
+	// The server responded to the last status ping in time.
+	ServerHealth_SERVER_HEALTH_UP ServerHealth = 1
+	// The server responded slowly or some of the last status pings failed.
+	// Degraded servers are still chosen for players.
+	ServerHealth_SERVER_HEALTH_DEGRADED ServerHealth = 2
+	// The configured number of consecutive status pings failed.
+	// Down servers are skipped when choosing a server from the try list or forced hosts.
+	ServerHealth_SERVER_HEALTH_DOWN ServerHealth = 3
+)
+
+// Enum value maps for ServerHealth.
+var (
+	ServerHealth_name = map[int32]string{
+		0: "SERVER_HEALTH_UNSPECIFIED",
+		1: "SERVER_HEALTH_UP",
+		2: "SERVER_HEALTH_DEGRADED",
+		3: "SERVER_HEALTH_DOWN",
+	}
+	ServerHealth_value = map[string]int32{
+		"SERVER_HEALTH_UNSPECIFIED": 0,
+		"SERVER_HEALTH_UP":          1,
+		"SERVER_HEALTH_DEGRADED":    2,
+		"SERVER_HEALTH_DOWN":        3,
+	}
+)
+
+func (x ServerHealth) Enum() *ServerHealth {
+	p := new(ServerHealth)
+	*p = x
+	return p
+}
+
+func (x ServerHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServerHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ServerHealth) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[0]
+}
+
+func (x ServerHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServerHealth.Descriptor instead.
+func (ServerHealth) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{0}
+}
+
 // ProxyMode enumerates the current operating mode of Gate.
 type ProxyMode int32
 
@@ -55,11 +113,11 @@ func (x ProxyMode) String() string {
 }
 
 func (ProxyMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_minekube_gate_v1_gate_service_proto_enumTypes[0].Descriptor()
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[1].Descriptor()
 }
 
 func (ProxyMode) Type() protoreflect.EnumType {
-	return &file_minekube_gate_v1_gate_service_proto_enumTypes[0]
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[1]
 }
 
 func (x ProxyMode) Number() protoreflect.EnumNumber {
@@ -68,7 +126,7 @@ func (x ProxyMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProxyMode.Descriptor instead.
 func (ProxyMode) EnumDescriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{0}
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{1}
 }
 
 // BedrockDeviceOS represents the operating system of a Bedrock Edition player's device.
@@ -147,11 +205,11 @@ func (x BedrockDeviceOS) String() string {
 }
 
 func (BedrockDeviceOS) Descriptor() protoreflect.EnumDescriptor {
-	return file_minekube_gate_v1_gate_service_proto_enumTypes[1].Descriptor()
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[2].Descriptor()
 }
 
 func (BedrockDeviceOS) Type() protoreflect.EnumType {
-	return &file_minekube_gate_v1_gate_service_proto_enumTypes[1]
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[2]
 }
 
 func (x BedrockDeviceOS) Number() protoreflect.EnumNumber {
@@ -160,7 +218,7 @@ func (x BedrockDeviceOS) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BedrockDeviceOS.Descriptor instead.
 func (BedrockDeviceOS) EnumDescriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{1}
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{2}
 }
 
 // BedrockInputMode represents the input method used by a Bedrock Edition player.
@@ -206,11 +264,11 @@ func (x BedrockInputMode) String() string {
 }
 
 func (BedrockInputMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_minekube_gate_v1_gate_service_proto_enumTypes[2].Descriptor()
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[3].Descriptor()
 }
 
 func (BedrockInputMode) Type() protoreflect.EnumType {
-	return &file_minekube_gate_v1_gate_service_proto_enumTypes[2]
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[3]
 }
 
 func (x BedrockInputMode) Number() protoreflect.EnumNumber {
@@ -219,7 +277,7 @@ func (x BedrockInputMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BedrockInputMode.Descriptor instead.
 func (BedrockInputMode) EnumDescriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{2}
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{3}
 }
 
 // BedrockUIProfile represents the UI profile used by a Bedrock Edition player.
@@ -256,11 +314,11 @@ func (x BedrockUIProfile) String() string {
 }
 
 func (BedrockUIProfile) Descriptor() protoreflect.EnumDescriptor {
-	return file_minekube_gate_v1_gate_service_proto_enumTypes[3].Descriptor()
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[4].Descriptor()
 }
 
 func (BedrockUIProfile) Type() protoreflect.EnumType {
-	return &file_minekube_gate_v1_gate_service_proto_enumTypes[3]
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[4]
 }
 
 func (x BedrockUIProfile) Number() protoreflect.EnumNumber {
@@ -269,7 +327,312 @@ func (x BedrockUIProfile) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BedrockUIProfile.Descriptor instead.
 func (BedrockUIProfile) EnumDescriptor() ([]byte, []int) {
-	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{3}
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{4}
+}
+
+// PermissionValue is the value of a permission.
+type PermissionValue int32
+
+const (
+	// The permission is not set.
+	PermissionValue_PERMISSION_VALUE_UNSPECIFIED PermissionValue = 0
+	// The permission is granted.
+	PermissionValue_PERMISSION_VALUE_TRUE PermissionValue = 1
+	// The permission is explicitly denied.
+	PermissionValue_PERMISSION_VALUE_FALSE PermissionValue = 2
+)
+
+// Enum value maps for PermissionValue.
+var (
+	PermissionValue_name = map[int32]string{
+		0: "PERMISSION_VALUE_UNSPECIFIED",
+		1: "PERMISSION_VALUE_TRUE",
+		2: "PERMISSION_VALUE_FALSE",
+	}
+	PermissionValue_value = map[string]int32{
+		"PERMISSION_VALUE_UNSPECIFIED": 0,
+		"PERMISSION_VALUE_TRUE":        1,
+		"PERMISSION_VALUE_FALSE":       2,
+	}
+)
+
+func (x PermissionValue) Enum() *PermissionValue {
+	p := new(PermissionValue)
+	*p = x
+	return p
+}
+
+func (x PermissionValue) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionValue) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PermissionValue) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[5]
+}
+
+func (x PermissionValue) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionValue.Descriptor instead.
+func (PermissionValue) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{5}
+}
+
+// PunishmentType is the type of a punishment.
+type PunishmentType int32
+
+const (
+	PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED PunishmentType = 0
+	// The player is denied to log in.
+	PunishmentType_PUNISHMENT_TYPE_BAN PunishmentType = 1
+	// The player's chat messages are denied.
+	PunishmentType_PUNISHMENT_TYPE_MUTE PunishmentType = 2
+)
+
+// Enum value maps for PunishmentType.
+var (
+	PunishmentType_name = map[int32]string{
+		0: "PUNISHMENT_TYPE_UNSPECIFIED",
+		1: "PUNISHMENT_TYPE_BAN",
+		2: "PUNISHMENT_TYPE_MUTE",
+	}
+	PunishmentType_value = map[string]int32{
+		"PUNISHMENT_TYPE_UNSPECIFIED": 0,
+		"PUNISHMENT_TYPE_BAN":         1,
+		"PUNISHMENT_TYPE_MUTE":        2,
+	}
+)
+
+func (x PunishmentType) Enum() *PunishmentType {
+	p := new(PunishmentType)
+	*p = x
+	return p
+}
+
+func (x PunishmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PunishmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[6].Descriptor()
+}
+
+func (PunishmentType) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[6]
+}
+
+func (x PunishmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PunishmentType.Descriptor instead.
+func (PunishmentType) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{6}
+}
+
+// EventType is the type of an event streamed by WatchEvents.
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
+	// A player logged in to the proxy.
+	EventType_EVENT_TYPE_PLAYER_LOGIN EventType = 1
+	// A logged in player disconnected from the proxy.
+	EventType_EVENT_TYPE_PLAYER_DISCONNECT EventType = 2
+	// A player connected to a server, including the initial server.
+	EventType_EVENT_TYPE_PLAYER_SERVER_SWITCH EventType = 3
+	// A player was kicked from a server.
+	EventType_EVENT_TYPE_PLAYER_KICKED EventType = 4
+	// A server was registered.
+	EventType_EVENT_TYPE_SERVER_REGISTERED EventType = 5
+	// A server was unregistered.
+	EventType_EVENT_TYPE_SERVER_UNREGISTERED EventType = 6
+	// A config was applied at runtime, by ApplyConfig or a reload of the config file.
+	EventType_EVENT_TYPE_CONFIG_APPLIED EventType = 7
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_PLAYER_LOGIN",
+		2: "EVENT_TYPE_PLAYER_DISCONNECT",
+		3: "EVENT_TYPE_PLAYER_SERVER_SWITCH",
+		4: "EVENT_TYPE_PLAYER_KICKED",
+		5: "EVENT_TYPE_SERVER_REGISTERED",
+		6: "EVENT_TYPE_SERVER_UNREGISTERED",
+		7: "EVENT_TYPE_CONFIG_APPLIED",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":          0,
+		"EVENT_TYPE_PLAYER_LOGIN":         1,
+		"EVENT_TYPE_PLAYER_DISCONNECT":    2,
+		"EVENT_TYPE_PLAYER_SERVER_SWITCH": 3,
+		"EVENT_TYPE_PLAYER_KICKED":        4,
+		"EVENT_TYPE_SERVER_REGISTERED":    5,
+		"EVENT_TYPE_SERVER_UNREGISTERED":  6,
+		"EVENT_TYPE_CONFIG_APPLIED":       7,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[7].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[7]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{7}
+}
+
+// BossBarColor is the color of a boss bar.
+type BossBarColor int32
+
+const (
+	// Defaults to pink.
+	BossBarColor_BOSS_BAR_COLOR_UNSPECIFIED BossBarColor = 0
+	BossBarColor_BOSS_BAR_COLOR_PINK        BossBarColor = 1
+	BossBarColor_BOSS_BAR_COLOR_BLUE        BossBarColor = 2
+	BossBarColor_BOSS_BAR_COLOR_RED         BossBarColor = 3
+	BossBarColor_BOSS_BAR_COLOR_GREEN       BossBarColor = 4
+	BossBarColor_BOSS_BAR_COLOR_YELLOW      BossBarColor = 5
+	BossBarColor_BOSS_BAR_COLOR_PURPLE      BossBarColor = 6
+	BossBarColor_BOSS_BAR_COLOR_WHITE       BossBarColor = 7
+)
+
+// Enum value maps for BossBarColor.
+var (
+	BossBarColor_name = map[int32]string{
+		0: "BOSS_BAR_COLOR_UNSPECIFIED",
+		1: "BOSS_BAR_COLOR_PINK",
+		2: "BOSS_BAR_COLOR_BLUE",
+		3: "BOSS_BAR_COLOR_RED",
+		4: "BOSS_BAR_COLOR_GREEN",
+		5: "BOSS_BAR_COLOR_YELLOW",
+		6: "BOSS_BAR_COLOR_PURPLE",
+		7: "BOSS_BAR_COLOR_WHITE",
+	}
+	BossBarColor_value = map[string]int32{
+		"BOSS_BAR_COLOR_UNSPECIFIED": 0,
+		"BOSS_BAR_COLOR_PINK":        1,
+		"BOSS_BAR_COLOR_BLUE":        2,
+		"BOSS_BAR_COLOR_RED":         3,
+		"BOSS_BAR_COLOR_GREEN":       4,
+		"BOSS_BAR_COLOR_YELLOW":      5,
+		"BOSS_BAR_COLOR_PURPLE":      6,
+		"BOSS_BAR_COLOR_WHITE":       7,
+	}
+)
+
+func (x BossBarColor) Enum() *BossBarColor {
+	p := new(BossBarColor)
+	*p = x
+	return p
+}
+
+func (x BossBarColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BossBarColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[8].Descriptor()
+}
+
+func (BossBarColor) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[8]
+}
+
+func (x BossBarColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BossBarColor.Descriptor instead.
+func (BossBarColor) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{8}
+}
+
+// BossBarOverlay is the overlay of a boss bar.
+type BossBarOverlay int32
+
+const (
+	// Defaults to progress.
+	BossBarOverlay_BOSS_BAR_OVERLAY_UNSPECIFIED BossBarOverlay = 0
+	// A solid bar.
+	BossBarOverlay_BOSS_BAR_OVERLAY_PROGRESS BossBarOverlay = 1
+	// A bar split into 6, 10, 12 or 20 notches.
+	BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_6  BossBarOverlay = 2
+	BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_10 BossBarOverlay = 3
+	BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_12 BossBarOverlay = 4
+	BossBarOverlay_BOSS_BAR_OVERLAY_NOTCHED_20 BossBarOverlay = 5
+)
+
+// Enum value maps for BossBarOverlay.
+var (
+	BossBarOverlay_name = map[int32]string{
+		0: "BOSS_BAR_OVERLAY_UNSPECIFIED",
+		1: "BOSS_BAR_OVERLAY_PROGRESS",
+		2: "BOSS_BAR_OVERLAY_NOTCHED_6",
+		3: "BOSS_BAR_OVERLAY_NOTCHED_10",
+		4: "BOSS_BAR_OVERLAY_NOTCHED_12",
+		5: "BOSS_BAR_OVERLAY_NOTCHED_20",
+	}
+	BossBarOverlay_value = map[string]int32{
+		"BOSS_BAR_OVERLAY_UNSPECIFIED": 0,
+		"BOSS_BAR_OVERLAY_PROGRESS":    1,
+		"BOSS_BAR_OVERLAY_NOTCHED_6":   2,
+		"BOSS_BAR_OVERLAY_NOTCHED_10":  3,
+		"BOSS_BAR_OVERLAY_NOTCHED_12":  4,
+		"BOSS_BAR_OVERLAY_NOTCHED_20":  5,
+	}
+)
+
+func (x BossBarOverlay) Enum() *BossBarOverlay {
+	p := new(BossBarOverlay)
+	*p = x
+	return p
+}
+
+func (x BossBarOverlay) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BossBarOverlay) Descriptor() protoreflect.EnumDescriptor {
+	return file_minekube_gate_v1_gate_service_proto_enumTypes[9].Descriptor()
+}
+
+func (BossBarOverlay) Type() protoreflect.EnumType {
+	return &file_minekube_gate_v1_gate_service_proto_enumTypes[9]
+}
+
+func (x BossBarOverlay) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BossBarOverlay.Descriptor instead.
+func (BossBarOverlay) EnumDescriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{9}
 }
 
 // StoreCookieRequest is the request for StoreCookie method.
@@ -946,7 +1309,10 @@ type Server struct {
 	// The network address of the server.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// The number of players currently on the server.
-	Players       int32 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	Players int32 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	// The health of the server as determined by the proxy's periodic status pings.
+	// SERVER_HEALTH_UNSPECIFIED if health checks are disabled or the server was not checked yet.
+	Health        ServerHealth `protobuf:"varint,4,opt,name=health,proto3,enum=minekube.gate.v1.ServerHealth" json:"health,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1002,6 +1368,13 @@ func (x *Server) GetPlayers() int32 {
 	return 0
 }
 
+func (x *Server) GetHealth() ServerHealth {
+	if x != nil {
+		return x.Health
+	}
+	return ServerHealth_SERVER_HEALTH_UNSPECIFIED
+}
+
 // GetPlayerRequest is the request for GetPlayer method.
 type GetPlayerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1114,9 +1487,14 @@ type ListPlayersRequest struct {
 	// Filter players by server names.
 	// Optional, if empty all online players are returned.
 	// If specified, only returns players on the listed servers.
-	Servers       []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Servers []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Filter players by edition.
+	// Optional, if unset players of both editions are returned.
+	// If true, only returns Bedrock Edition players joining through Geyser/Floodgate,
+	// if false, only returns Java Edition players.
+	PlayerIsBedrock *bool `protobuf:"varint,2,opt,name=player_is_bedrock,json=playerIsBedrock,proto3,oneof" json:"player_is_bedrock,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListPlayersRequest) Reset() {
@@ -1156,6 +1534,13 @@ func (x *ListPlayersRequest) GetServers() []string {
 	return nil
 }
 
+func (x *ListPlayersRequest) GetPlayerIsBedrock() bool {
+	if x != nil && x.PlayerIsBedrock != nil {
+		return *x.PlayerIsBedrock
+	}
+	return false
+}
+
 // ListPlayersResponse is the response for ListPlayers method.
 type ListPlayersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1282,7 +1667,15 @@ type BedrockPlayerData struct {
 	// Whether the player is connecting through a proxy
 	BehindProxy bool `protobuf:"varint,6,opt,name=behind_proxy,json=behindProxy,proto3" json:"behind_proxy,omitempty"`
 	// Linked Java Edition username (if any)
-	LinkedPlayer  string `protobuf:"bytes,7,opt,name=linked_player,json=linkedPlayer,proto3" json:"linked_player,omitempty"`
+	LinkedPlayer string `protobuf:"bytes,7,opt,name=linked_player,json=linkedPlayer,proto3" json:"linked_player,omitempty"`
+	// Floodgate version of the player's Geyser instance
+	Version string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Bedrock username without the configured username format
+	Username string `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	// Device operating system name as reported by Floodgate (e.g., "Android")
+	DeviceOsName string `protobuf:"bytes,10,opt,name=device_os_name,json=deviceOsName,proto3" json:"device_os_name,omitempty"`
+	// IP address of the Bedrock client as seen by Geyser
+	Ip            string `protobuf:"bytes,11,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1366,6 +1759,34 @@ func (x *BedrockPlayerData) GetLinkedPlayer() string {
 	return ""
 }
 
+func (x *BedrockPlayerData) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BedrockPlayerData) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BedrockPlayerData) GetDeviceOsName() string {
+	if x != nil {
+		return x.DeviceOsName
+	}
+	return ""
+}
+
+func (x *BedrockPlayerData) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
 // GetStatusRequest is the request for GetStatus method.
 type GetStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1969,57 +2390,4325 @@ func (x *ApplyConfigResponse) GetVersion() string {
 	return ""
 }
 
-var File_minekube_gate_v1_gate_service_proto protoreflect.FileDescriptor
+// PermissionGroup is a named set of permission nodes of the built-in permission provider.
+type PermissionGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique name of the group.
+	// Every player is implicitly member of the group named "default".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The groups this group inherits permission nodes from.
+	Parents []string `protobuf:"bytes,2,rep,name=parents,proto3" json:"parents,omitempty"`
+	// The permission nodes of the group.
+	// A node ending with ".*" grants all sub-permissions, "*" grants every permission
+	// and a node prefixed with "-" explicitly denies the permission.
+	Permissions   []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
-	"\n" +
-	"#minekube/gate/v1/gate_service.proto\x12\x10minekube.gate.v1\"X\n" +
-	"\x12StoreCookieRequest\x12\x16\n" +
-	"\x06player\x18\x01 \x01(\tR\x06player\x12\x10\n" +
-	"\x03key\x18\x02 \x01(\tR\x03key\x12\x18\n" +
-	"\apayload\x18\x03 \x01(\fR\apayload\"\x15\n" +
-	"\x13StoreCookieResponse\"@\n" +
-	"\x14RequestCookieRequest\x12\x16\n" +
-	"\x06player\x18\x01 \x01(\tR\x06player\x12\x10\n" +
-	"\x03key\x18\x02 \x01(\tR\x03key\"1\n" +
-	"\x15RequestCookieResponse\x12\x18\n" +
-	"\apayload\x18\x01 \x01(\fR\apayload\"I\n" +
-	"\x17DisconnectPlayerRequest\x12\x16\n" +
-	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x1a\n" +
-	"\x18DisconnectPlayerResponse\"F\n" +
-	"\x14ConnectPlayerRequest\x12\x16\n" +
-	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
-	"\x06server\x18\x02 \x01(\tR\x06server\"\x17\n" +
-	"\x15ConnectPlayerResponse\"E\n" +
-	"\x15RegisterServerRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x18\n" +
-	"\x16RegisterServerResponse\"G\n" +
-	"\x17UnregisterServerRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x1a\n" +
-	"\x18UnregisterServerResponse\"\x14\n" +
-	"\x12ListServersRequest\"I\n" +
-	"\x13ListServersResponse\x122\n" +
-	"\aservers\x18\x01 \x03(\v2\x18.minekube.gate.v1.ServerR\aservers\"P\n" +
-	"\x06Server\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
-	"\aplayers\x18\x03 \x01(\x05R\aplayers\">\n" +
-	"\x10GetPlayerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\"E\n" +
+func (x *PermissionGroup) Reset() {
+	*x = PermissionGroup{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionGroup) ProtoMessage() {}
+
+func (x *PermissionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionGroup.ProtoReflect.Descriptor instead.
+func (*PermissionGroup) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PermissionGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PermissionGroup) GetParents() []string {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *PermissionGroup) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// PlayerPermissions are the permissions of a single player of the built-in permission provider.
+type PlayerPermissions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's Minecraft UUID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The player's username, if known.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The groups the player is member of.
+	Groups []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The player's own permission nodes overriding the nodes of the groups.
+	Permissions   []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerPermissions) Reset() {
+	*x = PlayerPermissions{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerPermissions) ProtoMessage() {}
+
+func (x *PlayerPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerPermissions.ProtoReflect.Descriptor instead.
+func (*PlayerPermissions) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlayerPermissions) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerPermissions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerPermissions) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *PlayerPermissions) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// GetPermissionsRequest is the request for GetPermissions method.
+type GetPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionsRequest) Reset() {
+	*x = GetPermissionsRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsRequest) ProtoMessage() {}
+
+func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{33}
+}
+
+// GetPermissionsResponse is the response for GetPermissions method.
+type GetPermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The permission groups sorted by name.
+	Groups []*PermissionGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The player permissions sorted by id.
+	Players       []*PlayerPermissions `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionsResponse) Reset() {
+	*x = GetPermissionsResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsResponse) ProtoMessage() {}
+
+func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPermissionsResponse) GetGroups() []*PermissionGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetPermissionsResponse) GetPlayers() []*PlayerPermissions {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// CheckPermissionRequest is the request for CheckPermission method.
+type CheckPermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID.
+	// Offline players can only be checked by ID.
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The permission to check, e.g. "gate.command.server"
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CheckPermissionRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+// CheckPermissionResponse is the response for CheckPermission method.
+type CheckPermissionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The value of the permission.
+	Value PermissionValue `protobuf:"varint,1,opt,name=value,proto3,enum=minekube.gate.v1.PermissionValue" json:"value,omitempty"`
+	// Whether the permission is granted.
+	Allowed       bool `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckPermissionResponse) GetValue() PermissionValue {
+	if x != nil {
+		return x.Value
+	}
+	return PermissionValue_PERMISSION_VALUE_UNSPECIFIED
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+// SetPermissionGroupRequest is the request for SetPermissionGroup method.
+type SetPermissionGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The group to create or replace.
+	Group         *PermissionGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPermissionGroupRequest) Reset() {
+	*x = SetPermissionGroupRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPermissionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPermissionGroupRequest) ProtoMessage() {}
+
+func (x *SetPermissionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPermissionGroupRequest.ProtoReflect.Descriptor instead.
+func (*SetPermissionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetPermissionGroupRequest) GetGroup() *PermissionGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// SetPermissionGroupResponse is the response for SetPermissionGroup method.
+type SetPermissionGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPermissionGroupResponse) Reset() {
+	*x = SetPermissionGroupResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPermissionGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPermissionGroupResponse) ProtoMessage() {}
+
+func (x *SetPermissionGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPermissionGroupResponse.ProtoReflect.Descriptor instead.
+func (*SetPermissionGroupResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{38}
+}
+
+// DeletePermissionGroupRequest is the request for DeletePermissionGroup method.
+type DeletePermissionGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the group to delete.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePermissionGroupRequest) Reset() {
+	*x = DeletePermissionGroupRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePermissionGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePermissionGroupRequest) ProtoMessage() {}
+
+func (x *DeletePermissionGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePermissionGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeletePermissionGroupRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeletePermissionGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeletePermissionGroupResponse is the response for DeletePermissionGroup method.
+type DeletePermissionGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePermissionGroupResponse) Reset() {
+	*x = DeletePermissionGroupResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePermissionGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePermissionGroupResponse) ProtoMessage() {}
+
+func (x *DeletePermissionGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePermissionGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeletePermissionGroupResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{40}
+}
+
+// SetPlayerPermissionsRequest is the request for SetPlayerPermissions method.
+type SetPlayerPermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID.
+	// Offline players can only be set by ID.
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The groups the player is member of.
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The player's own permission nodes.
+	Permissions   []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerPermissionsRequest) Reset() {
+	*x = SetPlayerPermissionsRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerPermissionsRequest) ProtoMessage() {}
+
+func (x *SetPlayerPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetPlayerPermissionsRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *SetPlayerPermissionsRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *SetPlayerPermissionsRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// SetPlayerPermissionsResponse is the response for SetPlayerPermissions method.
+type SetPlayerPermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resulting player permissions.
+	Player        *PlayerPermissions `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerPermissionsResponse) Reset() {
+	*x = SetPlayerPermissionsResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerPermissionsResponse) ProtoMessage() {}
+
+func (x *SetPlayerPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetPlayerPermissionsResponse) GetPlayer() *PlayerPermissions {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// Queue is the connection queue of a server.
+type Queue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the queued server.
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// The queued players in order.
+	Entries       []*QueueEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Queue) Reset() {
+	*x = Queue{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Queue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Queue) ProtoMessage() {}
+
+func (x *Queue) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Queue.ProtoReflect.Descriptor instead.
+func (*Queue) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Queue) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *Queue) GetEntries() []*QueueEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// QueueEntry is a player waiting in the connection queue of a server.
+type QueueEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's Minecraft UUID
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// The player's username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The name of the queued server.
+	Server string `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	// The 1-based position in the queue.
+	Position int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// The number of players in the queue.
+	Size int32 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Players with higher priority are served first.
+	Priority int32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	// The number of seconds the player is queued.
+	WaitSeconds   int64 `protobuf:"varint,7,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueEntry) Reset() {
+	*x = QueueEntry{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEntry) ProtoMessage() {}
+
+func (x *QueueEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEntry.ProtoReflect.Descriptor instead.
+func (*QueueEntry) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QueueEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *QueueEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *QueueEntry) GetWaitSeconds() int64 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+// ListQueuesRequest is the request for ListQueues method.
+type ListQueuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional, only return the queues of these servers.
+	Servers       []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesRequest) ProtoMessage() {}
+
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListQueuesRequest) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// ListQueuesResponse is the response for ListQueues method.
+type ListQueuesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The queues sorted by server name.
+	Queues        []*Queue `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse) ProtoMessage() {}
+
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListQueuesResponse) GetQueues() []*Queue {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+// EnqueuePlayerRequest is the request for EnqueuePlayer method.
+type EnqueuePlayerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The target server name to queue the player for
+	Server string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// The priority of the player in the queue.
+	// Optional, if not set the highest configured priority of the player's permissions is used.
+	Priority      *int32 `protobuf:"varint,3,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueuePlayerRequest) Reset() {
+	*x = EnqueuePlayerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueuePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePlayerRequest) ProtoMessage() {}
+
+func (x *EnqueuePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePlayerRequest.ProtoReflect.Descriptor instead.
+func (*EnqueuePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EnqueuePlayerRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *EnqueuePlayerRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *EnqueuePlayerRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+// EnqueuePlayerResponse is the response for EnqueuePlayer method.
+type EnqueuePlayerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's queue entry.
+	Entry         *QueueEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueuePlayerResponse) Reset() {
+	*x = EnqueuePlayerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueuePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueuePlayerResponse) ProtoMessage() {}
+
+func (x *EnqueuePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueuePlayerResponse.ProtoReflect.Descriptor instead.
+func (*EnqueuePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EnqueuePlayerResponse) GetEntry() *QueueEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// DequeuePlayerRequest is the request for DequeuePlayer method.
+type DequeuePlayerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or ID
+	Player        string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DequeuePlayerRequest) Reset() {
+	*x = DequeuePlayerRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DequeuePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeuePlayerRequest) ProtoMessage() {}
+
+func (x *DequeuePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeuePlayerRequest.ProtoReflect.Descriptor instead.
+func (*DequeuePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DequeuePlayerRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// DequeuePlayerResponse is the response for DequeuePlayer method.
+type DequeuePlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DequeuePlayerResponse) Reset() {
+	*x = DequeuePlayerResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DequeuePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DequeuePlayerResponse) ProtoMessage() {}
+
+func (x *DequeuePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DequeuePlayerResponse.ProtoReflect.Descriptor instead.
+func (*DequeuePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{50}
+}
+
+// ClearQueueRequest is the request for ClearQueue method.
+type ClearQueueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the server whose queue to clear.
+	Server        string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearQueueRequest) Reset() {
+	*x = ClearQueueRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearQueueRequest) ProtoMessage() {}
+
+func (x *ClearQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearQueueRequest.ProtoReflect.Descriptor instead.
+func (*ClearQueueRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ClearQueueRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+// ClearQueueResponse is the response for ClearQueue method.
+type ClearQueueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of removed players.
+	Removed       int32 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearQueueResponse) Reset() {
+	*x = ClearQueueResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearQueueResponse) ProtoMessage() {}
+
+func (x *ClearQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearQueueResponse.ProtoReflect.Descriptor instead.
+func (*ClearQueueResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ClearQueueResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// Punishment is a ban or mute of players matching its player UUID, username or IP.
+type Punishment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique id of the punishment.
+	Id   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type PunishmentType `protobuf:"varint,2,opt,name=type,proto3,enum=minekube.gate.v1.PunishmentType" json:"type,omitempty"`
+	// The punished player's Minecraft UUID, if any.
+	PlayerId string `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// The punished username, if any.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// The punished IP address or CIDR range, if any.
+	Ip string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`
+	// The reason shown to the player, either a legacy or JSON text component.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Who issued the punishment.
+	Issuer string `protobuf:"bytes,7,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// When the punishment was issued in Unix seconds.
+	CreatedAt int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// When the punishment expires in Unix seconds, 0 if permanent.
+	ExpiresAt     int64 `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Punishment) Reset() {
+	*x = Punishment{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Punishment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Punishment) ProtoMessage() {}
+
+func (x *Punishment) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Punishment.ProtoReflect.Descriptor instead.
+func (*Punishment) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Punishment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Punishment) GetType() PunishmentType {
+	if x != nil {
+		return x.Type
+	}
+	return PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Punishment) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *Punishment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Punishment) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Punishment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Punishment) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Punishment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Punishment) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// ListPunishmentsRequest is the request for ListPunishments method.
+type ListPunishmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional, only return punishments of this type.
+	Type          PunishmentType `protobuf:"varint,1,opt,name=type,proto3,enum=minekube.gate.v1.PunishmentType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPunishmentsRequest) Reset() {
+	*x = ListPunishmentsRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPunishmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPunishmentsRequest) ProtoMessage() {}
+
+func (x *ListPunishmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPunishmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPunishmentsRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListPunishmentsRequest) GetType() PunishmentType {
+	if x != nil {
+		return x.Type
+	}
+	return PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED
+}
+
+// ListPunishmentsResponse is the response for ListPunishments method.
+type ListPunishmentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The active punishments ordered by creation time.
+	Punishments   []*Punishment `protobuf:"bytes,1,rep,name=punishments,proto3" json:"punishments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPunishmentsResponse) Reset() {
+	*x = ListPunishmentsResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPunishmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPunishmentsResponse) ProtoMessage() {}
+
+func (x *ListPunishmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPunishmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPunishmentsResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListPunishmentsResponse) GetPunishments() []*Punishment {
+	if x != nil {
+		return x.Punishments
+	}
+	return nil
+}
+
+// AddPunishmentRequest is the request for AddPunishment method.
+// At least one of player, username or ip must be set.
+type AddPunishmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  PunishmentType         `protobuf:"varint,1,opt,name=type,proto3,enum=minekube.gate.v1.PunishmentType" json:"type,omitempty"`
+	// The player's username or ID.
+	// A username must be of an online player and punishes the player's UUID and username.
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// The username to punish, the player doesn't need to be online.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// The IP address or CIDR range to punish, e.g. "10.0.0.0/8".
+	Ip string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	// The reason shown to the player.
+	//
+	// Formats:
+	//
+	// - `{"text":"Hello, world!"}` - JSON text component. See https://wiki.vg/Text_formatting for details.
+	//
+	// - `§aHello,\n§bworld!` - Simple color codes. See https://wiki.vg/Text_formatting#Colors
+	//
+	// Optional, if empty no reason will be shown.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The duration of the punishment in seconds.
+	// Optional, if 0 the punishment is permanent.
+	DurationSeconds int64 `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Who issued the punishment.
+	// Optional, defaults to "api".
+	Issuer        string `protobuf:"bytes,7,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPunishmentRequest) Reset() {
+	*x = AddPunishmentRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPunishmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPunishmentRequest) ProtoMessage() {}
+
+func (x *AddPunishmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPunishmentRequest.ProtoReflect.Descriptor instead.
+func (*AddPunishmentRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddPunishmentRequest) GetType() PunishmentType {
+	if x != nil {
+		return x.Type
+	}
+	return PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED
+}
+
+func (x *AddPunishmentRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *AddPunishmentRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddPunishmentRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AddPunishmentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AddPunishmentRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *AddPunishmentRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+// AddPunishmentResponse is the response for AddPunishment method.
+type AddPunishmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The added punishment.
+	Punishment    *Punishment `protobuf:"bytes,1,opt,name=punishment,proto3" json:"punishment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPunishmentResponse) Reset() {
+	*x = AddPunishmentResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPunishmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPunishmentResponse) ProtoMessage() {}
+
+func (x *AddPunishmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPunishmentResponse.ProtoReflect.Descriptor instead.
+func (*AddPunishmentResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AddPunishmentResponse) GetPunishment() *Punishment {
+	if x != nil {
+		return x.Punishment
+	}
+	return nil
+}
+
+// RemovePunishmentRequest is the request for RemovePunishment method.
+type RemovePunishmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the punishment to remove.
+	// Optional, if not set, the type and target are used to match punishments.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of the punishments to remove.
+	Type PunishmentType `protobuf:"varint,2,opt,name=type,proto3,enum=minekube.gate.v1.PunishmentType" json:"type,omitempty"`
+	// The player UUID, username or IP of the punishments to remove.
+	Target        string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePunishmentRequest) Reset() {
+	*x = RemovePunishmentRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePunishmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePunishmentRequest) ProtoMessage() {}
+
+func (x *RemovePunishmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePunishmentRequest.ProtoReflect.Descriptor instead.
+func (*RemovePunishmentRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RemovePunishmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemovePunishmentRequest) GetType() PunishmentType {
+	if x != nil {
+		return x.Type
+	}
+	return PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED
+}
+
+func (x *RemovePunishmentRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// RemovePunishmentResponse is the response for RemovePunishment method.
+type RemovePunishmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The removed punishments.
+	Punishments   []*Punishment `protobuf:"bytes,1,rep,name=punishments,proto3" json:"punishments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePunishmentResponse) Reset() {
+	*x = RemovePunishmentResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePunishmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePunishmentResponse) ProtoMessage() {}
+
+func (x *RemovePunishmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePunishmentResponse.ProtoReflect.Descriptor instead.
+func (*RemovePunishmentResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemovePunishmentResponse) GetPunishments() []*Punishment {
+	if x != nil {
+		return x.Punishments
+	}
+	return nil
+}
+
+// ListChatChannelsRequest is the request for ListChatChannels method.
+type ListChatChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatChannelsRequest) Reset() {
+	*x = ListChatChannelsRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatChannelsRequest) ProtoMessage() {}
+
+func (x *ListChatChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChatChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{60}
+}
+
+// ListChatChannelsResponse is the response for ListChatChannels method.
+type ListChatChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*ChatChannel         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChatChannelsResponse) Reset() {
+	*x = ListChatChannelsResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatChannelsResponse) ProtoMessage() {}
+
+func (x *ListChatChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChatChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListChatChannelsResponse) GetChannels() []*ChatChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// ChatChannel is a proxy chat channel.
+type ChatChannel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the channel.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Chat messages starting with the prefix are sent to the channel.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The command to send to the channel without the leading "/".
+	Command string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	// The permission to join and read the channel, empty for everyone.
+	Permission string `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	// The permission to send to the channel.
+	SpeakPermission string `protobuf:"bytes,5,opt,name=speak_permission,json=speakPermission,proto3" json:"speak_permission,omitempty"`
+	// The online players that can read the channel.
+	Members       []*Player `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatChannel) Reset() {
+	*x = ChatChannel{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatChannel) ProtoMessage() {}
+
+func (x *ChatChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatChannel.ProtoReflect.Descriptor instead.
+func (*ChatChannel) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ChatChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetSpeakPermission() string {
+	if x != nil {
+		return x.SpeakPermission
+	}
+	return ""
+}
+
+func (x *ChatChannel) GetMembers() []*Player {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SendChatChannelMessageRequest is the request for SendChatChannelMessage method.
+type SendChatChannelMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// The plain text message, formatted with the channel's format.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The username or ID of the online player to send the message as.
+	// Optional, if empty the message is sent as console.
+	Player        string `protobuf:"bytes,3,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatChannelMessageRequest) Reset() {
+	*x = SendChatChannelMessageRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatChannelMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatChannelMessageRequest) ProtoMessage() {}
+
+func (x *SendChatChannelMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatChannelMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatChannelMessageRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SendChatChannelMessageRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendChatChannelMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendChatChannelMessageRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// SendChatChannelMessageResponse is the response for SendChatChannelMessage method.
+type SendChatChannelMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players that received the message.
+	Recipients    int32 `protobuf:"varint,1,opt,name=recipients,proto3" json:"recipients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatChannelMessageResponse) Reset() {
+	*x = SendChatChannelMessageResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatChannelMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatChannelMessageResponse) ProtoMessage() {}
+
+func (x *SendChatChannelMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatChannelMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatChannelMessageResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SendChatChannelMessageResponse) GetRecipients() int32 {
+	if x != nil {
+		return x.Recipients
+	}
+	return 0
+}
+
+// GetMaintenanceRequest is the request for GetMaintenance method.
+type GetMaintenanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceRequest) Reset() {
+	*x = GetMaintenanceRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{65}
+}
+
+// GetMaintenanceResponse is the response for GetMaintenance method.
+type GetMaintenanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the whole network is under maintenance.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The names of the servers under maintenance, route hosts in Lite mode.
+	Servers       []string `protobuf:"bytes,2,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceResponse) Reset() {
+	*x = GetMaintenanceResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetMaintenanceResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetMaintenanceResponse) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// SetMaintenanceRequest is the request for SetMaintenance method.
+type SetMaintenanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to enable or disable maintenance.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The server to toggle maintenance of, a route host in Lite mode.
+	// Optional, if empty maintenance of the whole network is toggled.
+	Server        string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceRequest) Reset() {
+	*x = SetMaintenanceRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetMaintenanceRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceRequest) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+// SetMaintenanceResponse is the response for SetMaintenance method.
+type SetMaintenanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players kicked by enabling global maintenance.
+	Kicked        int32 `protobuf:"varint,1,opt,name=kicked,proto3" json:"kicked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceResponse) Reset() {
+	*x = SetMaintenanceResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetMaintenanceResponse) GetKicked() int32 {
+	if x != nil {
+		return x.Kicked
+	}
+	return 0
+}
+
+// WhitelistEntry is a player of the proxy-level whitelist.
+// An entry with an id matches the player's UUID, otherwise the username.
+type WhitelistEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the player, empty if unknown.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The username of the player, empty if unknown.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhitelistEntry) Reset() {
+	*x = WhitelistEntry{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhitelistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistEntry) ProtoMessage() {}
+
+func (x *WhitelistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistEntry.ProtoReflect.Descriptor instead.
+func (*WhitelistEntry) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *WhitelistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WhitelistEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// ListWhitelistRequest is the request for ListWhitelist method.
+type ListWhitelistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWhitelistRequest) Reset() {
+	*x = ListWhitelistRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWhitelistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWhitelistRequest) ProtoMessage() {}
+
+func (x *ListWhitelistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*ListWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{70}
+}
+
+// ListWhitelistResponse is the response for ListWhitelist method.
+type ListWhitelistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The whitelisted players in the order they were added.
+	Entries       []*WhitelistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWhitelistResponse) Reset() {
+	*x = ListWhitelistResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWhitelistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWhitelistResponse) ProtoMessage() {}
+
+func (x *ListWhitelistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*ListWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListWhitelistResponse) GetEntries() []*WhitelistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// AddWhitelistEntryRequest is the request for AddWhitelistEntry method.
+type AddWhitelistEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or UUID, the player doesn't need to be online.
+	Player        string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWhitelistEntryRequest) Reset() {
+	*x = AddWhitelistEntryRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWhitelistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWhitelistEntryRequest) ProtoMessage() {}
+
+func (x *AddWhitelistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWhitelistEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddWhitelistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AddWhitelistEntryRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// AddWhitelistEntryResponse is the response for AddWhitelistEntry method.
+type AddWhitelistEntryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The added entry.
+	Entry         *WhitelistEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWhitelistEntryResponse) Reset() {
+	*x = AddWhitelistEntryResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWhitelistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWhitelistEntryResponse) ProtoMessage() {}
+
+func (x *AddWhitelistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWhitelistEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddWhitelistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AddWhitelistEntryResponse) GetEntry() *WhitelistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// RemoveWhitelistEntryRequest is the request for RemoveWhitelistEntry method.
+type RemoveWhitelistEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The player's username or UUID.
+	Player        string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWhitelistEntryRequest) Reset() {
+	*x = RemoveWhitelistEntryRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWhitelistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWhitelistEntryRequest) ProtoMessage() {}
+
+func (x *RemoveWhitelistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWhitelistEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWhitelistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RemoveWhitelistEntryRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+// RemoveWhitelistEntryResponse is the response for RemoveWhitelistEntry method.
+type RemoveWhitelistEntryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The removed entries.
+	Entries       []*WhitelistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWhitelistEntryResponse) Reset() {
+	*x = RemoveWhitelistEntryResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWhitelistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWhitelistEntryResponse) ProtoMessage() {}
+
+func (x *RemoveWhitelistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWhitelistEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWhitelistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RemoveWhitelistEntryResponse) GetEntries() []*WhitelistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// WatchEventsRequest is the request for WatchEvents method.
+// All filters must match for an event to be streamed.
+type WatchEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional, only stream events of these types.
+	// If empty, events of all types are streamed.
+	Types []EventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=minekube.gate.v1.EventType" json:"types,omitempty"`
+	// Optional, only stream events of these players by username or UUID.
+	// If specified, events not about a player are not streamed.
+	Players []string `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	// Optional, only stream events involving these servers by name.
+	// If specified, events not involving a server are not streamed.
+	Servers       []string `protobuf:"bytes,3,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *WatchEventsRequest) GetTypes() []EventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// WatchEventsResponse is a single event streamed by WatchEvents method.
+type WatchEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  EventType              `protobuf:"varint,1,opt,name=type,proto3,enum=minekube.gate.v1.EventType" json:"type,omitempty"`
+	// When the event was fired in Unix milliseconds.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// The number of events dropped right before this event
+	// because the client did not receive them fast enough.
+	// Drops no event follows are reported once the buffered events were sent,
+	// with EVENT_TYPE_UNSPECIFIED and no event.
+	Dropped uint64 `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// The event matching the type.
+	//
+	// Types that are valid to be assigned to Event:
+	//
+	//	*WatchEventsResponse_PlayerLogin
+	//	*WatchEventsResponse_PlayerDisconnect
+	//	*WatchEventsResponse_PlayerServerSwitch
+	//	*WatchEventsResponse_PlayerKicked
+	//	*WatchEventsResponse_ServerRegistered
+	//	*WatchEventsResponse_ServerUnregistered
+	//	*WatchEventsResponse_ConfigApplied
+	Event         isWatchEventsResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *WatchEventsResponse) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchEventsResponse) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *WatchEventsResponse) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *WatchEventsResponse) GetEvent() isWatchEventsResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetPlayerLogin() *PlayerLoginEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_PlayerLogin); ok {
+			return x.PlayerLogin
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetPlayerDisconnect() *PlayerDisconnectEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_PlayerDisconnect); ok {
+			return x.PlayerDisconnect
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetPlayerServerSwitch() *PlayerServerSwitchEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_PlayerServerSwitch); ok {
+			return x.PlayerServerSwitch
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetPlayerKicked() *PlayerKickedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_PlayerKicked); ok {
+			return x.PlayerKicked
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetServerRegistered() *ServerRegisteredEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_ServerRegistered); ok {
+			return x.ServerRegistered
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetServerUnregistered() *ServerUnregisteredEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_ServerUnregistered); ok {
+			return x.ServerUnregistered
+		}
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetConfigApplied() *ConfigAppliedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*WatchEventsResponse_ConfigApplied); ok {
+			return x.ConfigApplied
+		}
+	}
+	return nil
+}
+
+type isWatchEventsResponse_Event interface {
+	isWatchEventsResponse_Event()
+}
+
+type WatchEventsResponse_PlayerLogin struct {
+	PlayerLogin *PlayerLoginEvent `protobuf:"bytes,10,opt,name=player_login,json=playerLogin,proto3,oneof"`
+}
+
+type WatchEventsResponse_PlayerDisconnect struct {
+	PlayerDisconnect *PlayerDisconnectEvent `protobuf:"bytes,11,opt,name=player_disconnect,json=playerDisconnect,proto3,oneof"`
+}
+
+type WatchEventsResponse_PlayerServerSwitch struct {
+	PlayerServerSwitch *PlayerServerSwitchEvent `protobuf:"bytes,12,opt,name=player_server_switch,json=playerServerSwitch,proto3,oneof"`
+}
+
+type WatchEventsResponse_PlayerKicked struct {
+	PlayerKicked *PlayerKickedEvent `protobuf:"bytes,13,opt,name=player_kicked,json=playerKicked,proto3,oneof"`
+}
+
+type WatchEventsResponse_ServerRegistered struct {
+	ServerRegistered *ServerRegisteredEvent `protobuf:"bytes,14,opt,name=server_registered,json=serverRegistered,proto3,oneof"`
+}
+
+type WatchEventsResponse_ServerUnregistered struct {
+	ServerUnregistered *ServerUnregisteredEvent `protobuf:"bytes,15,opt,name=server_unregistered,json=serverUnregistered,proto3,oneof"`
+}
+
+type WatchEventsResponse_ConfigApplied struct {
+	ConfigApplied *ConfigAppliedEvent `protobuf:"bytes,16,opt,name=config_applied,json=configApplied,proto3,oneof"`
+}
+
+func (*WatchEventsResponse_PlayerLogin) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_PlayerDisconnect) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_PlayerServerSwitch) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_PlayerKicked) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_ServerRegistered) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_ServerUnregistered) isWatchEventsResponse_Event() {}
+
+func (*WatchEventsResponse_ConfigApplied) isWatchEventsResponse_Event() {}
+
+// PlayerLoginEvent is streamed when a player logged in to the proxy.
+type PlayerLoginEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLoginEvent) Reset() {
+	*x = PlayerLoginEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLoginEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLoginEvent) ProtoMessage() {}
+
+func (x *PlayerLoginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLoginEvent.ProtoReflect.Descriptor instead.
+func (*PlayerLoginEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PlayerLoginEvent) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// PlayerDisconnectEvent is streamed when a logged in player disconnected from the proxy.
+type PlayerDisconnectEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The server the player was connected to, empty if none.
+	Server        string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDisconnectEvent) Reset() {
+	*x = PlayerDisconnectEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDisconnectEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDisconnectEvent) ProtoMessage() {}
+
+func (x *PlayerDisconnectEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDisconnectEvent.ProtoReflect.Descriptor instead.
+func (*PlayerDisconnectEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PlayerDisconnectEvent) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerDisconnectEvent) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+// PlayerServerSwitchEvent is streamed when a player connected to a server.
+type PlayerServerSwitchEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The server the player connected to.
+	Server string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// The server the player was previously connected to,
+	// empty if the player connected to their initial server.
+	PreviousServer string `protobuf:"bytes,3,opt,name=previous_server,json=previousServer,proto3" json:"previous_server,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerServerSwitchEvent) Reset() {
+	*x = PlayerServerSwitchEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerServerSwitchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerServerSwitchEvent) ProtoMessage() {}
+
+func (x *PlayerServerSwitchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerServerSwitchEvent.ProtoReflect.Descriptor instead.
+func (*PlayerServerSwitchEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PlayerServerSwitchEvent) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerServerSwitchEvent) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *PlayerServerSwitchEvent) GetPreviousServer() string {
+	if x != nil {
+		return x.PreviousServer
+	}
+	return ""
+}
+
+// PlayerKickedEvent is streamed when a player was kicked from a server.
+type PlayerKickedEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The server that kicked the player.
+	Server string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// The kick reason of the server as JSON text component, empty if none.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the player was kicked while connecting to the server
+	// and is still connected to their previous server.
+	DuringServerConnect bool `protobuf:"varint,4,opt,name=during_server_connect,json=duringServerConnect,proto3" json:"during_server_connect,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PlayerKickedEvent) Reset() {
+	*x = PlayerKickedEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerKickedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerKickedEvent) ProtoMessage() {}
+
+func (x *PlayerKickedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerKickedEvent.ProtoReflect.Descriptor instead.
+func (*PlayerKickedEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PlayerKickedEvent) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *PlayerKickedEvent) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *PlayerKickedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PlayerKickedEvent) GetDuringServerConnect() bool {
+	if x != nil {
+		return x.DuringServerConnect
+	}
+	return false
+}
+
+// ServerRegisteredEvent is streamed when a server was registered.
+type ServerRegisteredEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerRegisteredEvent) Reset() {
+	*x = ServerRegisteredEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerRegisteredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerRegisteredEvent) ProtoMessage() {}
+
+func (x *ServerRegisteredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerRegisteredEvent.ProtoReflect.Descriptor instead.
+func (*ServerRegisteredEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ServerRegisteredEvent) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+// ServerUnregisteredEvent is streamed when a server was unregistered.
+type ServerUnregisteredEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the server.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The network address of the server.
+	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerUnregisteredEvent) Reset() {
+	*x = ServerUnregisteredEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerUnregisteredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerUnregisteredEvent) ProtoMessage() {}
+
+func (x *ServerUnregisteredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerUnregisteredEvent.ProtoReflect.Descriptor instead.
+func (*ServerUnregisteredEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ServerUnregisteredEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerUnregisteredEvent) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ConfigAppliedEvent is streamed when a config was applied at runtime.
+// Use GetConfig to get the applied config.
+type ConfigAppliedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigAppliedEvent) Reset() {
+	*x = ConfigAppliedEvent{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigAppliedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigAppliedEvent) ProtoMessage() {}
+
+func (x *ConfigAppliedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigAppliedEvent.ProtoReflect.Descriptor instead.
+func (*ConfigAppliedEvent) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{84}
+}
+
+// Audience selects the online players to send messages to.
+// The players selected by each field are combined.
+type Audience struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional, players by username or UUID. Offline players are ignored.
+	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Optional, all players connected to these servers.
+	Servers []string `protobuf:"bytes,2,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Optional, all players of the proxy.
+	All           bool `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Audience) Reset() {
+	*x = Audience{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Audience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Audience) ProtoMessage() {}
+
+func (x *Audience) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Audience.ProtoReflect.Descriptor instead.
+func (*Audience) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Audience) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *Audience) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *Audience) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// SendMessageRequest is the request for SendMessage method.
+type SendMessageRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Audience *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	// The chat message.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SendMessageRequest) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SendMessageResponse is the response for SendMessage method.
+type SendMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players the message was sent to.
+	Players       int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SendMessageResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// SendActionBarRequest is the request for SendActionBar method.
+type SendActionBarRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Audience *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	// The action bar message.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendActionBarRequest) Reset() {
+	*x = SendActionBarRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendActionBarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActionBarRequest) ProtoMessage() {}
+
+func (x *SendActionBarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActionBarRequest.ProtoReflect.Descriptor instead.
+func (*SendActionBarRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SendActionBarRequest) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *SendActionBarRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SendActionBarResponse is the response for SendActionBar method.
+type SendActionBarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players the action bar was shown to.
+	Players       int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendActionBarResponse) Reset() {
+	*x = SendActionBarResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendActionBarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActionBarResponse) ProtoMessage() {}
+
+func (x *SendActionBarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActionBarResponse.ProtoReflect.Descriptor instead.
+func (*SendActionBarResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SendActionBarResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// ShowTitleRequest is the request for ShowTitle method.
+type ShowTitleRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Audience *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	// The title, optional if a subtitle is set.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The subtitle, optional.
+	Subtitle string `protobuf:"bytes,3,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	// The fade in, stay and fade out times in milliseconds.
+	// If all are 0, the defaults of 500, 3000 and 500 milliseconds are used.
+	FadeInMs      int32 `protobuf:"varint,4,opt,name=fade_in_ms,json=fadeInMs,proto3" json:"fade_in_ms,omitempty"`
+	StayMs        int32 `protobuf:"varint,5,opt,name=stay_ms,json=stayMs,proto3" json:"stay_ms,omitempty"`
+	FadeOutMs     int32 `protobuf:"varint,6,opt,name=fade_out_ms,json=fadeOutMs,proto3" json:"fade_out_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowTitleRequest) Reset() {
+	*x = ShowTitleRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowTitleRequest) ProtoMessage() {}
+
+func (x *ShowTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowTitleRequest.ProtoReflect.Descriptor instead.
+func (*ShowTitleRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ShowTitleRequest) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *ShowTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShowTitleRequest) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *ShowTitleRequest) GetFadeInMs() int32 {
+	if x != nil {
+		return x.FadeInMs
+	}
+	return 0
+}
+
+func (x *ShowTitleRequest) GetStayMs() int32 {
+	if x != nil {
+		return x.StayMs
+	}
+	return 0
+}
+
+func (x *ShowTitleRequest) GetFadeOutMs() int32 {
+	if x != nil {
+		return x.FadeOutMs
+	}
+	return 0
+}
+
+// ShowTitleResponse is the response for ShowTitle method.
+type ShowTitleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players the title was shown to.
+	Players       int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowTitleResponse) Reset() {
+	*x = ShowTitleResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowTitleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowTitleResponse) ProtoMessage() {}
+
+func (x *ShowTitleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowTitleResponse.ProtoReflect.Descriptor instead.
+func (*ShowTitleResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ShowTitleResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// PlaySoundRequest is the request for PlaySound method.
+type PlaySoundRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Audience *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	// The sound key, e.g. "entity.experience_orb.pickup" or "minecraft:block.note_block.pling".
+	// The namespace defaults to "minecraft".
+	Sound string `protobuf:"bytes,2,opt,name=sound,proto3" json:"sound,omitempty"`
+	// The sound source, e.g. "master", "music", "player" or "ui".
+	// Optional, defaults to "master".
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// The volume, optional, defaults to 1.
+	Volume *float32 `protobuf:"fixed32,4,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	// The pitch, optional, defaults to 1.
+	Pitch         *float32 `protobuf:"fixed32,5,opt,name=pitch,proto3,oneof" json:"pitch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaySoundRequest) Reset() {
+	*x = PlaySoundRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaySoundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaySoundRequest) ProtoMessage() {}
+
+func (x *PlaySoundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaySoundRequest.ProtoReflect.Descriptor instead.
+func (*PlaySoundRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PlaySoundRequest) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *PlaySoundRequest) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+func (x *PlaySoundRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PlaySoundRequest) GetVolume() float32 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+func (x *PlaySoundRequest) GetPitch() float32 {
+	if x != nil && x.Pitch != nil {
+		return *x.Pitch
+	}
+	return 0
+}
+
+// PlaySoundResponse is the response for PlaySound method.
+type PlaySoundResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players the sound was played to.
+	Players       int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaySoundResponse) Reset() {
+	*x = PlaySoundResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaySoundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaySoundResponse) ProtoMessage() {}
+
+func (x *PlaySoundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaySoundResponse.ProtoReflect.Descriptor instead.
+func (*PlaySoundResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PlaySoundResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// ShowBossBarRequest is the request for ShowBossBar method.
+type ShowBossBarRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Audience *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	// The name shown above the bar.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The progress of the bar from 0 to 1, values outside are clamped.
+	Progress float32        `protobuf:"fixed32,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Color    BossBarColor   `protobuf:"varint,4,opt,name=color,proto3,enum=minekube.gate.v1.BossBarColor" json:"color,omitempty"`
+	Overlay  BossBarOverlay `protobuf:"varint,5,opt,name=overlay,proto3,enum=minekube.gate.v1.BossBarOverlay" json:"overlay,omitempty"`
+	// How long to show the boss bar in milliseconds.
+	// Optional, if 0 the boss bar is shown until hidden with HideBossBar or all its players left.
+	DurationMs    int64 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowBossBarRequest) Reset() {
+	*x = ShowBossBarRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowBossBarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowBossBarRequest) ProtoMessage() {}
+
+func (x *ShowBossBarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowBossBarRequest.ProtoReflect.Descriptor instead.
+func (*ShowBossBarRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ShowBossBarRequest) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *ShowBossBarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShowBossBarRequest) GetProgress() float32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ShowBossBarRequest) GetColor() BossBarColor {
+	if x != nil {
+		return x.Color
+	}
+	return BossBarColor_BOSS_BAR_COLOR_UNSPECIFIED
+}
+
+func (x *ShowBossBarRequest) GetOverlay() BossBarOverlay {
+	if x != nil {
+		return x.Overlay
+	}
+	return BossBarOverlay_BOSS_BAR_OVERLAY_UNSPECIFIED
+}
+
+func (x *ShowBossBarRequest) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// ShowBossBarResponse is the response for ShowBossBar method.
+type ShowBossBarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the boss bar to hide it with HideBossBar.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The number of players the boss bar was shown to.
+	Players       int32 `protobuf:"varint,2,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowBossBarResponse) Reset() {
+	*x = ShowBossBarResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowBossBarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowBossBarResponse) ProtoMessage() {}
+
+func (x *ShowBossBarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowBossBarResponse.ProtoReflect.Descriptor instead.
+func (*ShowBossBarResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ShowBossBarResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShowBossBarResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// HideBossBarRequest is the request for HideBossBar method.
+type HideBossBarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the boss bar returned by ShowBossBar.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HideBossBarRequest) Reset() {
+	*x = HideBossBarRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideBossBarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideBossBarRequest) ProtoMessage() {}
+
+func (x *HideBossBarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideBossBarRequest.ProtoReflect.Descriptor instead.
+func (*HideBossBarRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *HideBossBarRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// HideBossBarResponse is the response for HideBossBar method.
+type HideBossBarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HideBossBarResponse) Reset() {
+	*x = HideBossBarResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideBossBarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideBossBarResponse) ProtoMessage() {}
+
+func (x *HideBossBarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideBossBarResponse.ProtoReflect.Descriptor instead.
+func (*HideBossBarResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{97}
+}
+
+// SendResourcePackRequest is the request for SendResourcePack method.
+type SendResourcePackRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Audience *Audience              `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	// The download URL of the resource pack.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The hex encoded SHA-1 hash of the resource pack.
+	// Optional, but recommended so clients can use a cached resource pack.
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Whether players must accept the resource pack or are disconnected.
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// The message shown on the resource pack prompt, optional.
+	Prompt        string `protobuf:"bytes,5,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendResourcePackRequest) Reset() {
+	*x = SendResourcePackRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResourcePackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResourcePackRequest) ProtoMessage() {}
+
+func (x *SendResourcePackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResourcePackRequest.ProtoReflect.Descriptor instead.
+func (*SendResourcePackRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SendResourcePackRequest) GetAudience() *Audience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *SendResourcePackRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SendResourcePackRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SendResourcePackRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *SendResourcePackRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+// SendResourcePackResponse is the response for SendResourcePack method.
+type SendResourcePackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of players the resource pack was sent to.
+	Players       int32 `protobuf:"varint,1,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendResourcePackResponse) Reset() {
+	*x = SendResourcePackResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendResourcePackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResourcePackResponse) ProtoMessage() {}
+
+func (x *SendResourcePackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResourcePackResponse.ProtoReflect.Descriptor instead.
+func (*SendResourcePackResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SendResourcePackResponse) GetPlayers() int32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+// CommandSource is the source a command is run as.
+type CommandSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional, impersonates this online player by username or UUID.
+	// The command is run as the player with their permissions,
+	// but messages are returned instead of sent to the player.
+	Player string `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// The permission nodes of the source if no player is impersonated,
+	// e.g. "gate.command.*" or "*" for all permissions. Nodes starting with "-" deny a permission.
+	// Optional, if empty the source has no permissions.
+	Permissions   []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandSource) Reset() {
+	*x = CommandSource{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandSource) ProtoMessage() {}
+
+func (x *CommandSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandSource.ProtoReflect.Descriptor instead.
+func (*CommandSource) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CommandSource) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *CommandSource) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+// CommandMessage is a message sent to the command source.
+type CommandMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The message as JSON text component.
+	Json string `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	// The message as plain text without formatting.
+	Plain         string `protobuf:"bytes,2,opt,name=plain,proto3" json:"plain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandMessage) Reset() {
+	*x = CommandMessage{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandMessage) ProtoMessage() {}
+
+func (x *CommandMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandMessage.ProtoReflect.Descriptor instead.
+func (*CommandMessage) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CommandMessage) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *CommandMessage) GetPlain() string {
+	if x != nil {
+		return x.Plain
+	}
+	return ""
+}
+
+// ExecuteCommandRequest is the request for ExecuteCommand method.
+type ExecuteCommandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The command line without leading slash, e.g. "glist all".
+	Command       string         `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Source        *CommandSource `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteCommandRequest) Reset() {
+	*x = ExecuteCommandRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteCommandRequest) ProtoMessage() {}
+
+func (x *ExecuteCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteCommandRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteCommandRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ExecuteCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ExecuteCommandRequest) GetSource() *CommandSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+// ExecuteCommandResponse is the response for ExecuteCommand method.
+type ExecuteCommandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The messages sent to the command source in order.
+	Messages      []*CommandMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteCommandResponse) Reset() {
+	*x = ExecuteCommandResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteCommandResponse) ProtoMessage() {}
+
+func (x *ExecuteCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteCommandResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteCommandResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ExecuteCommandResponse) GetMessages() []*CommandMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// SuggestCommandRequest is the request for SuggestCommand method.
+type SuggestCommandRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The partial command line without leading slash, e.g. "server l".
+	Command       string         `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Source        *CommandSource `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCommandRequest) Reset() {
+	*x = SuggestCommandRequest{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCommandRequest) ProtoMessage() {}
+
+func (x *SuggestCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCommandRequest.ProtoReflect.Descriptor instead.
+func (*SuggestCommandRequest) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SuggestCommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *SuggestCommandRequest) GetSource() *CommandSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+// SuggestCommandResponse is the response for SuggestCommand method.
+type SuggestCommandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggestions for the last argument of the command line.
+	Suggestions   []string `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestCommandResponse) Reset() {
+	*x = SuggestCommandResponse{}
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestCommandResponse) ProtoMessage() {}
+
+func (x *SuggestCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minekube_gate_v1_gate_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestCommandResponse.ProtoReflect.Descriptor instead.
+func (*SuggestCommandResponse) Descriptor() ([]byte, []int) {
+	return file_minekube_gate_v1_gate_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SuggestCommandResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+var File_minekube_gate_v1_gate_service_proto protoreflect.FileDescriptor
+
+const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
+	"\n" +
+	"#minekube/gate/v1/gate_service.proto\x12\x10minekube.gate.v1\"X\n" +
+	"\x12StoreCookieRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"\x15\n" +
+	"\x13StoreCookieResponse\"@\n" +
+	"\x14RequestCookieRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"1\n" +
+	"\x15RequestCookieResponse\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\"I\n" +
+	"\x17DisconnectPlayerRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x1a\n" +
+	"\x18DisconnectPlayerResponse\"F\n" +
+	"\x14ConnectPlayerRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\"\x17\n" +
+	"\x15ConnectPlayerResponse\"E\n" +
+	"\x15RegisterServerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x18\n" +
+	"\x16RegisterServerResponse\"G\n" +
+	"\x17UnregisterServerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x1a\n" +
+	"\x18UnregisterServerResponse\"\x14\n" +
+	"\x12ListServersRequest\"I\n" +
+	"\x13ListServersResponse\x122\n" +
+	"\aservers\x18\x01 \x03(\v2\x18.minekube.gate.v1.ServerR\aservers\"\x88\x01\n" +
+	"\x06Server\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\aplayers\x18\x03 \x01(\x05R\aplayers\x126\n" +
+	"\x06health\x18\x04 \x01(\x0e2\x1e.minekube.gate.v1.ServerHealthR\x06health\">\n" +
+	"\x10GetPlayerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"E\n" +
 	"\x11GetPlayerResponse\x120\n" +
-	"\x06player\x18\x01 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\".\n" +
+	"\x06player\x18\x01 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\"u\n" +
 	"\x12ListPlayersRequest\x12\x18\n" +
-	"\aservers\x18\x01 \x03(\tR\aservers\"I\n" +
+	"\aservers\x18\x01 \x03(\tR\aservers\x12/\n" +
+	"\x11player_is_bedrock\x18\x02 \x01(\bH\x00R\x0fplayerIsBedrock\x88\x01\x01B\x14\n" +
+	"\x12_player_is_bedrock\"I\n" +
 	"\x13ListPlayersResponse\x122\n" +
 	"\aplayers\x18\x01 \x03(\v2\x18.minekube.gate.v1.PlayerR\aplayers\"s\n" +
 	"\x06Player\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12=\n" +
-	"\abedrock\x18\x03 \x01(\v2#.minekube.gate.v1.BedrockPlayerDataR\abedrock\"\xd1\x02\n" +
+	"\abedrock\x18\x03 \x01(\v2#.minekube.gate.v1.BedrockPlayerDataR\abedrock\"\xbd\x03\n" +
 	"\x11BedrockPlayerData\x12\x12\n" +
 	"\x04xuid\x18\x01 \x01(\x03R\x04xuid\x12>\n" +
 	"\tdevice_os\x18\x02 \x01(\x0e2!.minekube.gate.v1.BedrockDeviceOSR\bdeviceOs\x12\x1a\n" +
@@ -2029,7 +6718,12 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\n" +
 	"input_mode\x18\x05 \x01(\x0e2\".minekube.gate.v1.BedrockInputModeR\tinputMode\x12!\n" +
 	"\fbehind_proxy\x18\x06 \x01(\bR\vbehindProxy\x12#\n" +
-	"\rlinked_player\x18\a \x01(\tR\flinkedPlayer\"\x12\n" +
+	"\rlinked_player\x18\a \x01(\tR\flinkedPlayer\x12\x18\n" +
+	"\aversion\x18\b \x01(\tR\aversion\x12\x1a\n" +
+	"\busername\x18\t \x01(\tR\busername\x12$\n" +
+	"\x0edevice_os_name\x18\n" +
+	" \x01(\tR\fdeviceOsName\x12\x0e\n" +
+	"\x02ip\x18\v \x01(\tR\x02ip\"\x12\n" +
 	"\x10GetStatusRequest\"\xd6\x01\n" +
 	"\x11GetStatusResponse\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12/\n" +
@@ -2061,7 +6755,262 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\x05input\"K\n" +
 	"\x13ApplyConfigResponse\x12\x1a\n" +
 	"\bwarnings\x18\x01 \x03(\tR\bwarnings\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion*T\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"a\n" +
+	"\x0fPermissionGroup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aparents\x18\x02 \x03(\tR\aparents\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"q\n" +
+	"\x11PlayerPermissions\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06groups\x18\x03 \x03(\tR\x06groups\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"\x17\n" +
+	"\x15GetPermissionsRequest\"\x92\x01\n" +
+	"\x16GetPermissionsResponse\x129\n" +
+	"\x06groups\x18\x01 \x03(\v2!.minekube.gate.v1.PermissionGroupR\x06groups\x12=\n" +
+	"\aplayers\x18\x02 \x03(\v2#.minekube.gate.v1.PlayerPermissionsR\aplayers\"P\n" +
+	"\x16CheckPermissionRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"l\n" +
+	"\x17CheckPermissionResponse\x127\n" +
+	"\x05value\x18\x01 \x01(\x0e2!.minekube.gate.v1.PermissionValueR\x05value\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\"T\n" +
+	"\x19SetPermissionGroupRequest\x127\n" +
+	"\x05group\x18\x01 \x01(\v2!.minekube.gate.v1.PermissionGroupR\x05group\"\x1c\n" +
+	"\x1aSetPermissionGroupResponse\"2\n" +
+	"\x1cDeletePermissionGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1f\n" +
+	"\x1dDeletePermissionGroupResponse\"o\n" +
+	"\x1bSetPlayerPermissionsRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
+	"\x06groups\x18\x02 \x03(\tR\x06groups\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\"[\n" +
+	"\x1cSetPlayerPermissionsResponse\x12;\n" +
+	"\x06player\x18\x01 \x01(\v2#.minekube.gate.v1.PlayerPermissionsR\x06player\"W\n" +
+	"\x05Queue\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x126\n" +
+	"\aentries\x18\x02 \x03(\v2\x1c.minekube.gate.v1.QueueEntryR\aentries\"\xcc\x01\n" +
+	"\n" +
+	"QueueEntry\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06server\x18\x03 \x01(\tR\x06server\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\x05R\bpriority\x12!\n" +
+	"\fwait_seconds\x18\a \x01(\x03R\vwaitSeconds\"-\n" +
+	"\x11ListQueuesRequest\x12\x18\n" +
+	"\aservers\x18\x01 \x03(\tR\aservers\"E\n" +
+	"\x12ListQueuesResponse\x12/\n" +
+	"\x06queues\x18\x01 \x03(\v2\x17.minekube.gate.v1.QueueR\x06queues\"t\n" +
+	"\x14EnqueuePlayerRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x1f\n" +
+	"\bpriority\x18\x03 \x01(\x05H\x00R\bpriority\x88\x01\x01B\v\n" +
+	"\t_priority\"K\n" +
+	"\x15EnqueuePlayerResponse\x122\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1c.minekube.gate.v1.QueueEntryR\x05entry\".\n" +
+	"\x14DequeuePlayerRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\"\x17\n" +
+	"\x15DequeuePlayerResponse\"+\n" +
+	"\x11ClearQueueRequest\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\".\n" +
+	"\x12ClearQueueResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"\x89\x02\n" +
+	"\n" +
+	"Punishment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .minekube.gate.v1.PunishmentTypeR\x04type\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06issuer\x18\a \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\x03R\texpiresAt\"N\n" +
+	"\x16ListPunishmentsRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .minekube.gate.v1.PunishmentTypeR\x04type\"Y\n" +
+	"\x17ListPunishmentsResponse\x12>\n" +
+	"\vpunishments\x18\x01 \x03(\v2\x1c.minekube.gate.v1.PunishmentR\vpunishments\"\xeb\x01\n" +
+	"\x14AddPunishmentRequest\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .minekube.gate.v1.PunishmentTypeR\x04type\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x12\x16\n" +
+	"\x06issuer\x18\a \x01(\tR\x06issuer\"U\n" +
+	"\x15AddPunishmentResponse\x12<\n" +
+	"\n" +
+	"punishment\x18\x01 \x01(\v2\x1c.minekube.gate.v1.PunishmentR\n" +
+	"punishment\"w\n" +
+	"\x17RemovePunishmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .minekube.gate.v1.PunishmentTypeR\x04type\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\"Z\n" +
+	"\x18RemovePunishmentResponse\x12>\n" +
+	"\vpunishments\x18\x01 \x03(\v2\x1c.minekube.gate.v1.PunishmentR\vpunishments\"\x19\n" +
+	"\x17ListChatChannelsRequest\"U\n" +
+	"\x18ListChatChannelsResponse\x129\n" +
+	"\bchannels\x18\x01 \x03(\v2\x1d.minekube.gate.v1.ChatChannelR\bchannels\"\xd2\x01\n" +
+	"\vChatChannel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x18\n" +
+	"\acommand\x18\x03 \x01(\tR\acommand\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\x12)\n" +
+	"\x10speak_permission\x18\x05 \x01(\tR\x0fspeakPermission\x122\n" +
+	"\amembers\x18\x06 \x03(\v2\x18.minekube.gate.v1.PlayerR\amembers\"k\n" +
+	"\x1dSendChatChannelMessageRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06player\x18\x03 \x01(\tR\x06player\"@\n" +
+	"\x1eSendChatChannelMessageResponse\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x01 \x01(\x05R\n" +
+	"recipients\"\x17\n" +
+	"\x15GetMaintenanceRequest\"L\n" +
+	"\x16GetMaintenanceResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aservers\x18\x02 \x03(\tR\aservers\"I\n" +
+	"\x15SetMaintenanceRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\"0\n" +
+	"\x16SetMaintenanceResponse\x12\x16\n" +
+	"\x06kicked\x18\x01 \x01(\x05R\x06kicked\"<\n" +
+	"\x0eWhitelistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x16\n" +
+	"\x14ListWhitelistRequest\"S\n" +
+	"\x15ListWhitelistResponse\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .minekube.gate.v1.WhitelistEntryR\aentries\"2\n" +
+	"\x18AddWhitelistEntryRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\"S\n" +
+	"\x19AddWhitelistEntryResponse\x126\n" +
+	"\x05entry\x18\x01 \x01(\v2 .minekube.gate.v1.WhitelistEntryR\x05entry\"5\n" +
+	"\x1bRemoveWhitelistEntryRequest\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\"Z\n" +
+	"\x1cRemoveWhitelistEntryResponse\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .minekube.gate.v1.WhitelistEntryR\aentries\"{\n" +
+	"\x12WatchEventsRequest\x121\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x1b.minekube.gate.v1.EventTypeR\x05types\x12\x18\n" +
+	"\aplayers\x18\x02 \x03(\tR\aplayers\x12\x18\n" +
+	"\aservers\x18\x03 \x03(\tR\aservers\"\xce\x05\n" +
+	"\x13WatchEventsResponse\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.minekube.gate.v1.EventTypeR\x04type\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x03R\x04time\x12\x18\n" +
+	"\adropped\x18\x03 \x01(\x04R\adropped\x12G\n" +
+	"\fplayer_login\x18\n" +
+	" \x01(\v2\".minekube.gate.v1.PlayerLoginEventH\x00R\vplayerLogin\x12V\n" +
+	"\x11player_disconnect\x18\v \x01(\v2'.minekube.gate.v1.PlayerDisconnectEventH\x00R\x10playerDisconnect\x12]\n" +
+	"\x14player_server_switch\x18\f \x01(\v2).minekube.gate.v1.PlayerServerSwitchEventH\x00R\x12playerServerSwitch\x12J\n" +
+	"\rplayer_kicked\x18\r \x01(\v2#.minekube.gate.v1.PlayerKickedEventH\x00R\fplayerKicked\x12V\n" +
+	"\x11server_registered\x18\x0e \x01(\v2'.minekube.gate.v1.ServerRegisteredEventH\x00R\x10serverRegistered\x12\\\n" +
+	"\x13server_unregistered\x18\x0f \x01(\v2).minekube.gate.v1.ServerUnregisteredEventH\x00R\x12serverUnregistered\x12M\n" +
+	"\x0econfig_applied\x18\x10 \x01(\v2$.minekube.gate.v1.ConfigAppliedEventH\x00R\rconfigAppliedB\a\n" +
+	"\x05event\"D\n" +
+	"\x10PlayerLoginEvent\x120\n" +
+	"\x06player\x18\x01 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\"a\n" +
+	"\x15PlayerDisconnectEvent\x120\n" +
+	"\x06player\x18\x01 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\"\x8c\x01\n" +
+	"\x17PlayerServerSwitchEvent\x120\n" +
+	"\x06player\x18\x01 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12'\n" +
+	"\x0fprevious_server\x18\x03 \x01(\tR\x0epreviousServer\"\xa9\x01\n" +
+	"\x11PlayerKickedEvent\x120\n" +
+	"\x06player\x18\x01 \x01(\v2\x18.minekube.gate.v1.PlayerR\x06player\x12\x16\n" +
+	"\x06server\x18\x02 \x01(\tR\x06server\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x122\n" +
+	"\x15during_server_connect\x18\x04 \x01(\bR\x13duringServerConnect\"I\n" +
+	"\x15ServerRegisteredEvent\x120\n" +
+	"\x06server\x18\x01 \x01(\v2\x18.minekube.gate.v1.ServerR\x06server\"G\n" +
+	"\x17ServerUnregisteredEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x14\n" +
+	"\x12ConfigAppliedEvent\"P\n" +
+	"\bAudience\x12\x18\n" +
+	"\aplayers\x18\x01 \x03(\tR\aplayers\x12\x18\n" +
+	"\aservers\x18\x02 \x03(\tR\aservers\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\"f\n" +
+	"\x12SendMessageRequest\x126\n" +
+	"\baudience\x18\x01 \x01(\v2\x1a.minekube.gate.v1.AudienceR\baudience\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x13SendMessageResponse\x12\x18\n" +
+	"\aplayers\x18\x01 \x01(\x05R\aplayers\"h\n" +
+	"\x14SendActionBarRequest\x126\n" +
+	"\baudience\x18\x01 \x01(\v2\x1a.minekube.gate.v1.AudienceR\baudience\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
+	"\x15SendActionBarResponse\x12\x18\n" +
+	"\aplayers\x18\x01 \x01(\x05R\aplayers\"\xd3\x01\n" +
+	"\x10ShowTitleRequest\x126\n" +
+	"\baudience\x18\x01 \x01(\v2\x1a.minekube.gate.v1.AudienceR\baudience\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsubtitle\x18\x03 \x01(\tR\bsubtitle\x12\x1c\n" +
+	"\n" +
+	"fade_in_ms\x18\x04 \x01(\x05R\bfadeInMs\x12\x17\n" +
+	"\astay_ms\x18\x05 \x01(\x05R\x06stayMs\x12\x1e\n" +
+	"\vfade_out_ms\x18\x06 \x01(\x05R\tfadeOutMs\"-\n" +
+	"\x11ShowTitleResponse\x12\x18\n" +
+	"\aplayers\x18\x01 \x01(\x05R\aplayers\"\xc5\x01\n" +
+	"\x10PlaySoundRequest\x126\n" +
+	"\baudience\x18\x01 \x01(\v2\x1a.minekube.gate.v1.AudienceR\baudience\x12\x14\n" +
+	"\x05sound\x18\x02 \x01(\tR\x05sound\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1b\n" +
+	"\x06volume\x18\x04 \x01(\x02H\x00R\x06volume\x88\x01\x01\x12\x19\n" +
+	"\x05pitch\x18\x05 \x01(\x02H\x01R\x05pitch\x88\x01\x01B\t\n" +
+	"\a_volumeB\b\n" +
+	"\x06_pitch\"-\n" +
+	"\x11PlaySoundResponse\x12\x18\n" +
+	"\aplayers\x18\x01 \x01(\x05R\aplayers\"\x8f\x02\n" +
+	"\x12ShowBossBarRequest\x126\n" +
+	"\baudience\x18\x01 \x01(\v2\x1a.minekube.gate.v1.AudienceR\baudience\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprogress\x18\x03 \x01(\x02R\bprogress\x124\n" +
+	"\x05color\x18\x04 \x01(\x0e2\x1e.minekube.gate.v1.BossBarColorR\x05color\x12:\n" +
+	"\aoverlay\x18\x05 \x01(\x0e2 .minekube.gate.v1.BossBarOverlayR\aoverlay\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs\"?\n" +
+	"\x13ShowBossBarResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aplayers\x18\x02 \x01(\x05R\aplayers\"$\n" +
+	"\x12HideBossBarRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13HideBossBarResponse\"\xab\x01\n" +
+	"\x17SendResourcePackRequest\x126\n" +
+	"\baudience\x18\x01 \x01(\v2\x1a.minekube.gate.v1.AudienceR\baudience\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x16\n" +
+	"\x06prompt\x18\x05 \x01(\tR\x06prompt\"4\n" +
+	"\x18SendResourcePackResponse\x12\x18\n" +
+	"\aplayers\x18\x01 \x01(\x05R\aplayers\"I\n" +
+	"\rCommandSource\x12\x16\n" +
+	"\x06player\x18\x01 \x01(\tR\x06player\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\":\n" +
+	"\x0eCommandMessage\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json\x12\x14\n" +
+	"\x05plain\x18\x02 \x01(\tR\x05plain\"j\n" +
+	"\x15ExecuteCommandRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x127\n" +
+	"\x06source\x18\x02 \x01(\v2\x1f.minekube.gate.v1.CommandSourceR\x06source\"V\n" +
+	"\x16ExecuteCommandResponse\x12<\n" +
+	"\bmessages\x18\x01 \x03(\v2 .minekube.gate.v1.CommandMessageR\bmessages\"j\n" +
+	"\x15SuggestCommandRequest\x12\x18\n" +
+	"\acommand\x18\x01 \x01(\tR\acommand\x127\n" +
+	"\x06source\x18\x02 \x01(\v2\x1f.minekube.gate.v1.CommandSourceR\x06source\":\n" +
+	"\x16SuggestCommandResponse\x12 \n" +
+	"\vsuggestions\x18\x01 \x03(\tR\vsuggestions*w\n" +
+	"\fServerHealth\x12\x1d\n" +
+	"\x19SERVER_HEALTH_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SERVER_HEALTH_UP\x10\x01\x12\x1a\n" +
+	"\x16SERVER_HEALTH_DEGRADED\x10\x02\x12\x16\n" +
+	"\x12SERVER_HEALTH_DOWN\x10\x03*T\n" +
 	"\tProxyMode\x12\x1a\n" +
 	"\x16PROXY_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12PROXY_MODE_CLASSIC\x10\x01\x12\x13\n" +
@@ -2095,7 +7044,40 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\x10BedrockUIProfile\x12\"\n" +
 	"\x1eBEDROCK_UI_PROFILE_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aBEDROCK_UI_PROFILE_CLASSIC\x10\x01\x12\x1d\n" +
-	"\x19BEDROCK_UI_PROFILE_POCKET\x10\x022\xe3\t\n" +
+	"\x19BEDROCK_UI_PROFILE_POCKET\x10\x02*j\n" +
+	"\x0fPermissionValue\x12 \n" +
+	"\x1cPERMISSION_VALUE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PERMISSION_VALUE_TRUE\x10\x01\x12\x1a\n" +
+	"\x16PERMISSION_VALUE_FALSE\x10\x02*d\n" +
+	"\x0ePunishmentType\x12\x1f\n" +
+	"\x1bPUNISHMENT_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PUNISHMENT_TYPE_BAN\x10\x01\x12\x18\n" +
+	"\x14PUNISHMENT_TYPE_MUTE\x10\x02*\x8e\x02\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17EVENT_TYPE_PLAYER_LOGIN\x10\x01\x12 \n" +
+	"\x1cEVENT_TYPE_PLAYER_DISCONNECT\x10\x02\x12#\n" +
+	"\x1fEVENT_TYPE_PLAYER_SERVER_SWITCH\x10\x03\x12\x1c\n" +
+	"\x18EVENT_TYPE_PLAYER_KICKED\x10\x04\x12 \n" +
+	"\x1cEVENT_TYPE_SERVER_REGISTERED\x10\x05\x12\"\n" +
+	"\x1eEVENT_TYPE_SERVER_UNREGISTERED\x10\x06\x12\x1d\n" +
+	"\x19EVENT_TYPE_CONFIG_APPLIED\x10\a*\xe2\x01\n" +
+	"\fBossBarColor\x12\x1e\n" +
+	"\x1aBOSS_BAR_COLOR_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13BOSS_BAR_COLOR_PINK\x10\x01\x12\x17\n" +
+	"\x13BOSS_BAR_COLOR_BLUE\x10\x02\x12\x16\n" +
+	"\x12BOSS_BAR_COLOR_RED\x10\x03\x12\x18\n" +
+	"\x14BOSS_BAR_COLOR_GREEN\x10\x04\x12\x19\n" +
+	"\x15BOSS_BAR_COLOR_YELLOW\x10\x05\x12\x19\n" +
+	"\x15BOSS_BAR_COLOR_PURPLE\x10\x06\x12\x18\n" +
+	"\x14BOSS_BAR_COLOR_WHITE\x10\a*\xd4\x01\n" +
+	"\x0eBossBarOverlay\x12 \n" +
+	"\x1cBOSS_BAR_OVERLAY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BOSS_BAR_OVERLAY_PROGRESS\x10\x01\x12\x1e\n" +
+	"\x1aBOSS_BAR_OVERLAY_NOTCHED_6\x10\x02\x12\x1f\n" +
+	"\x1bBOSS_BAR_OVERLAY_NOTCHED_10\x10\x03\x12\x1f\n" +
+	"\x1bBOSS_BAR_OVERLAY_NOTCHED_12\x10\x04\x12\x1f\n" +
+	"\x1bBOSS_BAR_OVERLAY_NOTCHED_20\x10\x052\xeb \n" +
 	"\vGateService\x12T\n" +
 	"\tGetPlayer\x12\".minekube.gate.v1.GetPlayerRequest\x1a#.minekube.gate.v1.GetPlayerResponse\x12Z\n" +
 	"\vListPlayers\x12$.minekube.gate.v1.ListPlayersRequest\x1a%.minekube.gate.v1.ListPlayersResponse\x12Z\n" +
@@ -2109,7 +7091,38 @@ const file_minekube_gate_v1_gate_service_proto_rawDesc = "" +
 	"\tGetStatus\x12\".minekube.gate.v1.GetStatusRequest\x1a#.minekube.gate.v1.GetStatusResponse\x12T\n" +
 	"\tGetConfig\x12\".minekube.gate.v1.GetConfigRequest\x1a#.minekube.gate.v1.GetConfigResponse\x12c\n" +
 	"\x0eValidateConfig\x12'.minekube.gate.v1.ValidateConfigRequest\x1a(.minekube.gate.v1.ValidateConfigResponse\x12Z\n" +
-	"\vApplyConfig\x12$.minekube.gate.v1.ApplyConfigRequest\x1a%.minekube.gate.v1.ApplyConfigResponseB\xcd\x01\n" +
+	"\vApplyConfig\x12$.minekube.gate.v1.ApplyConfigRequest\x1a%.minekube.gate.v1.ApplyConfigResponse\x12c\n" +
+	"\x0eGetPermissions\x12'.minekube.gate.v1.GetPermissionsRequest\x1a(.minekube.gate.v1.GetPermissionsResponse\x12f\n" +
+	"\x0fCheckPermission\x12(.minekube.gate.v1.CheckPermissionRequest\x1a).minekube.gate.v1.CheckPermissionResponse\x12o\n" +
+	"\x12SetPermissionGroup\x12+.minekube.gate.v1.SetPermissionGroupRequest\x1a,.minekube.gate.v1.SetPermissionGroupResponse\x12x\n" +
+	"\x15DeletePermissionGroup\x12..minekube.gate.v1.DeletePermissionGroupRequest\x1a/.minekube.gate.v1.DeletePermissionGroupResponse\x12u\n" +
+	"\x14SetPlayerPermissions\x12-.minekube.gate.v1.SetPlayerPermissionsRequest\x1a..minekube.gate.v1.SetPlayerPermissionsResponse\x12W\n" +
+	"\n" +
+	"ListQueues\x12#.minekube.gate.v1.ListQueuesRequest\x1a$.minekube.gate.v1.ListQueuesResponse\x12`\n" +
+	"\rEnqueuePlayer\x12&.minekube.gate.v1.EnqueuePlayerRequest\x1a'.minekube.gate.v1.EnqueuePlayerResponse\x12`\n" +
+	"\rDequeuePlayer\x12&.minekube.gate.v1.DequeuePlayerRequest\x1a'.minekube.gate.v1.DequeuePlayerResponse\x12W\n" +
+	"\n" +
+	"ClearQueue\x12#.minekube.gate.v1.ClearQueueRequest\x1a$.minekube.gate.v1.ClearQueueResponse\x12f\n" +
+	"\x0fListPunishments\x12(.minekube.gate.v1.ListPunishmentsRequest\x1a).minekube.gate.v1.ListPunishmentsResponse\x12`\n" +
+	"\rAddPunishment\x12&.minekube.gate.v1.AddPunishmentRequest\x1a'.minekube.gate.v1.AddPunishmentResponse\x12i\n" +
+	"\x10RemovePunishment\x12).minekube.gate.v1.RemovePunishmentRequest\x1a*.minekube.gate.v1.RemovePunishmentResponse\x12i\n" +
+	"\x10ListChatChannels\x12).minekube.gate.v1.ListChatChannelsRequest\x1a*.minekube.gate.v1.ListChatChannelsResponse\x12{\n" +
+	"\x16SendChatChannelMessage\x12/.minekube.gate.v1.SendChatChannelMessageRequest\x1a0.minekube.gate.v1.SendChatChannelMessageResponse\x12c\n" +
+	"\x0eGetMaintenance\x12'.minekube.gate.v1.GetMaintenanceRequest\x1a(.minekube.gate.v1.GetMaintenanceResponse\x12c\n" +
+	"\x0eSetMaintenance\x12'.minekube.gate.v1.SetMaintenanceRequest\x1a(.minekube.gate.v1.SetMaintenanceResponse\x12`\n" +
+	"\rListWhitelist\x12&.minekube.gate.v1.ListWhitelistRequest\x1a'.minekube.gate.v1.ListWhitelistResponse\x12l\n" +
+	"\x11AddWhitelistEntry\x12*.minekube.gate.v1.AddWhitelistEntryRequest\x1a+.minekube.gate.v1.AddWhitelistEntryResponse\x12u\n" +
+	"\x14RemoveWhitelistEntry\x12-.minekube.gate.v1.RemoveWhitelistEntryRequest\x1a..minekube.gate.v1.RemoveWhitelistEntryResponse\x12\\\n" +
+	"\vWatchEvents\x12$.minekube.gate.v1.WatchEventsRequest\x1a%.minekube.gate.v1.WatchEventsResponse0\x01\x12Z\n" +
+	"\vSendMessage\x12$.minekube.gate.v1.SendMessageRequest\x1a%.minekube.gate.v1.SendMessageResponse\x12`\n" +
+	"\rSendActionBar\x12&.minekube.gate.v1.SendActionBarRequest\x1a'.minekube.gate.v1.SendActionBarResponse\x12T\n" +
+	"\tShowTitle\x12\".minekube.gate.v1.ShowTitleRequest\x1a#.minekube.gate.v1.ShowTitleResponse\x12T\n" +
+	"\tPlaySound\x12\".minekube.gate.v1.PlaySoundRequest\x1a#.minekube.gate.v1.PlaySoundResponse\x12Z\n" +
+	"\vShowBossBar\x12$.minekube.gate.v1.ShowBossBarRequest\x1a%.minekube.gate.v1.ShowBossBarResponse\x12Z\n" +
+	"\vHideBossBar\x12$.minekube.gate.v1.HideBossBarRequest\x1a%.minekube.gate.v1.HideBossBarResponse\x12i\n" +
+	"\x10SendResourcePack\x12).minekube.gate.v1.SendResourcePackRequest\x1a*.minekube.gate.v1.SendResourcePackResponse\x12c\n" +
+	"\x0eExecuteCommand\x12'.minekube.gate.v1.ExecuteCommandRequest\x1a(.minekube.gate.v1.ExecuteCommandResponse\x12c\n" +
+	"\x0eSuggestCommand\x12'.minekube.gate.v1.SuggestCommandRequest\x1a(.minekube.gate.v1.SuggestCommandResponseB\xcd\x01\n" +
 	"\x14com.minekube.gate.v1B\x10GateServiceProtoP\x01ZAgo.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1;gatev1\xa2\x02\x03MGX\xaa\x02\x10Minekube.Gate.V1\xca\x02\x10Minekube\\Gate\\V1\xe2\x02\x1cMinekube\\Gate\\V1\\GPBMetadata\xea\x02\x12Minekube::Gate::V1b\x06proto3"
 
 var (
@@ -2124,87 +7137,272 @@ func file_minekube_gate_v1_gate_service_proto_rawDescGZIP() []byte {
 	return file_minekube_gate_v1_gate_service_proto_rawDescData
 }
 
-var file_minekube_gate_v1_gate_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_minekube_gate_v1_gate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_minekube_gate_v1_gate_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_minekube_gate_v1_gate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_minekube_gate_v1_gate_service_proto_goTypes = []any{
-	(ProxyMode)(0),                   // 0: minekube.gate.v1.ProxyMode
-	(BedrockDeviceOS)(0),             // 1: minekube.gate.v1.BedrockDeviceOS
-	(BedrockInputMode)(0),            // 2: minekube.gate.v1.BedrockInputMode
-	(BedrockUIProfile)(0),            // 3: minekube.gate.v1.BedrockUIProfile
-	(*StoreCookieRequest)(nil),       // 4: minekube.gate.v1.StoreCookieRequest
-	(*StoreCookieResponse)(nil),      // 5: minekube.gate.v1.StoreCookieResponse
-	(*RequestCookieRequest)(nil),     // 6: minekube.gate.v1.RequestCookieRequest
-	(*RequestCookieResponse)(nil),    // 7: minekube.gate.v1.RequestCookieResponse
-	(*DisconnectPlayerRequest)(nil),  // 8: minekube.gate.v1.DisconnectPlayerRequest
-	(*DisconnectPlayerResponse)(nil), // 9: minekube.gate.v1.DisconnectPlayerResponse
-	(*ConnectPlayerRequest)(nil),     // 10: minekube.gate.v1.ConnectPlayerRequest
-	(*ConnectPlayerResponse)(nil),    // 11: minekube.gate.v1.ConnectPlayerResponse
-	(*RegisterServerRequest)(nil),    // 12: minekube.gate.v1.RegisterServerRequest
-	(*RegisterServerResponse)(nil),   // 13: minekube.gate.v1.RegisterServerResponse
-	(*UnregisterServerRequest)(nil),  // 14: minekube.gate.v1.UnregisterServerRequest
-	(*UnregisterServerResponse)(nil), // 15: minekube.gate.v1.UnregisterServerResponse
-	(*ListServersRequest)(nil),       // 16: minekube.gate.v1.ListServersRequest
-	(*ListServersResponse)(nil),      // 17: minekube.gate.v1.ListServersResponse
-	(*Server)(nil),                   // 18: minekube.gate.v1.Server
-	(*GetPlayerRequest)(nil),         // 19: minekube.gate.v1.GetPlayerRequest
-	(*GetPlayerResponse)(nil),        // 20: minekube.gate.v1.GetPlayerResponse
-	(*ListPlayersRequest)(nil),       // 21: minekube.gate.v1.ListPlayersRequest
-	(*ListPlayersResponse)(nil),      // 22: minekube.gate.v1.ListPlayersResponse
-	(*Player)(nil),                   // 23: minekube.gate.v1.Player
-	(*BedrockPlayerData)(nil),        // 24: minekube.gate.v1.BedrockPlayerData
-	(*GetStatusRequest)(nil),         // 25: minekube.gate.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),        // 26: minekube.gate.v1.GetStatusResponse
-	(*ClassicStats)(nil),             // 27: minekube.gate.v1.ClassicStats
-	(*LiteStats)(nil),                // 28: minekube.gate.v1.LiteStats
-	(*GetConfigRequest)(nil),         // 29: minekube.gate.v1.GetConfigRequest
-	(*GetConfigResponse)(nil),        // 30: minekube.gate.v1.GetConfigResponse
-	(*ValidateConfigRequest)(nil),    // 31: minekube.gate.v1.ValidateConfigRequest
-	(*ValidateConfigResponse)(nil),   // 32: minekube.gate.v1.ValidateConfigResponse
-	(*ApplyConfigRequest)(nil),       // 33: minekube.gate.v1.ApplyConfigRequest
-	(*ApplyConfigResponse)(nil),      // 34: minekube.gate.v1.ApplyConfigResponse
+	(ServerHealth)(0),                      // 0: minekube.gate.v1.ServerHealth
+	(ProxyMode)(0),                         // 1: minekube.gate.v1.ProxyMode
+	(BedrockDeviceOS)(0),                   // 2: minekube.gate.v1.BedrockDeviceOS
+	(BedrockInputMode)(0),                  // 3: minekube.gate.v1.BedrockInputMode
+	(BedrockUIProfile)(0),                  // 4: minekube.gate.v1.BedrockUIProfile
+	(PermissionValue)(0),                   // 5: minekube.gate.v1.PermissionValue
+	(PunishmentType)(0),                    // 6: minekube.gate.v1.PunishmentType
+	(EventType)(0),                         // 7: minekube.gate.v1.EventType
+	(BossBarColor)(0),                      // 8: minekube.gate.v1.BossBarColor
+	(BossBarOverlay)(0),                    // 9: minekube.gate.v1.BossBarOverlay
+	(*StoreCookieRequest)(nil),             // 10: minekube.gate.v1.StoreCookieRequest
+	(*StoreCookieResponse)(nil),            // 11: minekube.gate.v1.StoreCookieResponse
+	(*RequestCookieRequest)(nil),           // 12: minekube.gate.v1.RequestCookieRequest
+	(*RequestCookieResponse)(nil),          // 13: minekube.gate.v1.RequestCookieResponse
+	(*DisconnectPlayerRequest)(nil),        // 14: minekube.gate.v1.DisconnectPlayerRequest
+	(*DisconnectPlayerResponse)(nil),       // 15: minekube.gate.v1.DisconnectPlayerResponse
+	(*ConnectPlayerRequest)(nil),           // 16: minekube.gate.v1.ConnectPlayerRequest
+	(*ConnectPlayerResponse)(nil),          // 17: minekube.gate.v1.ConnectPlayerResponse
+	(*RegisterServerRequest)(nil),          // 18: minekube.gate.v1.RegisterServerRequest
+	(*RegisterServerResponse)(nil),         // 19: minekube.gate.v1.RegisterServerResponse
+	(*UnregisterServerRequest)(nil),        // 20: minekube.gate.v1.UnregisterServerRequest
+	(*UnregisterServerResponse)(nil),       // 21: minekube.gate.v1.UnregisterServerResponse
+	(*ListServersRequest)(nil),             // 22: minekube.gate.v1.ListServersRequest
+	(*ListServersResponse)(nil),            // 23: minekube.gate.v1.ListServersResponse
+	(*Server)(nil),                         // 24: minekube.gate.v1.Server
+	(*GetPlayerRequest)(nil),               // 25: minekube.gate.v1.GetPlayerRequest
+	(*GetPlayerResponse)(nil),              // 26: minekube.gate.v1.GetPlayerResponse
+	(*ListPlayersRequest)(nil),             // 27: minekube.gate.v1.ListPlayersRequest
+	(*ListPlayersResponse)(nil),            // 28: minekube.gate.v1.ListPlayersResponse
+	(*Player)(nil),                         // 29: minekube.gate.v1.Player
+	(*BedrockPlayerData)(nil),              // 30: minekube.gate.v1.BedrockPlayerData
+	(*GetStatusRequest)(nil),               // 31: minekube.gate.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),              // 32: minekube.gate.v1.GetStatusResponse
+	(*ClassicStats)(nil),                   // 33: minekube.gate.v1.ClassicStats
+	(*LiteStats)(nil),                      // 34: minekube.gate.v1.LiteStats
+	(*GetConfigRequest)(nil),               // 35: minekube.gate.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),              // 36: minekube.gate.v1.GetConfigResponse
+	(*ValidateConfigRequest)(nil),          // 37: minekube.gate.v1.ValidateConfigRequest
+	(*ValidateConfigResponse)(nil),         // 38: minekube.gate.v1.ValidateConfigResponse
+	(*ApplyConfigRequest)(nil),             // 39: minekube.gate.v1.ApplyConfigRequest
+	(*ApplyConfigResponse)(nil),            // 40: minekube.gate.v1.ApplyConfigResponse
+	(*PermissionGroup)(nil),                // 41: minekube.gate.v1.PermissionGroup
+	(*PlayerPermissions)(nil),              // 42: minekube.gate.v1.PlayerPermissions
+	(*GetPermissionsRequest)(nil),          // 43: minekube.gate.v1.GetPermissionsRequest
+	(*GetPermissionsResponse)(nil),         // 44: minekube.gate.v1.GetPermissionsResponse
+	(*CheckPermissionRequest)(nil),         // 45: minekube.gate.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),        // 46: minekube.gate.v1.CheckPermissionResponse
+	(*SetPermissionGroupRequest)(nil),      // 47: minekube.gate.v1.SetPermissionGroupRequest
+	(*SetPermissionGroupResponse)(nil),     // 48: minekube.gate.v1.SetPermissionGroupResponse
+	(*DeletePermissionGroupRequest)(nil),   // 49: minekube.gate.v1.DeletePermissionGroupRequest
+	(*DeletePermissionGroupResponse)(nil),  // 50: minekube.gate.v1.DeletePermissionGroupResponse
+	(*SetPlayerPermissionsRequest)(nil),    // 51: minekube.gate.v1.SetPlayerPermissionsRequest
+	(*SetPlayerPermissionsResponse)(nil),   // 52: minekube.gate.v1.SetPlayerPermissionsResponse
+	(*Queue)(nil),                          // 53: minekube.gate.v1.Queue
+	(*QueueEntry)(nil),                     // 54: minekube.gate.v1.QueueEntry
+	(*ListQueuesRequest)(nil),              // 55: minekube.gate.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),             // 56: minekube.gate.v1.ListQueuesResponse
+	(*EnqueuePlayerRequest)(nil),           // 57: minekube.gate.v1.EnqueuePlayerRequest
+	(*EnqueuePlayerResponse)(nil),          // 58: minekube.gate.v1.EnqueuePlayerResponse
+	(*DequeuePlayerRequest)(nil),           // 59: minekube.gate.v1.DequeuePlayerRequest
+	(*DequeuePlayerResponse)(nil),          // 60: minekube.gate.v1.DequeuePlayerResponse
+	(*ClearQueueRequest)(nil),              // 61: minekube.gate.v1.ClearQueueRequest
+	(*ClearQueueResponse)(nil),             // 62: minekube.gate.v1.ClearQueueResponse
+	(*Punishment)(nil),                     // 63: minekube.gate.v1.Punishment
+	(*ListPunishmentsRequest)(nil),         // 64: minekube.gate.v1.ListPunishmentsRequest
+	(*ListPunishmentsResponse)(nil),        // 65: minekube.gate.v1.ListPunishmentsResponse
+	(*AddPunishmentRequest)(nil),           // 66: minekube.gate.v1.AddPunishmentRequest
+	(*AddPunishmentResponse)(nil),          // 67: minekube.gate.v1.AddPunishmentResponse
+	(*RemovePunishmentRequest)(nil),        // 68: minekube.gate.v1.RemovePunishmentRequest
+	(*RemovePunishmentResponse)(nil),       // 69: minekube.gate.v1.RemovePunishmentResponse
+	(*ListChatChannelsRequest)(nil),        // 70: minekube.gate.v1.ListChatChannelsRequest
+	(*ListChatChannelsResponse)(nil),       // 71: minekube.gate.v1.ListChatChannelsResponse
+	(*ChatChannel)(nil),                    // 72: minekube.gate.v1.ChatChannel
+	(*SendChatChannelMessageRequest)(nil),  // 73: minekube.gate.v1.SendChatChannelMessageRequest
+	(*SendChatChannelMessageResponse)(nil), // 74: minekube.gate.v1.SendChatChannelMessageResponse
+	(*GetMaintenanceRequest)(nil),          // 75: minekube.gate.v1.GetMaintenanceRequest
+	(*GetMaintenanceResponse)(nil),         // 76: minekube.gate.v1.GetMaintenanceResponse
+	(*SetMaintenanceRequest)(nil),          // 77: minekube.gate.v1.SetMaintenanceRequest
+	(*SetMaintenanceResponse)(nil),         // 78: minekube.gate.v1.SetMaintenanceResponse
+	(*WhitelistEntry)(nil),                 // 79: minekube.gate.v1.WhitelistEntry
+	(*ListWhitelistRequest)(nil),           // 80: minekube.gate.v1.ListWhitelistRequest
+	(*ListWhitelistResponse)(nil),          // 81: minekube.gate.v1.ListWhitelistResponse
+	(*AddWhitelistEntryRequest)(nil),       // 82: minekube.gate.v1.AddWhitelistEntryRequest
+	(*AddWhitelistEntryResponse)(nil),      // 83: minekube.gate.v1.AddWhitelistEntryResponse
+	(*RemoveWhitelistEntryRequest)(nil),    // 84: minekube.gate.v1.RemoveWhitelistEntryRequest
+	(*RemoveWhitelistEntryResponse)(nil),   // 85: minekube.gate.v1.RemoveWhitelistEntryResponse
+	(*WatchEventsRequest)(nil),             // 86: minekube.gate.v1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),            // 87: minekube.gate.v1.WatchEventsResponse
+	(*PlayerLoginEvent)(nil),               // 88: minekube.gate.v1.PlayerLoginEvent
+	(*PlayerDisconnectEvent)(nil),          // 89: minekube.gate.v1.PlayerDisconnectEvent
+	(*PlayerServerSwitchEvent)(nil),        // 90: minekube.gate.v1.PlayerServerSwitchEvent
+	(*PlayerKickedEvent)(nil),              // 91: minekube.gate.v1.PlayerKickedEvent
+	(*ServerRegisteredEvent)(nil),          // 92: minekube.gate.v1.ServerRegisteredEvent
+	(*ServerUnregisteredEvent)(nil),        // 93: minekube.gate.v1.ServerUnregisteredEvent
+	(*ConfigAppliedEvent)(nil),             // 94: minekube.gate.v1.ConfigAppliedEvent
+	(*Audience)(nil),                       // 95: minekube.gate.v1.Audience
+	(*SendMessageRequest)(nil),             // 96: minekube.gate.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),            // 97: minekube.gate.v1.SendMessageResponse
+	(*SendActionBarRequest)(nil),           // 98: minekube.gate.v1.SendActionBarRequest
+	(*SendActionBarResponse)(nil),          // 99: minekube.gate.v1.SendActionBarResponse
+	(*ShowTitleRequest)(nil),               // 100: minekube.gate.v1.ShowTitleRequest
+	(*ShowTitleResponse)(nil),              // 101: minekube.gate.v1.ShowTitleResponse
+	(*PlaySoundRequest)(nil),               // 102: minekube.gate.v1.PlaySoundRequest
+	(*PlaySoundResponse)(nil),              // 103: minekube.gate.v1.PlaySoundResponse
+	(*ShowBossBarRequest)(nil),             // 104: minekube.gate.v1.ShowBossBarRequest
+	(*ShowBossBarResponse)(nil),            // 105: minekube.gate.v1.ShowBossBarResponse
+	(*HideBossBarRequest)(nil),             // 106: minekube.gate.v1.HideBossBarRequest
+	(*HideBossBarResponse)(nil),            // 107: minekube.gate.v1.HideBossBarResponse
+	(*SendResourcePackRequest)(nil),        // 108: minekube.gate.v1.SendResourcePackRequest
+	(*SendResourcePackResponse)(nil),       // 109: minekube.gate.v1.SendResourcePackResponse
+	(*CommandSource)(nil),                  // 110: minekube.gate.v1.CommandSource
+	(*CommandMessage)(nil),                 // 111: minekube.gate.v1.CommandMessage
+	(*ExecuteCommandRequest)(nil),          // 112: minekube.gate.v1.ExecuteCommandRequest
+	(*ExecuteCommandResponse)(nil),         // 113: minekube.gate.v1.ExecuteCommandResponse
+	(*SuggestCommandRequest)(nil),          // 114: minekube.gate.v1.SuggestCommandRequest
+	(*SuggestCommandResponse)(nil),         // 115: minekube.gate.v1.SuggestCommandResponse
 }
 var file_minekube_gate_v1_gate_service_proto_depIdxs = []int32{
-	18, // 0: minekube.gate.v1.ListServersResponse.servers:type_name -> minekube.gate.v1.Server
-	23, // 1: minekube.gate.v1.GetPlayerResponse.player:type_name -> minekube.gate.v1.Player
-	23, // 2: minekube.gate.v1.ListPlayersResponse.players:type_name -> minekube.gate.v1.Player
-	24, // 3: minekube.gate.v1.Player.bedrock:type_name -> minekube.gate.v1.BedrockPlayerData
-	1,  // 4: minekube.gate.v1.BedrockPlayerData.device_os:type_name -> minekube.gate.v1.BedrockDeviceOS
-	3,  // 5: minekube.gate.v1.BedrockPlayerData.ui_profile:type_name -> minekube.gate.v1.BedrockUIProfile
-	2,  // 6: minekube.gate.v1.BedrockPlayerData.input_mode:type_name -> minekube.gate.v1.BedrockInputMode
-	0,  // 7: minekube.gate.v1.GetStatusResponse.mode:type_name -> minekube.gate.v1.ProxyMode
-	27, // 8: minekube.gate.v1.GetStatusResponse.classic:type_name -> minekube.gate.v1.ClassicStats
-	28, // 9: minekube.gate.v1.GetStatusResponse.lite:type_name -> minekube.gate.v1.LiteStats
-	19, // 10: minekube.gate.v1.GateService.GetPlayer:input_type -> minekube.gate.v1.GetPlayerRequest
-	21, // 11: minekube.gate.v1.GateService.ListPlayers:input_type -> minekube.gate.v1.ListPlayersRequest
-	16, // 12: minekube.gate.v1.GateService.ListServers:input_type -> minekube.gate.v1.ListServersRequest
-	12, // 13: minekube.gate.v1.GateService.RegisterServer:input_type -> minekube.gate.v1.RegisterServerRequest
-	14, // 14: minekube.gate.v1.GateService.UnregisterServer:input_type -> minekube.gate.v1.UnregisterServerRequest
-	10, // 15: minekube.gate.v1.GateService.ConnectPlayer:input_type -> minekube.gate.v1.ConnectPlayerRequest
-	8,  // 16: minekube.gate.v1.GateService.DisconnectPlayer:input_type -> minekube.gate.v1.DisconnectPlayerRequest
-	4,  // 17: minekube.gate.v1.GateService.StoreCookie:input_type -> minekube.gate.v1.StoreCookieRequest
-	6,  // 18: minekube.gate.v1.GateService.RequestCookie:input_type -> minekube.gate.v1.RequestCookieRequest
-	25, // 19: minekube.gate.v1.GateService.GetStatus:input_type -> minekube.gate.v1.GetStatusRequest
-	29, // 20: minekube.gate.v1.GateService.GetConfig:input_type -> minekube.gate.v1.GetConfigRequest
-	31, // 21: minekube.gate.v1.GateService.ValidateConfig:input_type -> minekube.gate.v1.ValidateConfigRequest
-	33, // 22: minekube.gate.v1.GateService.ApplyConfig:input_type -> minekube.gate.v1.ApplyConfigRequest
-	20, // 23: minekube.gate.v1.GateService.GetPlayer:output_type -> minekube.gate.v1.GetPlayerResponse
-	22, // 24: minekube.gate.v1.GateService.ListPlayers:output_type -> minekube.gate.v1.ListPlayersResponse
-	17, // 25: minekube.gate.v1.GateService.ListServers:output_type -> minekube.gate.v1.ListServersResponse
-	13, // 26: minekube.gate.v1.GateService.RegisterServer:output_type -> minekube.gate.v1.RegisterServerResponse
-	15, // 27: minekube.gate.v1.GateService.UnregisterServer:output_type -> minekube.gate.v1.UnregisterServerResponse
-	11, // 28: minekube.gate.v1.GateService.ConnectPlayer:output_type -> minekube.gate.v1.ConnectPlayerResponse
-	9,  // 29: minekube.gate.v1.GateService.DisconnectPlayer:output_type -> minekube.gate.v1.DisconnectPlayerResponse
-	5,  // 30: minekube.gate.v1.GateService.StoreCookie:output_type -> minekube.gate.v1.StoreCookieResponse
-	7,  // 31: minekube.gate.v1.GateService.RequestCookie:output_type -> minekube.gate.v1.RequestCookieResponse
-	26, // 32: minekube.gate.v1.GateService.GetStatus:output_type -> minekube.gate.v1.GetStatusResponse
-	30, // 33: minekube.gate.v1.GateService.GetConfig:output_type -> minekube.gate.v1.GetConfigResponse
-	32, // 34: minekube.gate.v1.GateService.ValidateConfig:output_type -> minekube.gate.v1.ValidateConfigResponse
-	34, // 35: minekube.gate.v1.GateService.ApplyConfig:output_type -> minekube.gate.v1.ApplyConfigResponse
-	23, // [23:36] is the sub-list for method output_type
-	10, // [10:23] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	24,  // 0: minekube.gate.v1.ListServersResponse.servers:type_name -> minekube.gate.v1.Server
+	0,   // 1: minekube.gate.v1.Server.health:type_name -> minekube.gate.v1.ServerHealth
+	29,  // 2: minekube.gate.v1.GetPlayerResponse.player:type_name -> minekube.gate.v1.Player
+	29,  // 3: minekube.gate.v1.ListPlayersResponse.players:type_name -> minekube.gate.v1.Player
+	30,  // 4: minekube.gate.v1.Player.bedrock:type_name -> minekube.gate.v1.BedrockPlayerData
+	2,   // 5: minekube.gate.v1.BedrockPlayerData.device_os:type_name -> minekube.gate.v1.BedrockDeviceOS
+	4,   // 6: minekube.gate.v1.BedrockPlayerData.ui_profile:type_name -> minekube.gate.v1.BedrockUIProfile
+	3,   // 7: minekube.gate.v1.BedrockPlayerData.input_mode:type_name -> minekube.gate.v1.BedrockInputMode
+	1,   // 8: minekube.gate.v1.GetStatusResponse.mode:type_name -> minekube.gate.v1.ProxyMode
+	33,  // 9: minekube.gate.v1.GetStatusResponse.classic:type_name -> minekube.gate.v1.ClassicStats
+	34,  // 10: minekube.gate.v1.GetStatusResponse.lite:type_name -> minekube.gate.v1.LiteStats
+	41,  // 11: minekube.gate.v1.GetPermissionsResponse.groups:type_name -> minekube.gate.v1.PermissionGroup
+	42,  // 12: minekube.gate.v1.GetPermissionsResponse.players:type_name -> minekube.gate.v1.PlayerPermissions
+	5,   // 13: minekube.gate.v1.CheckPermissionResponse.value:type_name -> minekube.gate.v1.PermissionValue
+	41,  // 14: minekube.gate.v1.SetPermissionGroupRequest.group:type_name -> minekube.gate.v1.PermissionGroup
+	42,  // 15: minekube.gate.v1.SetPlayerPermissionsResponse.player:type_name -> minekube.gate.v1.PlayerPermissions
+	54,  // 16: minekube.gate.v1.Queue.entries:type_name -> minekube.gate.v1.QueueEntry
+	53,  // 17: minekube.gate.v1.ListQueuesResponse.queues:type_name -> minekube.gate.v1.Queue
+	54,  // 18: minekube.gate.v1.EnqueuePlayerResponse.entry:type_name -> minekube.gate.v1.QueueEntry
+	6,   // 19: minekube.gate.v1.Punishment.type:type_name -> minekube.gate.v1.PunishmentType
+	6,   // 20: minekube.gate.v1.ListPunishmentsRequest.type:type_name -> minekube.gate.v1.PunishmentType
+	63,  // 21: minekube.gate.v1.ListPunishmentsResponse.punishments:type_name -> minekube.gate.v1.Punishment
+	6,   // 22: minekube.gate.v1.AddPunishmentRequest.type:type_name -> minekube.gate.v1.PunishmentType
+	63,  // 23: minekube.gate.v1.AddPunishmentResponse.punishment:type_name -> minekube.gate.v1.Punishment
+	6,   // 24: minekube.gate.v1.RemovePunishmentRequest.type:type_name -> minekube.gate.v1.PunishmentType
+	63,  // 25: minekube.gate.v1.RemovePunishmentResponse.punishments:type_name -> minekube.gate.v1.Punishment
+	72,  // 26: minekube.gate.v1.ListChatChannelsResponse.channels:type_name -> minekube.gate.v1.ChatChannel
+	29,  // 27: minekube.gate.v1.ChatChannel.members:type_name -> minekube.gate.v1.Player
+	79,  // 28: minekube.gate.v1.ListWhitelistResponse.entries:type_name -> minekube.gate.v1.WhitelistEntry
+	79,  // 29: minekube.gate.v1.AddWhitelistEntryResponse.entry:type_name -> minekube.gate.v1.WhitelistEntry
+	79,  // 30: minekube.gate.v1.RemoveWhitelistEntryResponse.entries:type_name -> minekube.gate.v1.WhitelistEntry
+	7,   // 31: minekube.gate.v1.WatchEventsRequest.types:type_name -> minekube.gate.v1.EventType
+	7,   // 32: minekube.gate.v1.WatchEventsResponse.type:type_name -> minekube.gate.v1.EventType
+	88,  // 33: minekube.gate.v1.WatchEventsResponse.player_login:type_name -> minekube.gate.v1.PlayerLoginEvent
+	89,  // 34: minekube.gate.v1.WatchEventsResponse.player_disconnect:type_name -> minekube.gate.v1.PlayerDisconnectEvent
+	90,  // 35: minekube.gate.v1.WatchEventsResponse.player_server_switch:type_name -> minekube.gate.v1.PlayerServerSwitchEvent
+	91,  // 36: minekube.gate.v1.WatchEventsResponse.player_kicked:type_name -> minekube.gate.v1.PlayerKickedEvent
+	92,  // 37: minekube.gate.v1.WatchEventsResponse.server_registered:type_name -> minekube.gate.v1.ServerRegisteredEvent
+	93,  // 38: minekube.gate.v1.WatchEventsResponse.server_unregistered:type_name -> minekube.gate.v1.ServerUnregisteredEvent
+	94,  // 39: minekube.gate.v1.WatchEventsResponse.config_applied:type_name -> minekube.gate.v1.ConfigAppliedEvent
+	29,  // 40: minekube.gate.v1.PlayerLoginEvent.player:type_name -> minekube.gate.v1.Player
+	29,  // 41: minekube.gate.v1.PlayerDisconnectEvent.player:type_name -> minekube.gate.v1.Player
+	29,  // 42: minekube.gate.v1.PlayerServerSwitchEvent.player:type_name -> minekube.gate.v1.Player
+	29,  // 43: minekube.gate.v1.PlayerKickedEvent.player:type_name -> minekube.gate.v1.Player
+	24,  // 44: minekube.gate.v1.ServerRegisteredEvent.server:type_name -> minekube.gate.v1.Server
+	95,  // 45: minekube.gate.v1.SendMessageRequest.audience:type_name -> minekube.gate.v1.Audience
+	95,  // 46: minekube.gate.v1.SendActionBarRequest.audience:type_name -> minekube.gate.v1.Audience
+	95,  // 47: minekube.gate.v1.ShowTitleRequest.audience:type_name -> minekube.gate.v1.Audience
+	95,  // 48: minekube.gate.v1.PlaySoundRequest.audience:type_name -> minekube.gate.v1.Audience
+	95,  // 49: minekube.gate.v1.ShowBossBarRequest.audience:type_name -> minekube.gate.v1.Audience
+	8,   // 50: minekube.gate.v1.ShowBossBarRequest.color:type_name -> minekube.gate.v1.BossBarColor
+	9,   // 51: minekube.gate.v1.ShowBossBarRequest.overlay:type_name -> minekube.gate.v1.BossBarOverlay
+	95,  // 52: minekube.gate.v1.SendResourcePackRequest.audience:type_name -> minekube.gate.v1.Audience
+	110, // 53: minekube.gate.v1.ExecuteCommandRequest.source:type_name -> minekube.gate.v1.CommandSource
+	111, // 54: minekube.gate.v1.ExecuteCommandResponse.messages:type_name -> minekube.gate.v1.CommandMessage
+	110, // 55: minekube.gate.v1.SuggestCommandRequest.source:type_name -> minekube.gate.v1.CommandSource
+	25,  // 56: minekube.gate.v1.GateService.GetPlayer:input_type -> minekube.gate.v1.GetPlayerRequest
+	27,  // 57: minekube.gate.v1.GateService.ListPlayers:input_type -> minekube.gate.v1.ListPlayersRequest
+	22,  // 58: minekube.gate.v1.GateService.ListServers:input_type -> minekube.gate.v1.ListServersRequest
+	18,  // 59: minekube.gate.v1.GateService.RegisterServer:input_type -> minekube.gate.v1.RegisterServerRequest
+	20,  // 60: minekube.gate.v1.GateService.UnregisterServer:input_type -> minekube.gate.v1.UnregisterServerRequest
+	16,  // 61: minekube.gate.v1.GateService.ConnectPlayer:input_type -> minekube.gate.v1.ConnectPlayerRequest
+	14,  // 62: minekube.gate.v1.GateService.DisconnectPlayer:input_type -> minekube.gate.v1.DisconnectPlayerRequest
+	10,  // 63: minekube.gate.v1.GateService.StoreCookie:input_type -> minekube.gate.v1.StoreCookieRequest
+	12,  // 64: minekube.gate.v1.GateService.RequestCookie:input_type -> minekube.gate.v1.RequestCookieRequest
+	31,  // 65: minekube.gate.v1.GateService.GetStatus:input_type -> minekube.gate.v1.GetStatusRequest
+	35,  // 66: minekube.gate.v1.GateService.GetConfig:input_type -> minekube.gate.v1.GetConfigRequest
+	37,  // 67: minekube.gate.v1.GateService.ValidateConfig:input_type -> minekube.gate.v1.ValidateConfigRequest
+	39,  // 68: minekube.gate.v1.GateService.ApplyConfig:input_type -> minekube.gate.v1.ApplyConfigRequest
+	43,  // 69: minekube.gate.v1.GateService.GetPermissions:input_type -> minekube.gate.v1.GetPermissionsRequest
+	45,  // 70: minekube.gate.v1.GateService.CheckPermission:input_type -> minekube.gate.v1.CheckPermissionRequest
+	47,  // 71: minekube.gate.v1.GateService.SetPermissionGroup:input_type -> minekube.gate.v1.SetPermissionGroupRequest
+	49,  // 72: minekube.gate.v1.GateService.DeletePermissionGroup:input_type -> minekube.gate.v1.DeletePermissionGroupRequest
+	51,  // 73: minekube.gate.v1.GateService.SetPlayerPermissions:input_type -> minekube.gate.v1.SetPlayerPermissionsRequest
+	55,  // 74: minekube.gate.v1.GateService.ListQueues:input_type -> minekube.gate.v1.ListQueuesRequest
+	57,  // 75: minekube.gate.v1.GateService.EnqueuePlayer:input_type -> minekube.gate.v1.EnqueuePlayerRequest
+	59,  // 76: minekube.gate.v1.GateService.DequeuePlayer:input_type -> minekube.gate.v1.DequeuePlayerRequest
+	61,  // 77: minekube.gate.v1.GateService.ClearQueue:input_type -> minekube.gate.v1.ClearQueueRequest
+	64,  // 78: minekube.gate.v1.GateService.ListPunishments:input_type -> minekube.gate.v1.ListPunishmentsRequest
+	66,  // 79: minekube.gate.v1.GateService.AddPunishment:input_type -> minekube.gate.v1.AddPunishmentRequest
+	68,  // 80: minekube.gate.v1.GateService.RemovePunishment:input_type -> minekube.gate.v1.RemovePunishmentRequest
+	70,  // 81: minekube.gate.v1.GateService.ListChatChannels:input_type -> minekube.gate.v1.ListChatChannelsRequest
+	73,  // 82: minekube.gate.v1.GateService.SendChatChannelMessage:input_type -> minekube.gate.v1.SendChatChannelMessageRequest
+	75,  // 83: minekube.gate.v1.GateService.GetMaintenance:input_type -> minekube.gate.v1.GetMaintenanceRequest
+	77,  // 84: minekube.gate.v1.GateService.SetMaintenance:input_type -> minekube.gate.v1.SetMaintenanceRequest
+	80,  // 85: minekube.gate.v1.GateService.ListWhitelist:input_type -> minekube.gate.v1.ListWhitelistRequest
+	82,  // 86: minekube.gate.v1.GateService.AddWhitelistEntry:input_type -> minekube.gate.v1.AddWhitelistEntryRequest
+	84,  // 87: minekube.gate.v1.GateService.RemoveWhitelistEntry:input_type -> minekube.gate.v1.RemoveWhitelistEntryRequest
+	86,  // 88: minekube.gate.v1.GateService.WatchEvents:input_type -> minekube.gate.v1.WatchEventsRequest
+	96,  // 89: minekube.gate.v1.GateService.SendMessage:input_type -> minekube.gate.v1.SendMessageRequest
+	98,  // 90: minekube.gate.v1.GateService.SendActionBar:input_type -> minekube.gate.v1.SendActionBarRequest
+	100, // 91: minekube.gate.v1.GateService.ShowTitle:input_type -> minekube.gate.v1.ShowTitleRequest
+	102, // 92: minekube.gate.v1.GateService.PlaySound:input_type -> minekube.gate.v1.PlaySoundRequest
+	104, // 93: minekube.gate.v1.GateService.ShowBossBar:input_type -> minekube.gate.v1.ShowBossBarRequest
+	106, // 94: minekube.gate.v1.GateService.HideBossBar:input_type -> minekube.gate.v1.HideBossBarRequest
+	108, // 95: minekube.gate.v1.GateService.SendResourcePack:input_type -> minekube.gate.v1.SendResourcePackRequest
+	112, // 96: minekube.gate.v1.GateService.ExecuteCommand:input_type -> minekube.gate.v1.ExecuteCommandRequest
+	114, // 97: minekube.gate.v1.GateService.SuggestCommand:input_type -> minekube.gate.v1.SuggestCommandRequest
+	26,  // 98: minekube.gate.v1.GateService.GetPlayer:output_type -> minekube.gate.v1.GetPlayerResponse
+	28,  // 99: minekube.gate.v1.GateService.ListPlayers:output_type -> minekube.gate.v1.ListPlayersResponse
+	23,  // 100: minekube.gate.v1.GateService.ListServers:output_type -> minekube.gate.v1.ListServersResponse
+	19,  // 101: minekube.gate.v1.GateService.RegisterServer:output_type -> minekube.gate.v1.RegisterServerResponse
+	21,  // 102: minekube.gate.v1.GateService.UnregisterServer:output_type -> minekube.gate.v1.UnregisterServerResponse
+	17,  // 103: minekube.gate.v1.GateService.ConnectPlayer:output_type -> minekube.gate.v1.ConnectPlayerResponse
+	15,  // 104: minekube.gate.v1.GateService.DisconnectPlayer:output_type -> minekube.gate.v1.DisconnectPlayerResponse
+	11,  // 105: minekube.gate.v1.GateService.StoreCookie:output_type -> minekube.gate.v1.StoreCookieResponse
+	13,  // 106: minekube.gate.v1.GateService.RequestCookie:output_type -> minekube.gate.v1.RequestCookieResponse
+	32,  // 107: minekube.gate.v1.GateService.GetStatus:output_type -> minekube.gate.v1.GetStatusResponse
+	36,  // 108: minekube.gate.v1.GateService.GetConfig:output_type -> minekube.gate.v1.GetConfigResponse
+	38,  // 109: minekube.gate.v1.GateService.ValidateConfig:output_type -> minekube.gate.v1.ValidateConfigResponse
+	40,  // 110: minekube.gate.v1.GateService.ApplyConfig:output_type -> minekube.gate.v1.ApplyConfigResponse
+	44,  // 111: minekube.gate.v1.GateService.GetPermissions:output_type -> minekube.gate.v1.GetPermissionsResponse
+	46,  // 112: minekube.gate.v1.GateService.CheckPermission:output_type -> minekube.gate.v1.CheckPermissionResponse
+	48,  // 113: minekube.gate.v1.GateService.SetPermissionGroup:output_type -> minekube.gate.v1.SetPermissionGroupResponse
+	50,  // 114: minekube.gate.v1.GateService.DeletePermissionGroup:output_type -> minekube.gate.v1.DeletePermissionGroupResponse
+	52,  // 115: minekube.gate.v1.GateService.SetPlayerPermissions:output_type -> minekube.gate.v1.SetPlayerPermissionsResponse
+	56,  // 116: minekube.gate.v1.GateService.ListQueues:output_type -> minekube.gate.v1.ListQueuesResponse
+	58,  // 117: minekube.gate.v1.GateService.EnqueuePlayer:output_type -> minekube.gate.v1.EnqueuePlayerResponse
+	60,  // 118: minekube.gate.v1.GateService.DequeuePlayer:output_type -> minekube.gate.v1.DequeuePlayerResponse
+	62,  // 119: minekube.gate.v1.GateService.ClearQueue:output_type -> minekube.gate.v1.ClearQueueResponse
+	65,  // 120: minekube.gate.v1.GateService.ListPunishments:output_type -> minekube.gate.v1.ListPunishmentsResponse
+	67,  // 121: minekube.gate.v1.GateService.AddPunishment:output_type -> minekube.gate.v1.AddPunishmentResponse
+	69,  // 122: minekube.gate.v1.GateService.RemovePunishment:output_type -> minekube.gate.v1.RemovePunishmentResponse
+	71,  // 123: minekube.gate.v1.GateService.ListChatChannels:output_type -> minekube.gate.v1.ListChatChannelsResponse
+	74,  // 124: minekube.gate.v1.GateService.SendChatChannelMessage:output_type -> minekube.gate.v1.SendChatChannelMessageResponse
+	76,  // 125: minekube.gate.v1.GateService.GetMaintenance:output_type -> minekube.gate.v1.GetMaintenanceResponse
+	78,  // 126: minekube.gate.v1.GateService.SetMaintenance:output_type -> minekube.gate.v1.SetMaintenanceResponse
+	81,  // 127: minekube.gate.v1.GateService.ListWhitelist:output_type -> minekube.gate.v1.ListWhitelistResponse
+	83,  // 128: minekube.gate.v1.GateService.AddWhitelistEntry:output_type -> minekube.gate.v1.AddWhitelistEntryResponse
+	85,  // 129: minekube.gate.v1.GateService.RemoveWhitelistEntry:output_type -> minekube.gate.v1.RemoveWhitelistEntryResponse
+	87,  // 130: minekube.gate.v1.GateService.WatchEvents:output_type -> minekube.gate.v1.WatchEventsResponse
+	97,  // 131: minekube.gate.v1.GateService.SendMessage:output_type -> minekube.gate.v1.SendMessageResponse
+	99,  // 132: minekube.gate.v1.GateService.SendActionBar:output_type -> minekube.gate.v1.SendActionBarResponse
+	101, // 133: minekube.gate.v1.GateService.ShowTitle:output_type -> minekube.gate.v1.ShowTitleResponse
+	103, // 134: minekube.gate.v1.GateService.PlaySound:output_type -> minekube.gate.v1.PlaySoundResponse
+	105, // 135: minekube.gate.v1.GateService.ShowBossBar:output_type -> minekube.gate.v1.ShowBossBarResponse
+	107, // 136: minekube.gate.v1.GateService.HideBossBar:output_type -> minekube.gate.v1.HideBossBarResponse
+	109, // 137: minekube.gate.v1.GateService.SendResourcePack:output_type -> minekube.gate.v1.SendResourcePackResponse
+	113, // 138: minekube.gate.v1.GateService.ExecuteCommand:output_type -> minekube.gate.v1.ExecuteCommandResponse
+	115, // 139: minekube.gate.v1.GateService.SuggestCommand:output_type -> minekube.gate.v1.SuggestCommandResponse
+	98,  // [98:140] is the sub-list for method output_type
+	56,  // [56:98] is the sub-list for method input_type
+	56,  // [56:56] is the sub-list for extension type_name
+	56,  // [56:56] is the sub-list for extension extendee
+	0,   // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_minekube_gate_v1_gate_service_proto_init() }
@@ -2212,6 +7410,7 @@ func file_minekube_gate_v1_gate_service_proto_init() {
 	if File_minekube_gate_v1_gate_service_proto != nil {
 		return
 	}
+	file_minekube_gate_v1_gate_service_proto_msgTypes[17].OneofWrappers = []any{}
 	file_minekube_gate_v1_gate_service_proto_msgTypes[22].OneofWrappers = []any{
 		(*GetStatusResponse_Classic)(nil),
 		(*GetStatusResponse_Lite)(nil),
@@ -2220,13 +7419,24 @@ func file_minekube_gate_v1_gate_service_proto_init() {
 		(*ApplyConfigRequest_Config)(nil),
 		(*ApplyConfigRequest_MergePatch)(nil),
 	}
+	file_minekube_gate_v1_gate_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_minekube_gate_v1_gate_service_proto_msgTypes[77].OneofWrappers = []any{
+		(*WatchEventsResponse_PlayerLogin)(nil),
+		(*WatchEventsResponse_PlayerDisconnect)(nil),
+		(*WatchEventsResponse_PlayerServerSwitch)(nil),
+		(*WatchEventsResponse_PlayerKicked)(nil),
+		(*WatchEventsResponse_ServerRegistered)(nil),
+		(*WatchEventsResponse_ServerUnregistered)(nil),
+		(*WatchEventsResponse_ConfigApplied)(nil),
+	}
+	file_minekube_gate_v1_gate_service_proto_msgTypes[92].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_minekube_gate_v1_gate_service_proto_rawDesc), len(file_minekube_gate_v1_gate_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   31,
+			NumEnums:      10,
+			NumMessages:   106,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -65,6 +65,85 @@ const (
 	GateServiceValidateConfigProcedure = "/minekube.gate.v1.GateService/ValidateConfig"
 	// GateServiceApplyConfigProcedure is the fully-qualified name of the GateService's ApplyConfig RPC.
 	GateServiceApplyConfigProcedure = "/minekube.gate.v1.GateService/ApplyConfig"
+	// GateServiceGetPermissionsProcedure is the fully-qualified name of the GateService's
+	// GetPermissions RPC.
+	GateServiceGetPermissionsProcedure = "/minekube.gate.v1.GateService/GetPermissions"
+	// GateServiceCheckPermissionProcedure is the fully-qualified name of the GateService's
+	// CheckPermission RPC.
+	GateServiceCheckPermissionProcedure = "/minekube.gate.v1.GateService/CheckPermission"
+	// GateServiceSetPermissionGroupProcedure is the fully-qualified name of the GateService's
+	// SetPermissionGroup RPC.
+	GateServiceSetPermissionGroupProcedure = "/minekube.gate.v1.GateService/SetPermissionGroup"
+	// GateServiceDeletePermissionGroupProcedure is the fully-qualified name of the GateService's
+	// DeletePermissionGroup RPC.
+	GateServiceDeletePermissionGroupProcedure = "/minekube.gate.v1.GateService/DeletePermissionGroup"
+	// GateServiceSetPlayerPermissionsProcedure is the fully-qualified name of the GateService's
+	// SetPlayerPermissions RPC.
+	GateServiceSetPlayerPermissionsProcedure = "/minekube.gate.v1.GateService/SetPlayerPermissions"
+	// GateServiceListQueuesProcedure is the fully-qualified name of the GateService's ListQueues RPC.
+	GateServiceListQueuesProcedure = "/minekube.gate.v1.GateService/ListQueues"
+	// GateServiceEnqueuePlayerProcedure is the fully-qualified name of the GateService's EnqueuePlayer
+	// RPC.
+	GateServiceEnqueuePlayerProcedure = "/minekube.gate.v1.GateService/EnqueuePlayer"
+	// GateServiceDequeuePlayerProcedure is the fully-qualified name of the GateService's DequeuePlayer
+	// RPC.
+	GateServiceDequeuePlayerProcedure = "/minekube.gate.v1.GateService/DequeuePlayer"
+	// GateServiceClearQueueProcedure is the fully-qualified name of the GateService's ClearQueue RPC.
+	GateServiceClearQueueProcedure = "/minekube.gate.v1.GateService/ClearQueue"
+	// GateServiceListPunishmentsProcedure is the fully-qualified name of the GateService's
+	// ListPunishments RPC.
+	GateServiceListPunishmentsProcedure = "/minekube.gate.v1.GateService/ListPunishments"
+	// GateServiceAddPunishmentProcedure is the fully-qualified name of the GateService's AddPunishment
+	// RPC.
+	GateServiceAddPunishmentProcedure = "/minekube.gate.v1.GateService/AddPunishment"
+	// GateServiceRemovePunishmentProcedure is the fully-qualified name of the GateService's
+	// RemovePunishment RPC.
+	GateServiceRemovePunishmentProcedure = "/minekube.gate.v1.GateService/RemovePunishment"
+	// GateServiceListChatChannelsProcedure is the fully-qualified name of the GateService's
+	// ListChatChannels RPC.
+	GateServiceListChatChannelsProcedure = "/minekube.gate.v1.GateService/ListChatChannels"
+	// GateServiceSendChatChannelMessageProcedure is the fully-qualified name of the GateService's
+	// SendChatChannelMessage RPC.
+	GateServiceSendChatChannelMessageProcedure = "/minekube.gate.v1.GateService/SendChatChannelMessage"
+	// GateServiceGetMaintenanceProcedure is the fully-qualified name of the GateService's
+	// GetMaintenance RPC.
+	GateServiceGetMaintenanceProcedure = "/minekube.gate.v1.GateService/GetMaintenance"
+	// GateServiceSetMaintenanceProcedure is the fully-qualified name of the GateService's
+	// SetMaintenance RPC.
+	GateServiceSetMaintenanceProcedure = "/minekube.gate.v1.GateService/SetMaintenance"
+	// GateServiceListWhitelistProcedure is the fully-qualified name of the GateService's ListWhitelist
+	// RPC.
+	GateServiceListWhitelistProcedure = "/minekube.gate.v1.GateService/ListWhitelist"
+	// GateServiceAddWhitelistEntryProcedure is the fully-qualified name of the GateService's
+	// AddWhitelistEntry RPC.
+	GateServiceAddWhitelistEntryProcedure = "/minekube.gate.v1.GateService/AddWhitelistEntry"
+	// GateServiceRemoveWhitelistEntryProcedure is the fully-qualified name of the GateService's
+	// RemoveWhitelistEntry RPC.
+	GateServiceRemoveWhitelistEntryProcedure = "/minekube.gate.v1.GateService/RemoveWhitelistEntry"
+	// GateServiceWatchEventsProcedure is the fully-qualified name of the GateService's WatchEvents RPC.
+	GateServiceWatchEventsProcedure = "/minekube.gate.v1.GateService/WatchEvents"
+	// GateServiceSendMessageProcedure is the fully-qualified name of the GateService's SendMessage RPC.
+	GateServiceSendMessageProcedure = "/minekube.gate.v1.GateService/SendMessage"
+	// GateServiceSendActionBarProcedure is the fully-qualified name of the GateService's SendActionBar
+	// RPC.
+	GateServiceSendActionBarProcedure = "/minekube.gate.v1.GateService/SendActionBar"
+	// GateServiceShowTitleProcedure is the fully-qualified name of the GateService's ShowTitle RPC.
+	GateServiceShowTitleProcedure = "/minekube.gate.v1.GateService/ShowTitle"
+	// GateServicePlaySoundProcedure is the fully-qualified name of the GateService's PlaySound RPC.
+	GateServicePlaySoundProcedure = "/minekube.gate.v1.GateService/PlaySound"
+	// GateServiceShowBossBarProcedure is the fully-qualified name of the GateService's ShowBossBar RPC.
+	GateServiceShowBossBarProcedure = "/minekube.gate.v1.GateService/ShowBossBar"
+	// GateServiceHideBossBarProcedure is the fully-qualified name of the GateService's HideBossBar RPC.
+	GateServiceHideBossBarProcedure = "/minekube.gate.v1.GateService/HideBossBar"
+	// GateServiceSendResourcePackProcedure is the fully-qualified name of the GateService's
+	// SendResourcePack RPC.
+	GateServiceSendResourcePackProcedure = "/minekube.gate.v1.GateService/SendResourcePack"
+	// GateServiceExecuteCommandProcedure is the fully-qualified name of the GateService's
+	// ExecuteCommand RPC.
+	GateServiceExecuteCommandProcedure = "/minekube.gate.v1.GateService/ExecuteCommand"
+	// GateServiceSuggestCommandProcedure is the fully-qualified name of the GateService's
+	// SuggestCommand RPC.
+	GateServiceSuggestCommandProcedure = "/minekube.gate.v1.GateService/SuggestCommand"
 )
 
 // GateServiceClient is a client for the minekube.gate.v1.GateService service.
@@ -75,6 +154,7 @@ type GateServiceClient interface {
 	GetPlayer(context.Context, *connect.Request[v1.GetPlayerRequest]) (*connect.Response[v1.GetPlayerResponse], error)
 	// ListPlayers returns all online players.
 	// If servers are specified in the request, only returns players on those servers.
+	// If player_is_bedrock is set, only returns Bedrock or Java Edition players.
 	ListPlayers(context.Context, *connect.Request[v1.ListPlayersRequest]) (*connect.Response[v1.ListPlayersResponse], error)
 	// ListServers returns all registered servers.
 	ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error)
@@ -109,6 +189,132 @@ type GateServiceClient interface {
 	ValidateConfig(context.Context, *connect.Request[v1.ValidateConfigRequest]) (*connect.Response[v1.ValidateConfigResponse], error)
 	// ApplyConfig parses, validates, and applies a new config payload.
 	ApplyConfig(context.Context, *connect.Request[v1.ApplyConfigRequest]) (*connect.Response[v1.ApplyConfigResponse], error)
+	// GetPermissions returns the groups and player permissions of the built-in permission provider.
+	// Returns FAILED_PRECONDITION if the built-in permission provider is disabled.
+	GetPermissions(context.Context, *connect.Request[v1.GetPermissionsRequest]) (*connect.Response[v1.GetPermissionsResponse], error)
+	// CheckPermission returns the value of a permission for a player.
+	// Online players are checked against their effective permissions, including plugin overrides.
+	// Offline players are checked against the built-in permission provider.
+	// Returns NOT_FOUND if a player given by username is not online.
+	CheckPermission(context.Context, *connect.Request[v1.CheckPermissionRequest]) (*connect.Response[v1.CheckPermissionResponse], error)
+	// SetPermissionGroup creates or replaces a group of the built-in permission provider.
+	// The change is persisted to the permissions file.
+	// Returns INVALID_ARGUMENT if the group is invalid, e.g. references unknown parents or inherits itself.
+	// Returns FAILED_PRECONDITION if the built-in permission provider is disabled.
+	SetPermissionGroup(context.Context, *connect.Request[v1.SetPermissionGroupRequest]) (*connect.Response[v1.SetPermissionGroupResponse], error)
+	// DeletePermissionGroup deletes a group of the built-in permission provider.
+	// The change is persisted to the permissions file.
+	// Returns NOT_FOUND if the group does not exist.
+	// Returns FAILED_PRECONDITION if the group is still referenced or the built-in permission provider is disabled.
+	DeletePermissionGroup(context.Context, *connect.Request[v1.DeletePermissionGroupRequest]) (*connect.Response[v1.DeletePermissionGroupResponse], error)
+	// SetPlayerPermissions creates or replaces the groups and permissions of a player.
+	// Empty groups and permissions remove the player from the permissions file.
+	// The change is persisted to the permissions file.
+	// Returns NOT_FOUND if a player given by username is not online.
+	// Returns INVALID_ARGUMENT if the player is member of unknown groups.
+	// Returns FAILED_PRECONDITION if the built-in permission provider is disabled.
+	SetPlayerPermissions(context.Context, *connect.Request[v1.SetPlayerPermissionsRequest]) (*connect.Response[v1.SetPlayerPermissionsResponse], error)
+	// ListQueues returns the connection queues of servers with queued players.
+	// If servers are specified in the request, only returns the queues of those servers.
+	ListQueues(context.Context, *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error)
+	// EnqueuePlayer adds a player to the connection queue of a server.
+	// The player is connected automatically once the server has a free slot.
+	// Returns NOT_FOUND if either the player or target server doesn't exist.
+	// Returns FAILED_PRECONDITION if queues are disabled.
+	EnqueuePlayer(context.Context, *connect.Request[v1.EnqueuePlayerRequest]) (*connect.Response[v1.EnqueuePlayerResponse], error)
+	// DequeuePlayer removes a player from its connection queue.
+	// Returns NOT_FOUND if the player doesn't exist or is not queued.
+	DequeuePlayer(context.Context, *connect.Request[v1.DequeuePlayerRequest]) (*connect.Response[v1.DequeuePlayerResponse], error)
+	// ClearQueue removes all players from the connection queue of a server.
+	ClearQueue(context.Context, *connect.Request[v1.ClearQueueRequest]) (*connect.Response[v1.ClearQueueResponse], error)
+	// ListPunishments returns the active bans and mutes of the built-in punishment store.
+	// Returns FAILED_PRECONDITION if punishments are disabled.
+	ListPunishments(context.Context, *connect.Request[v1.ListPunishmentsRequest]) (*connect.Response[v1.ListPunishmentsResponse], error)
+	// AddPunishment bans or mutes players by UUID, username or IP address/range.
+	// Matching online players are disconnected when banned and notified when muted.
+	// The punishment is persisted to the punishments file.
+	// Returns NOT_FOUND if a player given by username is not online.
+	// Returns INVALID_ARGUMENT if the punishment has no target or an invalid type, ip or duration.
+	// Returns FAILED_PRECONDITION if punishments are disabled.
+	AddPunishment(context.Context, *connect.Request[v1.AddPunishmentRequest]) (*connect.Response[v1.AddPunishmentResponse], error)
+	// RemovePunishment revokes punishments by id or by type and player UUID, username or IP.
+	// The removal is persisted to the punishments file.
+	// Returns NOT_FOUND if no matching punishment is active.
+	// Returns INVALID_ARGUMENT if neither id nor type and target are provided.
+	// Returns FAILED_PRECONDITION if punishments are disabled.
+	RemovePunishment(context.Context, *connect.Request[v1.RemovePunishmentRequest]) (*connect.Response[v1.RemovePunishmentResponse], error)
+	// ListChatChannels returns the proxy chat channels and their online members.
+	// Returns FAILED_PRECONDITION if chat channels are disabled.
+	ListChatChannels(context.Context, *connect.Request[v1.ListChatChannelsRequest]) (*connect.Response[v1.ListChatChannelsResponse], error)
+	// SendChatChannelMessage sends a message to the members of a proxy chat channel on all servers.
+	// Returns NOT_FOUND if the channel or the sending player doesn't exist.
+	// Returns PERMISSION_DENIED if the sending player may not speak in the channel.
+	// Returns FAILED_PRECONDITION if chat channels are disabled.
+	SendChatChannelMessage(context.Context, *connect.Request[v1.SendChatChannelMessageRequest]) (*connect.Response[v1.SendChatChannelMessageResponse], error)
+	// GetMaintenance returns whether the network is under maintenance and the servers under maintenance.
+	GetMaintenance(context.Context, *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error)
+	// SetMaintenance enables or disables maintenance of the whole network or a single server
+	// until the next config change of the maintenance settings.
+	// Enabling global maintenance kicks online players that are not allowed to join.
+	// Returns NOT_FOUND if the server is not registered.
+	SetMaintenance(context.Context, *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error)
+	// ListWhitelist returns the players of the proxy-level whitelist.
+	// Returns FAILED_PRECONDITION if the whitelist is disabled.
+	ListWhitelist(context.Context, *connect.Request[v1.ListWhitelistRequest]) (*connect.Response[v1.ListWhitelistResponse], error)
+	// AddWhitelistEntry adds a player by username or UUID to the whitelist.
+	// The UUID and username of online players are both recorded.
+	// The entry is persisted to the whitelist file.
+	// Returns INVALID_ARGUMENT if the player is empty.
+	// Returns FAILED_PRECONDITION if the whitelist is disabled.
+	AddWhitelistEntry(context.Context, *connect.Request[v1.AddWhitelistEntryRequest]) (*connect.Response[v1.AddWhitelistEntryResponse], error)
+	// RemoveWhitelistEntry removes the entries of a player by username or UUID from the whitelist.
+	// The removal is persisted to the whitelist file, online players are not kicked.
+	// Returns NOT_FOUND if the player is not whitelisted.
+	// Returns FAILED_PRECONDITION if the whitelist is disabled.
+	RemoveWhitelistEntry(context.Context, *connect.Request[v1.RemoveWhitelistEntryRequest]) (*connect.Response[v1.RemoveWhitelistEntryResponse], error)
+	// WatchEvents streams proxy events as they happen until the client cancels the call.
+	// Past events are not replayed. Events are buffered per call and dropped if the
+	// client does not receive them fast enough, see WatchEventsResponse.dropped.
+	// Returns INVALID_ARGUMENT if an event type is unknown.
+	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.WatchEventsResponse], error)
+	// SendMessage sends a chat message to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty or the message can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
+	// SendActionBar shows an action bar message to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty or the message can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	SendActionBar(context.Context, *connect.Request[v1.SendActionBarRequest]) (*connect.Response[v1.SendActionBarResponse], error)
+	// ShowTitle shows a title to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty or the title can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	ShowTitle(context.Context, *connect.Request[v1.ShowTitleRequest]) (*connect.Response[v1.ShowTitleResponse], error)
+	// PlaySound plays a sound to the players of the audience.
+	// Sounds are only played to players connected to a server with at least Minecraft 1.19.3.
+	// Returns INVALID_ARGUMENT if the audience is empty or the sound or source is invalid.
+	// Returns NOT_FOUND if no player of the audience is online.
+	PlaySound(context.Context, *connect.Request[v1.PlaySoundRequest]) (*connect.Response[v1.PlaySoundResponse], error)
+	// ShowBossBar shows a new boss bar to the players of the audience
+	// until it is hidden with HideBossBar, its duration elapsed or all its players left.
+	// Returns INVALID_ARGUMENT if the audience is empty or the name can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	ShowBossBar(context.Context, *connect.Request[v1.ShowBossBarRequest]) (*connect.Response[v1.ShowBossBarResponse], error)
+	// HideBossBar hides a boss bar shown by ShowBossBar from all its players.
+	// Returns NOT_FOUND if the boss bar does not exist.
+	HideBossBar(context.Context, *connect.Request[v1.HideBossBarRequest]) (*connect.Response[v1.HideBossBarResponse], error)
+	// SendResourcePack sends a resource pack to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty, the url is empty or the hash or prompt is invalid.
+	// Returns NOT_FOUND if no player of the audience is online.
+	SendResourcePack(context.Context, *connect.Request[v1.SendResourcePackRequest]) (*connect.Response[v1.SendResourcePackResponse], error)
+	// ExecuteCommand runs a proxy command as the command source and returns the messages
+	// sent to the source while the command ran. Commands of backend servers can't be run.
+	// Returns INVALID_ARGUMENT if the command is empty or its syntax is invalid.
+	// Returns NOT_FOUND if the command or the impersonated player is not found.
+	ExecuteCommand(context.Context, *connect.Request[v1.ExecuteCommandRequest]) (*connect.Response[v1.ExecuteCommandResponse], error)
+	// SuggestCommand returns the completion suggestions of a partial proxy command
+	// for the command source, e.g. for autocompletion.
+	// Returns NOT_FOUND if the impersonated player is not found.
+	SuggestCommand(context.Context, *connect.Request[v1.SuggestCommandRequest]) (*connect.Response[v1.SuggestCommandResponse], error)
 }
 
 // NewGateServiceClient constructs a client for the minekube.gate.v1.GateService service. By
@@ -200,24 +406,227 @@ func NewGateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gateServiceMethods.ByName("ApplyConfig")),
 			connect.WithClientOptions(opts...),
 		),
+		getPermissions: connect.NewClient[v1.GetPermissionsRequest, v1.GetPermissionsResponse](
+			httpClient,
+			baseURL+GateServiceGetPermissionsProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("GetPermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		checkPermission: connect.NewClient[v1.CheckPermissionRequest, v1.CheckPermissionResponse](
+			httpClient,
+			baseURL+GateServiceCheckPermissionProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("CheckPermission")),
+			connect.WithClientOptions(opts...),
+		),
+		setPermissionGroup: connect.NewClient[v1.SetPermissionGroupRequest, v1.SetPermissionGroupResponse](
+			httpClient,
+			baseURL+GateServiceSetPermissionGroupProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SetPermissionGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePermissionGroup: connect.NewClient[v1.DeletePermissionGroupRequest, v1.DeletePermissionGroupResponse](
+			httpClient,
+			baseURL+GateServiceDeletePermissionGroupProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("DeletePermissionGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		setPlayerPermissions: connect.NewClient[v1.SetPlayerPermissionsRequest, v1.SetPlayerPermissionsResponse](
+			httpClient,
+			baseURL+GateServiceSetPlayerPermissionsProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SetPlayerPermissions")),
+			connect.WithClientOptions(opts...),
+		),
+		listQueues: connect.NewClient[v1.ListQueuesRequest, v1.ListQueuesResponse](
+			httpClient,
+			baseURL+GateServiceListQueuesProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ListQueues")),
+			connect.WithClientOptions(opts...),
+		),
+		enqueuePlayer: connect.NewClient[v1.EnqueuePlayerRequest, v1.EnqueuePlayerResponse](
+			httpClient,
+			baseURL+GateServiceEnqueuePlayerProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("EnqueuePlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		dequeuePlayer: connect.NewClient[v1.DequeuePlayerRequest, v1.DequeuePlayerResponse](
+			httpClient,
+			baseURL+GateServiceDequeuePlayerProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("DequeuePlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		clearQueue: connect.NewClient[v1.ClearQueueRequest, v1.ClearQueueResponse](
+			httpClient,
+			baseURL+GateServiceClearQueueProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ClearQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		listPunishments: connect.NewClient[v1.ListPunishmentsRequest, v1.ListPunishmentsResponse](
+			httpClient,
+			baseURL+GateServiceListPunishmentsProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ListPunishments")),
+			connect.WithClientOptions(opts...),
+		),
+		addPunishment: connect.NewClient[v1.AddPunishmentRequest, v1.AddPunishmentResponse](
+			httpClient,
+			baseURL+GateServiceAddPunishmentProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("AddPunishment")),
+			connect.WithClientOptions(opts...),
+		),
+		removePunishment: connect.NewClient[v1.RemovePunishmentRequest, v1.RemovePunishmentResponse](
+			httpClient,
+			baseURL+GateServiceRemovePunishmentProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("RemovePunishment")),
+			connect.WithClientOptions(opts...),
+		),
+		listChatChannels: connect.NewClient[v1.ListChatChannelsRequest, v1.ListChatChannelsResponse](
+			httpClient,
+			baseURL+GateServiceListChatChannelsProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ListChatChannels")),
+			connect.WithClientOptions(opts...),
+		),
+		sendChatChannelMessage: connect.NewClient[v1.SendChatChannelMessageRequest, v1.SendChatChannelMessageResponse](
+			httpClient,
+			baseURL+GateServiceSendChatChannelMessageProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SendChatChannelMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		getMaintenance: connect.NewClient[v1.GetMaintenanceRequest, v1.GetMaintenanceResponse](
+			httpClient,
+			baseURL+GateServiceGetMaintenanceProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("GetMaintenance")),
+			connect.WithClientOptions(opts...),
+		),
+		setMaintenance: connect.NewClient[v1.SetMaintenanceRequest, v1.SetMaintenanceResponse](
+			httpClient,
+			baseURL+GateServiceSetMaintenanceProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SetMaintenance")),
+			connect.WithClientOptions(opts...),
+		),
+		listWhitelist: connect.NewClient[v1.ListWhitelistRequest, v1.ListWhitelistResponse](
+			httpClient,
+			baseURL+GateServiceListWhitelistProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ListWhitelist")),
+			connect.WithClientOptions(opts...),
+		),
+		addWhitelistEntry: connect.NewClient[v1.AddWhitelistEntryRequest, v1.AddWhitelistEntryResponse](
+			httpClient,
+			baseURL+GateServiceAddWhitelistEntryProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("AddWhitelistEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		removeWhitelistEntry: connect.NewClient[v1.RemoveWhitelistEntryRequest, v1.RemoveWhitelistEntryResponse](
+			httpClient,
+			baseURL+GateServiceRemoveWhitelistEntryProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("RemoveWhitelistEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		watchEvents: connect.NewClient[v1.WatchEventsRequest, v1.WatchEventsResponse](
+			httpClient,
+			baseURL+GateServiceWatchEventsProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("WatchEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		sendMessage: connect.NewClient[v1.SendMessageRequest, v1.SendMessageResponse](
+			httpClient,
+			baseURL+GateServiceSendMessageProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SendMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		sendActionBar: connect.NewClient[v1.SendActionBarRequest, v1.SendActionBarResponse](
+			httpClient,
+			baseURL+GateServiceSendActionBarProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SendActionBar")),
+			connect.WithClientOptions(opts...),
+		),
+		showTitle: connect.NewClient[v1.ShowTitleRequest, v1.ShowTitleResponse](
+			httpClient,
+			baseURL+GateServiceShowTitleProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ShowTitle")),
+			connect.WithClientOptions(opts...),
+		),
+		playSound: connect.NewClient[v1.PlaySoundRequest, v1.PlaySoundResponse](
+			httpClient,
+			baseURL+GateServicePlaySoundProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("PlaySound")),
+			connect.WithClientOptions(opts...),
+		),
+		showBossBar: connect.NewClient[v1.ShowBossBarRequest, v1.ShowBossBarResponse](
+			httpClient,
+			baseURL+GateServiceShowBossBarProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ShowBossBar")),
+			connect.WithClientOptions(opts...),
+		),
+		hideBossBar: connect.NewClient[v1.HideBossBarRequest, v1.HideBossBarResponse](
+			httpClient,
+			baseURL+GateServiceHideBossBarProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("HideBossBar")),
+			connect.WithClientOptions(opts...),
+		),
+		sendResourcePack: connect.NewClient[v1.SendResourcePackRequest, v1.SendResourcePackResponse](
+			httpClient,
+			baseURL+GateServiceSendResourcePackProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SendResourcePack")),
+			connect.WithClientOptions(opts...),
+		),
+		executeCommand: connect.NewClient[v1.ExecuteCommandRequest, v1.ExecuteCommandResponse](
+			httpClient,
+			baseURL+GateServiceExecuteCommandProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("ExecuteCommand")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestCommand: connect.NewClient[v1.SuggestCommandRequest, v1.SuggestCommandResponse](
+			httpClient,
+			baseURL+GateServiceSuggestCommandProcedure,
+			connect.WithSchema(gateServiceMethods.ByName("SuggestCommand")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gateServiceClient implements GateServiceClient.
 type gateServiceClient struct {
-	getPlayer        *connect.Client[v1.GetPlayerRequest, v1.GetPlayerResponse]
-	listPlayers      *connect.Client[v1.ListPlayersRequest, v1.ListPlayersResponse]
-	listServers      *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
-	registerServer   *connect.Client[v1.RegisterServerRequest, v1.RegisterServerResponse]
-	unregisterServer *connect.Client[v1.UnregisterServerRequest, v1.UnregisterServerResponse]
-	connectPlayer    *connect.Client[v1.ConnectPlayerRequest, v1.ConnectPlayerResponse]
-	disconnectPlayer *connect.Client[v1.DisconnectPlayerRequest, v1.DisconnectPlayerResponse]
-	storeCookie      *connect.Client[v1.StoreCookieRequest, v1.StoreCookieResponse]
-	requestCookie    *connect.Client[v1.RequestCookieRequest, v1.RequestCookieResponse]
-	getStatus        *connect.Client[v1.GetStatusRequest, v1.GetStatusResponse]
-	getConfig        *connect.Client[v1.GetConfigRequest, v1.GetConfigResponse]
-	validateConfig   *connect.Client[v1.ValidateConfigRequest, v1.ValidateConfigResponse]
-	applyConfig      *connect.Client[v1.ApplyConfigRequest, v1.ApplyConfigResponse]
+	getPlayer              *connect.Client[v1.GetPlayerRequest, v1.GetPlayerResponse]
+	listPlayers            *connect.Client[v1.ListPlayersRequest, v1.ListPlayersResponse]
+	listServers            *connect.Client[v1.ListServersRequest, v1.ListServersResponse]
+	registerServer         *connect.Client[v1.RegisterServerRequest, v1.RegisterServerResponse]
+	unregisterServer       *connect.Client[v1.UnregisterServerRequest, v1.UnregisterServerResponse]
+	connectPlayer          *connect.Client[v1.ConnectPlayerRequest, v1.ConnectPlayerResponse]
+	disconnectPlayer       *connect.Client[v1.DisconnectPlayerRequest, v1.DisconnectPlayerResponse]
+	storeCookie            *connect.Client[v1.StoreCookieRequest, v1.StoreCookieResponse]
+	requestCookie          *connect.Client[v1.RequestCookieRequest, v1.RequestCookieResponse]
+	getStatus              *connect.Client[v1.GetStatusRequest, v1.GetStatusResponse]
+	getConfig              *connect.Client[v1.GetConfigRequest, v1.GetConfigResponse]
+	validateConfig         *connect.Client[v1.ValidateConfigRequest, v1.ValidateConfigResponse]
+	applyConfig            *connect.Client[v1.ApplyConfigRequest, v1.ApplyConfigResponse]
+	getPermissions         *connect.Client[v1.GetPermissionsRequest, v1.GetPermissionsResponse]
+	checkPermission        *connect.Client[v1.CheckPermissionRequest, v1.CheckPermissionResponse]
+	setPermissionGroup     *connect.Client[v1.SetPermissionGroupRequest, v1.SetPermissionGroupResponse]
+	deletePermissionGroup  *connect.Client[v1.DeletePermissionGroupRequest, v1.DeletePermissionGroupResponse]
+	setPlayerPermissions   *connect.Client[v1.SetPlayerPermissionsRequest, v1.SetPlayerPermissionsResponse]
+	listQueues             *connect.Client[v1.ListQueuesRequest, v1.ListQueuesResponse]
+	enqueuePlayer          *connect.Client[v1.EnqueuePlayerRequest, v1.EnqueuePlayerResponse]
+	dequeuePlayer          *connect.Client[v1.DequeuePlayerRequest, v1.DequeuePlayerResponse]
+	clearQueue             *connect.Client[v1.ClearQueueRequest, v1.ClearQueueResponse]
+	listPunishments        *connect.Client[v1.ListPunishmentsRequest, v1.ListPunishmentsResponse]
+	addPunishment          *connect.Client[v1.AddPunishmentRequest, v1.AddPunishmentResponse]
+	removePunishment       *connect.Client[v1.RemovePunishmentRequest, v1.RemovePunishmentResponse]
+	listChatChannels       *connect.Client[v1.ListChatChannelsRequest, v1.ListChatChannelsResponse]
+	sendChatChannelMessage *connect.Client[v1.SendChatChannelMessageRequest, v1.SendChatChannelMessageResponse]
+	getMaintenance         *connect.Client[v1.GetMaintenanceRequest, v1.GetMaintenanceResponse]
+	setMaintenance         *connect.Client[v1.SetMaintenanceRequest, v1.SetMaintenanceResponse]
+	listWhitelist          *connect.Client[v1.ListWhitelistRequest, v1.ListWhitelistResponse]
+	addWhitelistEntry      *connect.Client[v1.AddWhitelistEntryRequest, v1.AddWhitelistEntryResponse]
+	removeWhitelistEntry   *connect.Client[v1.RemoveWhitelistEntryRequest, v1.RemoveWhitelistEntryResponse]
+	watchEvents            *connect.Client[v1.WatchEventsRequest, v1.WatchEventsResponse]
+	sendMessage            *connect.Client[v1.SendMessageRequest, v1.SendMessageResponse]
+	sendActionBar          *connect.Client[v1.SendActionBarRequest, v1.SendActionBarResponse]
+	showTitle              *connect.Client[v1.ShowTitleRequest, v1.ShowTitleResponse]
+	playSound              *connect.Client[v1.PlaySoundRequest, v1.PlaySoundResponse]
+	showBossBar            *connect.Client[v1.ShowBossBarRequest, v1.ShowBossBarResponse]
+	hideBossBar            *connect.Client[v1.HideBossBarRequest, v1.HideBossBarResponse]
+	sendResourcePack       *connect.Client[v1.SendResourcePackRequest, v1.SendResourcePackResponse]
+	executeCommand         *connect.Client[v1.ExecuteCommandRequest, v1.ExecuteCommandResponse]
+	suggestCommand         *connect.Client[v1.SuggestCommandRequest, v1.SuggestCommandResponse]
 }
 
 // GetPlayer calls minekube.gate.v1.GateService.GetPlayer.
@@ -285,6 +694,151 @@ func (c *gateServiceClient) ApplyConfig(ctx context.Context, req *connect.Reques
 	return c.applyConfig.CallUnary(ctx, req)
 }
 
+// GetPermissions calls minekube.gate.v1.GateService.GetPermissions.
+func (c *gateServiceClient) GetPermissions(ctx context.Context, req *connect.Request[v1.GetPermissionsRequest]) (*connect.Response[v1.GetPermissionsResponse], error) {
+	return c.getPermissions.CallUnary(ctx, req)
+}
+
+// CheckPermission calls minekube.gate.v1.GateService.CheckPermission.
+func (c *gateServiceClient) CheckPermission(ctx context.Context, req *connect.Request[v1.CheckPermissionRequest]) (*connect.Response[v1.CheckPermissionResponse], error) {
+	return c.checkPermission.CallUnary(ctx, req)
+}
+
+// SetPermissionGroup calls minekube.gate.v1.GateService.SetPermissionGroup.
+func (c *gateServiceClient) SetPermissionGroup(ctx context.Context, req *connect.Request[v1.SetPermissionGroupRequest]) (*connect.Response[v1.SetPermissionGroupResponse], error) {
+	return c.setPermissionGroup.CallUnary(ctx, req)
+}
+
+// DeletePermissionGroup calls minekube.gate.v1.GateService.DeletePermissionGroup.
+func (c *gateServiceClient) DeletePermissionGroup(ctx context.Context, req *connect.Request[v1.DeletePermissionGroupRequest]) (*connect.Response[v1.DeletePermissionGroupResponse], error) {
+	return c.deletePermissionGroup.CallUnary(ctx, req)
+}
+
+// SetPlayerPermissions calls minekube.gate.v1.GateService.SetPlayerPermissions.
+func (c *gateServiceClient) SetPlayerPermissions(ctx context.Context, req *connect.Request[v1.SetPlayerPermissionsRequest]) (*connect.Response[v1.SetPlayerPermissionsResponse], error) {
+	return c.setPlayerPermissions.CallUnary(ctx, req)
+}
+
+// ListQueues calls minekube.gate.v1.GateService.ListQueues.
+func (c *gateServiceClient) ListQueues(ctx context.Context, req *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error) {
+	return c.listQueues.CallUnary(ctx, req)
+}
+
+// EnqueuePlayer calls minekube.gate.v1.GateService.EnqueuePlayer.
+func (c *gateServiceClient) EnqueuePlayer(ctx context.Context, req *connect.Request[v1.EnqueuePlayerRequest]) (*connect.Response[v1.EnqueuePlayerResponse], error) {
+	return c.enqueuePlayer.CallUnary(ctx, req)
+}
+
+// DequeuePlayer calls minekube.gate.v1.GateService.DequeuePlayer.
+func (c *gateServiceClient) DequeuePlayer(ctx context.Context, req *connect.Request[v1.DequeuePlayerRequest]) (*connect.Response[v1.DequeuePlayerResponse], error) {
+	return c.dequeuePlayer.CallUnary(ctx, req)
+}
+
+// ClearQueue calls minekube.gate.v1.GateService.ClearQueue.
+func (c *gateServiceClient) ClearQueue(ctx context.Context, req *connect.Request[v1.ClearQueueRequest]) (*connect.Response[v1.ClearQueueResponse], error) {
+	return c.clearQueue.CallUnary(ctx, req)
+}
+
+// ListPunishments calls minekube.gate.v1.GateService.ListPunishments.
+func (c *gateServiceClient) ListPunishments(ctx context.Context, req *connect.Request[v1.ListPunishmentsRequest]) (*connect.Response[v1.ListPunishmentsResponse], error) {
+	return c.listPunishments.CallUnary(ctx, req)
+}
+
+// AddPunishment calls minekube.gate.v1.GateService.AddPunishment.
+func (c *gateServiceClient) AddPunishment(ctx context.Context, req *connect.Request[v1.AddPunishmentRequest]) (*connect.Response[v1.AddPunishmentResponse], error) {
+	return c.addPunishment.CallUnary(ctx, req)
+}
+
+// RemovePunishment calls minekube.gate.v1.GateService.RemovePunishment.
+func (c *gateServiceClient) RemovePunishment(ctx context.Context, req *connect.Request[v1.RemovePunishmentRequest]) (*connect.Response[v1.RemovePunishmentResponse], error) {
+	return c.removePunishment.CallUnary(ctx, req)
+}
+
+// ListChatChannels calls minekube.gate.v1.GateService.ListChatChannels.
+func (c *gateServiceClient) ListChatChannels(ctx context.Context, req *connect.Request[v1.ListChatChannelsRequest]) (*connect.Response[v1.ListChatChannelsResponse], error) {
+	return c.listChatChannels.CallUnary(ctx, req)
+}
+
+// SendChatChannelMessage calls minekube.gate.v1.GateService.SendChatChannelMessage.
+func (c *gateServiceClient) SendChatChannelMessage(ctx context.Context, req *connect.Request[v1.SendChatChannelMessageRequest]) (*connect.Response[v1.SendChatChannelMessageResponse], error) {
+	return c.sendChatChannelMessage.CallUnary(ctx, req)
+}
+
+// GetMaintenance calls minekube.gate.v1.GateService.GetMaintenance.
+func (c *gateServiceClient) GetMaintenance(ctx context.Context, req *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error) {
+	return c.getMaintenance.CallUnary(ctx, req)
+}
+
+// SetMaintenance calls minekube.gate.v1.GateService.SetMaintenance.
+func (c *gateServiceClient) SetMaintenance(ctx context.Context, req *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error) {
+	return c.setMaintenance.CallUnary(ctx, req)
+}
+
+// ListWhitelist calls minekube.gate.v1.GateService.ListWhitelist.
+func (c *gateServiceClient) ListWhitelist(ctx context.Context, req *connect.Request[v1.ListWhitelistRequest]) (*connect.Response[v1.ListWhitelistResponse], error) {
+	return c.listWhitelist.CallUnary(ctx, req)
+}
+
+// AddWhitelistEntry calls minekube.gate.v1.GateService.AddWhitelistEntry.
+func (c *gateServiceClient) AddWhitelistEntry(ctx context.Context, req *connect.Request[v1.AddWhitelistEntryRequest]) (*connect.Response[v1.AddWhitelistEntryResponse], error) {
+	return c.addWhitelistEntry.CallUnary(ctx, req)
+}
+
+// RemoveWhitelistEntry calls minekube.gate.v1.GateService.RemoveWhitelistEntry.
+func (c *gateServiceClient) RemoveWhitelistEntry(ctx context.Context, req *connect.Request[v1.RemoveWhitelistEntryRequest]) (*connect.Response[v1.RemoveWhitelistEntryResponse], error) {
+	return c.removeWhitelistEntry.CallUnary(ctx, req)
+}
+
+// WatchEvents calls minekube.gate.v1.GateService.WatchEvents.
+func (c *gateServiceClient) WatchEvents(ctx context.Context, req *connect.Request[v1.WatchEventsRequest]) (*connect.ServerStreamForClient[v1.WatchEventsResponse], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// SendMessage calls minekube.gate.v1.GateService.SendMessage.
+func (c *gateServiceClient) SendMessage(ctx context.Context, req *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
+	return c.sendMessage.CallUnary(ctx, req)
+}
+
+// SendActionBar calls minekube.gate.v1.GateService.SendActionBar.
+func (c *gateServiceClient) SendActionBar(ctx context.Context, req *connect.Request[v1.SendActionBarRequest]) (*connect.Response[v1.SendActionBarResponse], error) {
+	return c.sendActionBar.CallUnary(ctx, req)
+}
+
+// ShowTitle calls minekube.gate.v1.GateService.ShowTitle.
+func (c *gateServiceClient) ShowTitle(ctx context.Context, req *connect.Request[v1.ShowTitleRequest]) (*connect.Response[v1.ShowTitleResponse], error) {
+	return c.showTitle.CallUnary(ctx, req)
+}
+
+// PlaySound calls minekube.gate.v1.GateService.PlaySound.
+func (c *gateServiceClient) PlaySound(ctx context.Context, req *connect.Request[v1.PlaySoundRequest]) (*connect.Response[v1.PlaySoundResponse], error) {
+	return c.playSound.CallUnary(ctx, req)
+}
+
+// ShowBossBar calls minekube.gate.v1.GateService.ShowBossBar.
+func (c *gateServiceClient) ShowBossBar(ctx context.Context, req *connect.Request[v1.ShowBossBarRequest]) (*connect.Response[v1.ShowBossBarResponse], error) {
+	return c.showBossBar.CallUnary(ctx, req)
+}
+
+// HideBossBar calls minekube.gate.v1.GateService.HideBossBar.
+func (c *gateServiceClient) HideBossBar(ctx context.Context, req *connect.Request[v1.HideBossBarRequest]) (*connect.Response[v1.HideBossBarResponse], error) {
+	return c.hideBossBar.CallUnary(ctx, req)
+}
+
+// SendResourcePack calls minekube.gate.v1.GateService.SendResourcePack.
+func (c *gateServiceClient) SendResourcePack(ctx context.Context, req *connect.Request[v1.SendResourcePackRequest]) (*connect.Response[v1.SendResourcePackResponse], error) {
+	return c.sendResourcePack.CallUnary(ctx, req)
+}
+
+// ExecuteCommand calls minekube.gate.v1.GateService.ExecuteCommand.
+func (c *gateServiceClient) ExecuteCommand(ctx context.Context, req *connect.Request[v1.ExecuteCommandRequest]) (*connect.Response[v1.ExecuteCommandResponse], error) {
+	return c.executeCommand.CallUnary(ctx, req)
+}
+
+// SuggestCommand calls minekube.gate.v1.GateService.SuggestCommand.
+func (c *gateServiceClient) SuggestCommand(ctx context.Context, req *connect.Request[v1.SuggestCommandRequest]) (*connect.Response[v1.SuggestCommandResponse], error) {
+	return c.suggestCommand.CallUnary(ctx, req)
+}
+
 // GateServiceHandler is an implementation of the minekube.gate.v1.GateService service.
 type GateServiceHandler interface {
 	// GetPlayer returns the player by the given id or username.
@@ -293,6 +847,7 @@ type GateServiceHandler interface {
 	GetPlayer(context.Context, *connect.Request[v1.GetPlayerRequest]) (*connect.Response[v1.GetPlayerResponse], error)
 	// ListPlayers returns all online players.
 	// If servers are specified in the request, only returns players on those servers.
+	// If player_is_bedrock is set, only returns Bedrock or Java Edition players.
 	ListPlayers(context.Context, *connect.Request[v1.ListPlayersRequest]) (*connect.Response[v1.ListPlayersResponse], error)
 	// ListServers returns all registered servers.
 	ListServers(context.Context, *connect.Request[v1.ListServersRequest]) (*connect.Response[v1.ListServersResponse], error)
@@ -327,6 +882,132 @@ type GateServiceHandler interface {
 	ValidateConfig(context.Context, *connect.Request[v1.ValidateConfigRequest]) (*connect.Response[v1.ValidateConfigResponse], error)
 	// ApplyConfig parses, validates, and applies a new config payload.
 	ApplyConfig(context.Context, *connect.Request[v1.ApplyConfigRequest]) (*connect.Response[v1.ApplyConfigResponse], error)
+	// GetPermissions returns the groups and player permissions of the built-in permission provider.
+	// Returns FAILED_PRECONDITION if the built-in permission provider is disabled.
+	GetPermissions(context.Context, *connect.Request[v1.GetPermissionsRequest]) (*connect.Response[v1.GetPermissionsResponse], error)
+	// CheckPermission returns the value of a permission for a player.
+	// Online players are checked against their effective permissions, including plugin overrides.
+	// Offline players are checked against the built-in permission provider.
+	// Returns NOT_FOUND if a player given by username is not online.
+	CheckPermission(context.Context, *connect.Request[v1.CheckPermissionRequest]) (*connect.Response[v1.CheckPermissionResponse], error)
+	// SetPermissionGroup creates or replaces a group of the built-in permission provider.
+	// The change is persisted to the permissions file.
+	// Returns INVALID_ARGUMENT if the group is invalid, e.g. references unknown parents or inherits itself.
+	// Returns FAILED_PRECONDITION if the built-in permission provider is disabled.
+	SetPermissionGroup(context.Context, *connect.Request[v1.SetPermissionGroupRequest]) (*connect.Response[v1.SetPermissionGroupResponse], error)
+	// DeletePermissionGroup deletes a group of the built-in permission provider.
+	// The change is persisted to the permissions file.
+	// Returns NOT_FOUND if the group does not exist.
+	// Returns FAILED_PRECONDITION if the group is still referenced or the built-in permission provider is disabled.
+	DeletePermissionGroup(context.Context, *connect.Request[v1.DeletePermissionGroupRequest]) (*connect.Response[v1.DeletePermissionGroupResponse], error)
+	// SetPlayerPermissions creates or replaces the groups and permissions of a player.
+	// Empty groups and permissions remove the player from the permissions file.
+	// The change is persisted to the permissions file.
+	// Returns NOT_FOUND if a player given by username is not online.
+	// Returns INVALID_ARGUMENT if the player is member of unknown groups.
+	// Returns FAILED_PRECONDITION if the built-in permission provider is disabled.
+	SetPlayerPermissions(context.Context, *connect.Request[v1.SetPlayerPermissionsRequest]) (*connect.Response[v1.SetPlayerPermissionsResponse], error)
+	// ListQueues returns the connection queues of servers with queued players.
+	// If servers are specified in the request, only returns the queues of those servers.
+	ListQueues(context.Context, *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error)
+	// EnqueuePlayer adds a player to the connection queue of a server.
+	// The player is connected automatically once the server has a free slot.
+	// Returns NOT_FOUND if either the player or target server doesn't exist.
+	// Returns FAILED_PRECONDITION if queues are disabled.
+	EnqueuePlayer(context.Context, *connect.Request[v1.EnqueuePlayerRequest]) (*connect.Response[v1.EnqueuePlayerResponse], error)
+	// DequeuePlayer removes a player from its connection queue.
+	// Returns NOT_FOUND if the player doesn't exist or is not queued.
+	DequeuePlayer(context.Context, *connect.Request[v1.DequeuePlayerRequest]) (*connect.Response[v1.DequeuePlayerResponse], error)
+	// ClearQueue removes all players from the connection queue of a server.
+	ClearQueue(context.Context, *connect.Request[v1.ClearQueueRequest]) (*connect.Response[v1.ClearQueueResponse], error)
+	// ListPunishments returns the active bans and mutes of the built-in punishment store.
+	// Returns FAILED_PRECONDITION if punishments are disabled.
+	ListPunishments(context.Context, *connect.Request[v1.ListPunishmentsRequest]) (*connect.Response[v1.ListPunishmentsResponse], error)
+	// AddPunishment bans or mutes players by UUID, username or IP address/range.
+	// Matching online players are disconnected when banned and notified when muted.
+	// The punishment is persisted to the punishments file.
+	// Returns NOT_FOUND if a player given by username is not online.
+	// Returns INVALID_ARGUMENT if the punishment has no target or an invalid type, ip or duration.
+	// Returns FAILED_PRECONDITION if punishments are disabled.
+	AddPunishment(context.Context, *connect.Request[v1.AddPunishmentRequest]) (*connect.Response[v1.AddPunishmentResponse], error)
+	// RemovePunishment revokes punishments by id or by type and player UUID, username or IP.
+	// The removal is persisted to the punishments file.
+	// Returns NOT_FOUND if no matching punishment is active.
+	// Returns INVALID_ARGUMENT if neither id nor type and target are provided.
+	// Returns FAILED_PRECONDITION if punishments are disabled.
+	RemovePunishment(context.Context, *connect.Request[v1.RemovePunishmentRequest]) (*connect.Response[v1.RemovePunishmentResponse], error)
+	// ListChatChannels returns the proxy chat channels and their online members.
+	// Returns FAILED_PRECONDITION if chat channels are disabled.
+	ListChatChannels(context.Context, *connect.Request[v1.ListChatChannelsRequest]) (*connect.Response[v1.ListChatChannelsResponse], error)
+	// SendChatChannelMessage sends a message to the members of a proxy chat channel on all servers.
+	// Returns NOT_FOUND if the channel or the sending player doesn't exist.
+	// Returns PERMISSION_DENIED if the sending player may not speak in the channel.
+	// Returns FAILED_PRECONDITION if chat channels are disabled.
+	SendChatChannelMessage(context.Context, *connect.Request[v1.SendChatChannelMessageRequest]) (*connect.Response[v1.SendChatChannelMessageResponse], error)
+	// GetMaintenance returns whether the network is under maintenance and the servers under maintenance.
+	GetMaintenance(context.Context, *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error)
+	// SetMaintenance enables or disables maintenance of the whole network or a single server
+	// until the next config change of the maintenance settings.
+	// Enabling global maintenance kicks online players that are not allowed to join.
+	// Returns NOT_FOUND if the server is not registered.
+	SetMaintenance(context.Context, *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error)
+	// ListWhitelist returns the players of the proxy-level whitelist.
+	// Returns FAILED_PRECONDITION if the whitelist is disabled.
+	ListWhitelist(context.Context, *connect.Request[v1.ListWhitelistRequest]) (*connect.Response[v1.ListWhitelistResponse], error)
+	// AddWhitelistEntry adds a player by username or UUID to the whitelist.
+	// The UUID and username of online players are both recorded.
+	// The entry is persisted to the whitelist file.
+	// Returns INVALID_ARGUMENT if the player is empty.
+	// Returns FAILED_PRECONDITION if the whitelist is disabled.
+	AddWhitelistEntry(context.Context, *connect.Request[v1.AddWhitelistEntryRequest]) (*connect.Response[v1.AddWhitelistEntryResponse], error)
+	// RemoveWhitelistEntry removes the entries of a player by username or UUID from the whitelist.
+	// The removal is persisted to the whitelist file, online players are not kicked.
+	// Returns NOT_FOUND if the player is not whitelisted.
+	// Returns FAILED_PRECONDITION if the whitelist is disabled.
+	RemoveWhitelistEntry(context.Context, *connect.Request[v1.RemoveWhitelistEntryRequest]) (*connect.Response[v1.RemoveWhitelistEntryResponse], error)
+	// WatchEvents streams proxy events as they happen until the client cancels the call.
+	// Past events are not replayed. Events are buffered per call and dropped if the
+	// client does not receive them fast enough, see WatchEventsResponse.dropped.
+	// Returns INVALID_ARGUMENT if an event type is unknown.
+	WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.WatchEventsResponse]) error
+	// SendMessage sends a chat message to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty or the message can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
+	// SendActionBar shows an action bar message to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty or the message can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	SendActionBar(context.Context, *connect.Request[v1.SendActionBarRequest]) (*connect.Response[v1.SendActionBarResponse], error)
+	// ShowTitle shows a title to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty or the title can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	ShowTitle(context.Context, *connect.Request[v1.ShowTitleRequest]) (*connect.Response[v1.ShowTitleResponse], error)
+	// PlaySound plays a sound to the players of the audience.
+	// Sounds are only played to players connected to a server with at least Minecraft 1.19.3.
+	// Returns INVALID_ARGUMENT if the audience is empty or the sound or source is invalid.
+	// Returns NOT_FOUND if no player of the audience is online.
+	PlaySound(context.Context, *connect.Request[v1.PlaySoundRequest]) (*connect.Response[v1.PlaySoundResponse], error)
+	// ShowBossBar shows a new boss bar to the players of the audience
+	// until it is hidden with HideBossBar, its duration elapsed or all its players left.
+	// Returns INVALID_ARGUMENT if the audience is empty or the name can't be parsed.
+	// Returns NOT_FOUND if no player of the audience is online.
+	ShowBossBar(context.Context, *connect.Request[v1.ShowBossBarRequest]) (*connect.Response[v1.ShowBossBarResponse], error)
+	// HideBossBar hides a boss bar shown by ShowBossBar from all its players.
+	// Returns NOT_FOUND if the boss bar does not exist.
+	HideBossBar(context.Context, *connect.Request[v1.HideBossBarRequest]) (*connect.Response[v1.HideBossBarResponse], error)
+	// SendResourcePack sends a resource pack to the players of the audience.
+	// Returns INVALID_ARGUMENT if the audience is empty, the url is empty or the hash or prompt is invalid.
+	// Returns NOT_FOUND if no player of the audience is online.
+	SendResourcePack(context.Context, *connect.Request[v1.SendResourcePackRequest]) (*connect.Response[v1.SendResourcePackResponse], error)
+	// ExecuteCommand runs a proxy command as the command source and returns the messages
+	// sent to the source while the command ran. Commands of backend servers can't be run.
+	// Returns INVALID_ARGUMENT if the command is empty or its syntax is invalid.
+	// Returns NOT_FOUND if the command or the impersonated player is not found.
+	ExecuteCommand(context.Context, *connect.Request[v1.ExecuteCommandRequest]) (*connect.Response[v1.ExecuteCommandResponse], error)
+	// SuggestCommand returns the completion suggestions of a partial proxy command
+	// for the command source, e.g. for autocompletion.
+	// Returns NOT_FOUND if the impersonated player is not found.
+	SuggestCommand(context.Context, *connect.Request[v1.SuggestCommandRequest]) (*connect.Response[v1.SuggestCommandResponse], error)
 }
 
 // NewGateServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -414,6 +1095,180 @@ func NewGateServiceHandler(svc GateServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gateServiceMethods.ByName("ApplyConfig")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gateServiceGetPermissionsHandler := connect.NewUnaryHandler(
+		GateServiceGetPermissionsProcedure,
+		svc.GetPermissions,
+		connect.WithSchema(gateServiceMethods.ByName("GetPermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceCheckPermissionHandler := connect.NewUnaryHandler(
+		GateServiceCheckPermissionProcedure,
+		svc.CheckPermission,
+		connect.WithSchema(gateServiceMethods.ByName("CheckPermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSetPermissionGroupHandler := connect.NewUnaryHandler(
+		GateServiceSetPermissionGroupProcedure,
+		svc.SetPermissionGroup,
+		connect.WithSchema(gateServiceMethods.ByName("SetPermissionGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceDeletePermissionGroupHandler := connect.NewUnaryHandler(
+		GateServiceDeletePermissionGroupProcedure,
+		svc.DeletePermissionGroup,
+		connect.WithSchema(gateServiceMethods.ByName("DeletePermissionGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSetPlayerPermissionsHandler := connect.NewUnaryHandler(
+		GateServiceSetPlayerPermissionsProcedure,
+		svc.SetPlayerPermissions,
+		connect.WithSchema(gateServiceMethods.ByName("SetPlayerPermissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceListQueuesHandler := connect.NewUnaryHandler(
+		GateServiceListQueuesProcedure,
+		svc.ListQueues,
+		connect.WithSchema(gateServiceMethods.ByName("ListQueues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceEnqueuePlayerHandler := connect.NewUnaryHandler(
+		GateServiceEnqueuePlayerProcedure,
+		svc.EnqueuePlayer,
+		connect.WithSchema(gateServiceMethods.ByName("EnqueuePlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceDequeuePlayerHandler := connect.NewUnaryHandler(
+		GateServiceDequeuePlayerProcedure,
+		svc.DequeuePlayer,
+		connect.WithSchema(gateServiceMethods.ByName("DequeuePlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceClearQueueHandler := connect.NewUnaryHandler(
+		GateServiceClearQueueProcedure,
+		svc.ClearQueue,
+		connect.WithSchema(gateServiceMethods.ByName("ClearQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceListPunishmentsHandler := connect.NewUnaryHandler(
+		GateServiceListPunishmentsProcedure,
+		svc.ListPunishments,
+		connect.WithSchema(gateServiceMethods.ByName("ListPunishments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceAddPunishmentHandler := connect.NewUnaryHandler(
+		GateServiceAddPunishmentProcedure,
+		svc.AddPunishment,
+		connect.WithSchema(gateServiceMethods.ByName("AddPunishment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceRemovePunishmentHandler := connect.NewUnaryHandler(
+		GateServiceRemovePunishmentProcedure,
+		svc.RemovePunishment,
+		connect.WithSchema(gateServiceMethods.ByName("RemovePunishment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceListChatChannelsHandler := connect.NewUnaryHandler(
+		GateServiceListChatChannelsProcedure,
+		svc.ListChatChannels,
+		connect.WithSchema(gateServiceMethods.ByName("ListChatChannels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSendChatChannelMessageHandler := connect.NewUnaryHandler(
+		GateServiceSendChatChannelMessageProcedure,
+		svc.SendChatChannelMessage,
+		connect.WithSchema(gateServiceMethods.ByName("SendChatChannelMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceGetMaintenanceHandler := connect.NewUnaryHandler(
+		GateServiceGetMaintenanceProcedure,
+		svc.GetMaintenance,
+		connect.WithSchema(gateServiceMethods.ByName("GetMaintenance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSetMaintenanceHandler := connect.NewUnaryHandler(
+		GateServiceSetMaintenanceProcedure,
+		svc.SetMaintenance,
+		connect.WithSchema(gateServiceMethods.ByName("SetMaintenance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceListWhitelistHandler := connect.NewUnaryHandler(
+		GateServiceListWhitelistProcedure,
+		svc.ListWhitelist,
+		connect.WithSchema(gateServiceMethods.ByName("ListWhitelist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceAddWhitelistEntryHandler := connect.NewUnaryHandler(
+		GateServiceAddWhitelistEntryProcedure,
+		svc.AddWhitelistEntry,
+		connect.WithSchema(gateServiceMethods.ByName("AddWhitelistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceRemoveWhitelistEntryHandler := connect.NewUnaryHandler(
+		GateServiceRemoveWhitelistEntryProcedure,
+		svc.RemoveWhitelistEntry,
+		connect.WithSchema(gateServiceMethods.ByName("RemoveWhitelistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		GateServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		connect.WithSchema(gateServiceMethods.ByName("WatchEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSendMessageHandler := connect.NewUnaryHandler(
+		GateServiceSendMessageProcedure,
+		svc.SendMessage,
+		connect.WithSchema(gateServiceMethods.ByName("SendMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSendActionBarHandler := connect.NewUnaryHandler(
+		GateServiceSendActionBarProcedure,
+		svc.SendActionBar,
+		connect.WithSchema(gateServiceMethods.ByName("SendActionBar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceShowTitleHandler := connect.NewUnaryHandler(
+		GateServiceShowTitleProcedure,
+		svc.ShowTitle,
+		connect.WithSchema(gateServiceMethods.ByName("ShowTitle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServicePlaySoundHandler := connect.NewUnaryHandler(
+		GateServicePlaySoundProcedure,
+		svc.PlaySound,
+		connect.WithSchema(gateServiceMethods.ByName("PlaySound")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceShowBossBarHandler := connect.NewUnaryHandler(
+		GateServiceShowBossBarProcedure,
+		svc.ShowBossBar,
+		connect.WithSchema(gateServiceMethods.ByName("ShowBossBar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceHideBossBarHandler := connect.NewUnaryHandler(
+		GateServiceHideBossBarProcedure,
+		svc.HideBossBar,
+		connect.WithSchema(gateServiceMethods.ByName("HideBossBar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSendResourcePackHandler := connect.NewUnaryHandler(
+		GateServiceSendResourcePackProcedure,
+		svc.SendResourcePack,
+		connect.WithSchema(gateServiceMethods.ByName("SendResourcePack")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceExecuteCommandHandler := connect.NewUnaryHandler(
+		GateServiceExecuteCommandProcedure,
+		svc.ExecuteCommand,
+		connect.WithSchema(gateServiceMethods.ByName("ExecuteCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gateServiceSuggestCommandHandler := connect.NewUnaryHandler(
+		GateServiceSuggestCommandProcedure,
+		svc.SuggestCommand,
+		connect.WithSchema(gateServiceMethods.ByName("SuggestCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/minekube.gate.v1.GateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GateServiceGetPlayerProcedure:
@@ -442,6 +1297,64 @@ func NewGateServiceHandler(svc GateServiceHandler, opts ...connect.HandlerOption
 			gateServiceValidateConfigHandler.ServeHTTP(w, r)
 		case GateServiceApplyConfigProcedure:
 			gateServiceApplyConfigHandler.ServeHTTP(w, r)
+		case GateServiceGetPermissionsProcedure:
+			gateServiceGetPermissionsHandler.ServeHTTP(w, r)
+		case GateServiceCheckPermissionProcedure:
+			gateServiceCheckPermissionHandler.ServeHTTP(w, r)
+		case GateServiceSetPermissionGroupProcedure:
+			gateServiceSetPermissionGroupHandler.ServeHTTP(w, r)
+		case GateServiceDeletePermissionGroupProcedure:
+			gateServiceDeletePermissionGroupHandler.ServeHTTP(w, r)
+		case GateServiceSetPlayerPermissionsProcedure:
+			gateServiceSetPlayerPermissionsHandler.ServeHTTP(w, r)
+		case GateServiceListQueuesProcedure:
+			gateServiceListQueuesHandler.ServeHTTP(w, r)
+		case GateServiceEnqueuePlayerProcedure:
+			gateServiceEnqueuePlayerHandler.ServeHTTP(w, r)
+		case GateServiceDequeuePlayerProcedure:
+			gateServiceDequeuePlayerHandler.ServeHTTP(w, r)
+		case GateServiceClearQueueProcedure:
+			gateServiceClearQueueHandler.ServeHTTP(w, r)
+		case GateServiceListPunishmentsProcedure:
+			gateServiceListPunishmentsHandler.ServeHTTP(w, r)
+		case GateServiceAddPunishmentProcedure:
+			gateServiceAddPunishmentHandler.ServeHTTP(w, r)
+		case GateServiceRemovePunishmentProcedure:
+			gateServiceRemovePunishmentHandler.ServeHTTP(w, r)
+		case GateServiceListChatChannelsProcedure:
+			gateServiceListChatChannelsHandler.ServeHTTP(w, r)
+		case GateServiceSendChatChannelMessageProcedure:
+			gateServiceSendChatChannelMessageHandler.ServeHTTP(w, r)
+		case GateServiceGetMaintenanceProcedure:
+			gateServiceGetMaintenanceHandler.ServeHTTP(w, r)
+		case GateServiceSetMaintenanceProcedure:
+			gateServiceSetMaintenanceHandler.ServeHTTP(w, r)
+		case GateServiceListWhitelistProcedure:
+			gateServiceListWhitelistHandler.ServeHTTP(w, r)
+		case GateServiceAddWhitelistEntryProcedure:
+			gateServiceAddWhitelistEntryHandler.ServeHTTP(w, r)
+		case GateServiceRemoveWhitelistEntryProcedure:
+			gateServiceRemoveWhitelistEntryHandler.ServeHTTP(w, r)
+		case GateServiceWatchEventsProcedure:
+			gateServiceWatchEventsHandler.ServeHTTP(w, r)
+		case GateServiceSendMessageProcedure:
+			gateServiceSendMessageHandler.ServeHTTP(w, r)
+		case GateServiceSendActionBarProcedure:
+			gateServiceSendActionBarHandler.ServeHTTP(w, r)
+		case GateServiceShowTitleProcedure:
+			gateServiceShowTitleHandler.ServeHTTP(w, r)
+		case GateServicePlaySoundProcedure:
+			gateServicePlaySoundHandler.ServeHTTP(w, r)
+		case GateServiceShowBossBarProcedure:
+			gateServiceShowBossBarHandler.ServeHTTP(w, r)
+		case GateServiceHideBossBarProcedure:
+			gateServiceHideBossBarHandler.ServeHTTP(w, r)
+		case GateServiceSendResourcePackProcedure:
+			gateServiceSendResourcePackHandler.ServeHTTP(w, r)
+		case GateServiceExecuteCommandProcedure:
+			gateServiceExecuteCommandHandler.ServeHTTP(w, r)
+		case GateServiceSuggestCommandProcedure:
+			gateServiceSuggestCommandHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -502,3 +1415,119 @@ func (UnimplementedGateServiceHandler) ValidateConfig(context.Context, *connect.
 func (UnimplementedGateServiceHandler) ApplyConfig(context.Context, *connect.Request[v1.ApplyConfigRequest]) (*connect.Response[v1.ApplyConfigResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ApplyConfig is not implemented"))
 }
+
+func (UnimplementedGateServiceHandler) GetPermissions(context.Context, *connect.Request[v1.GetPermissionsRequest]) (*connect.Response[v1.GetPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.GetPermissions is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) CheckPermission(context.Context, *connect.Request[v1.CheckPermissionRequest]) (*connect.Response[v1.CheckPermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.CheckPermission is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SetPermissionGroup(context.Context, *connect.Request[v1.SetPermissionGroupRequest]) (*connect.Response[v1.SetPermissionGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SetPermissionGroup is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) DeletePermissionGroup(context.Context, *connect.Request[v1.DeletePermissionGroupRequest]) (*connect.Response[v1.DeletePermissionGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.DeletePermissionGroup is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SetPlayerPermissions(context.Context, *connect.Request[v1.SetPlayerPermissionsRequest]) (*connect.Response[v1.SetPlayerPermissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SetPlayerPermissions is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ListQueues(context.Context, *connect.Request[v1.ListQueuesRequest]) (*connect.Response[v1.ListQueuesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ListQueues is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) EnqueuePlayer(context.Context, *connect.Request[v1.EnqueuePlayerRequest]) (*connect.Response[v1.EnqueuePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.EnqueuePlayer is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) DequeuePlayer(context.Context, *connect.Request[v1.DequeuePlayerRequest]) (*connect.Response[v1.DequeuePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.DequeuePlayer is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ClearQueue(context.Context, *connect.Request[v1.ClearQueueRequest]) (*connect.Response[v1.ClearQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ClearQueue is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ListPunishments(context.Context, *connect.Request[v1.ListPunishmentsRequest]) (*connect.Response[v1.ListPunishmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ListPunishments is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) AddPunishment(context.Context, *connect.Request[v1.AddPunishmentRequest]) (*connect.Response[v1.AddPunishmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.AddPunishment is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) RemovePunishment(context.Context, *connect.Request[v1.RemovePunishmentRequest]) (*connect.Response[v1.RemovePunishmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.RemovePunishment is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ListChatChannels(context.Context, *connect.Request[v1.ListChatChannelsRequest]) (*connect.Response[v1.ListChatChannelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ListChatChannels is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SendChatChannelMessage(context.Context, *connect.Request[v1.SendChatChannelMessageRequest]) (*connect.Response[v1.SendChatChannelMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SendChatChannelMessage is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) GetMaintenance(context.Context, *connect.Request[v1.GetMaintenanceRequest]) (*connect.Response[v1.GetMaintenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.GetMaintenance is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SetMaintenance(context.Context, *connect.Request[v1.SetMaintenanceRequest]) (*connect.Response[v1.SetMaintenanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SetMaintenance is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ListWhitelist(context.Context, *connect.Request[v1.ListWhitelistRequest]) (*connect.Response[v1.ListWhitelistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ListWhitelist is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) AddWhitelistEntry(context.Context, *connect.Request[v1.AddWhitelistEntryRequest]) (*connect.Response[v1.AddWhitelistEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.AddWhitelistEntry is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) RemoveWhitelistEntry(context.Context, *connect.Request[v1.RemoveWhitelistEntryRequest]) (*connect.Response[v1.RemoveWhitelistEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.RemoveWhitelistEntry is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) WatchEvents(context.Context, *connect.Request[v1.WatchEventsRequest], *connect.ServerStream[v1.WatchEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.WatchEvents is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SendMessage is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SendActionBar(context.Context, *connect.Request[v1.SendActionBarRequest]) (*connect.Response[v1.SendActionBarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SendActionBar is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ShowTitle(context.Context, *connect.Request[v1.ShowTitleRequest]) (*connect.Response[v1.ShowTitleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ShowTitle is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) PlaySound(context.Context, *connect.Request[v1.PlaySoundRequest]) (*connect.Response[v1.PlaySoundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.PlaySound is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ShowBossBar(context.Context, *connect.Request[v1.ShowBossBarRequest]) (*connect.Response[v1.ShowBossBarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ShowBossBar is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) HideBossBar(context.Context, *connect.Request[v1.HideBossBarRequest]) (*connect.Response[v1.HideBossBarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.HideBossBar is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SendResourcePack(context.Context, *connect.Request[v1.SendResourcePackRequest]) (*connect.Response[v1.SendResourcePackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SendResourcePack is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) ExecuteCommand(context.Context, *connect.Request[v1.ExecuteCommandRequest]) (*connect.Response[v1.ExecuteCommandResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.ExecuteCommand is not implemented"))
+}
+
+func (UnimplementedGateServiceHandler) SuggestCommand(context.Context, *connect.Request[v1.SuggestCommandRequest]) (*connect.Response[v1.SuggestCommandResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("minekube.gate.v1.GateService.SuggestCommand is not implemented"))
+}
@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.minekube.com/common/minecraft/component"
+	"go.minekube.com/common/minecraft/key"
+
+	"go.minekube.com/gate/pkg/edition/java/bossbar"
+	"go.minekube.com/gate/pkg/edition/java/proto/version"
+	"go.minekube.com/gate/pkg/edition/java/proxy"
+	"go.minekube.com/gate/pkg/edition/java/sound"
+	"go.minekube.com/gate/pkg/edition/java/title"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/componentutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func (s *Service) SendMessage(ctx context.Context, c *connect.Request[pb.SendMessageRequest]) (*connect.Response[pb.SendMessageResponse], error) {
+	players, err := s.audience(c.Msg.GetAudience())
+	if err != nil {
+		return nil, err
+	}
+	msg, err := parseMessage("message", c.Msg.GetMessage())
+	if err != nil {
+		return nil, err
+	}
+	n := forEachPlayer(players, func(p proxy.Player) error {
+		return p.SendMessage(msg)
+	})
+	return connect.NewResponse(&pb.SendMessageResponse{Players: n}), nil
+}
+
+func (s *Service) SendActionBar(ctx context.Context, c *connect.Request[pb.SendActionBarRequest]) (*connect.Response[pb.SendActionBarResponse], error) {
+	players, err := s.audience(c.Msg.GetAudience())
+	if err != nil {
+		return nil, err
+	}
+	msg, err := parseMessage("message", c.Msg.GetMessage())
+	if err != nil {
+		return nil, err
+	}
+	n := forEachPlayer(players, func(p proxy.Player) error {
+		return p.SendActionBar(msg)
+	})
+	return connect.NewResponse(&pb.SendActionBarResponse{Players: n}), nil
+}
+
+func (s *Service) ShowTitle(ctx context.Context, c *connect.Request[pb.ShowTitleRequest]) (*connect.Response[pb.ShowTitleResponse], error) {
+	players, err := s.audience(c.Msg.GetAudience())
+	if err != nil {
+		return nil, err
+	}
+	if c.Msg.GetTitle() == "" && c.Msg.GetSubtitle() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("title or subtitle must be set"))
+	}
+	opts := &title.Options{
+		FadeIn:  time.Duration(c.Msg.GetFadeInMs()) * time.Millisecond,
+		Stay:    time.Duration(c.Msg.GetStayMs()) * time.Millisecond,
+		FadeOut: time.Duration(c.Msg.GetFadeOutMs()) * time.Millisecond,
+	}
+	if c.Msg.GetTitle() != "" {
+		if opts.Title, err = parseMessage("title", c.Msg.GetTitle()); err != nil {
+			return nil, err
+		}
+	}
+	if c.Msg.GetSubtitle() != "" {
+		if opts.Subtitle, err = parseMessage("subtitle", c.Msg.GetSubtitle()); err != nil {
+			return nil, err
+		}
+	}
+	n := forEachPlayer(players, func(p proxy.Player) error {
+		return title.ShowTitle(p, opts)
+	})
+	return connect.NewResponse(&pb.ShowTitleResponse{Players: n}), nil
+}
+
+func (s *Service) PlaySound(ctx context.Context, c *connect.Request[pb.PlaySoundRequest]) (*connect.Response[pb.PlaySoundResponse], error) {
+	players, err := s.audience(c.Msg.GetAudience())
+	if err != nil {
+		return nil, err
+	}
+	name := c.Msg.GetSound()
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("sound must be set"))
+	}
+	if !strings.Contains(name, ":") {
+		name = key.MinecraftNamespace + ":" + name
+	}
+	snd := sound.Sound{Source: sound.SourceMaster, Volume: 1, Pitch: 1}
+	if snd.Name, err = key.Parse(name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid sound: %v", err))
+	}
+	if c.Msg.GetSource() != "" {
+		if snd.Source, err = sound.ParseSource(c.Msg.GetSource()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid source: %v", err))
+		}
+	}
+	if c.Msg.Volume != nil {
+		snd.Volume = c.Msg.GetVolume()
+	}
+	if c.Msg.Pitch != nil {
+		snd.Pitch = c.Msg.GetPitch()
+	}
+	n := forEachPlayer(players, func(p proxy.Player) error {
+		return sound.Play(p, snd, p)
+	})
+	return connect.NewResponse(&pb.PlaySoundResponse{Players: n}), nil
+}
+
+func (s *Service) ShowBossBar(ctx context.Context, c *connect.Request[pb.ShowBossBarRequest]) (*connect.Response[pb.ShowBossBarResponse], error) {
+	players, err := s.audience(c.Msg.GetAudience())
+	if err != nil {
+		return nil, err
+	}
+	name, err := parseMessage("name", c.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+	progress := min(max(c.Msg.GetProgress(), bossbar.MinProgress), bossbar.MaxProgress)
+	bar := bossbar.New(name, progress,
+		bossBarColorFromProto(c.Msg.GetColor()),
+		bossBarOverlayFromProto(c.Msg.GetOverlay()))
+	n := forEachPlayer(players, func(p proxy.Player) error {
+		return bar.AddViewer(p)
+	})
+
+	s.bossBarsMu.Lock()
+	s.pruneBossBars()
+	s.bossBars[bar.ID()] = bar
+	s.bossBarsMu.Unlock()
+	if d := time.Duration(c.Msg.GetDurationMs()) * time.Millisecond; d > 0 {
+		time.AfterFunc(d, func() { s.hideBossBar(bar.ID()) })
+	}
+
+	return connect.NewResponse(&pb.ShowBossBarResponse{
+		Id:      bar.ID().String(),
+		Players: n,
+	}), nil
+}
+
+func (s *Service) HideBossBar(ctx context.Context, c *connect.Request[pb.HideBossBarRequest]) (*connect.Response[pb.HideBossBarResponse], error) {
+	id, err := uuid.Parse(c.Msg.GetId())
+	if err != nil || !s.hideBossBar(id) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("boss bar not found"))
+	}
+	return connect.NewResponse(&pb.HideBossBarResponse{}), nil
+}
+
+// hideBossBar removes the boss bar shown by ShowBossBar from its viewers.
+// Returns false if the boss bar does not exist (anymore).
+func (s *Service) hideBossBar(id uuid.UUID) bool {
+	s.bossBarsMu.Lock()
+	bar, ok := s.bossBars[id]
+	delete(s.bossBars, id)
+	s.bossBarsMu.Unlock()
+	if ok {
+		bossbar.RemoveAllViewers(bar)
+	}
+	return ok
+}
+
+// pruneBossBars forgets the boss bars shown by ShowBossBar whose viewers all disconnected,
+// so boss bars without duration don't pile up. The caller must hold bossBarsMu.
+func (s *Service) pruneBossBars() {
+	for id, bar := range s.bossBars {
+		if len(bar.Viewers()) == 0 {
+			delete(s.bossBars, id)
+		}
+	}
+}
+
+func (s *Service) SendResourcePack(ctx context.Context, c *connect.Request[pb.SendResourcePackRequest]) (*connect.Response[pb.SendResourcePackResponse], error) {
+	players, err := s.audience(c.Msg.GetAudience())
+	if err != nil {
+		return nil, err
+	}
+	if c.Msg.GetUrl() == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("url must be set"))
+	}
+	info := proxy.ResourcePackInfo{
+		ID:          uuid.New(),
+		URL:         c.Msg.GetUrl(),
+		ShouldForce: c.Msg.GetRequired(),
+		Origin:      proxy.PluginOnProxyResourcePackOrigin,
+	}
+	if c.Msg.GetHash() != "" {
+		info.Hash, err = hex.DecodeString(c.Msg.GetHash())
+		if err != nil || len(info.Hash) != 20 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("hash must be a hex encoded SHA-1 hash"))
+		}
+	}
+	if c.Msg.GetPrompt() != "" {
+		if info.Prompt, err = parseMessage("prompt", c.Msg.GetPrompt()); err != nil {
+			return nil, err
+		}
+	}
+	n := forEachPlayer(players, func(p proxy.Player) error {
+		return p.SendResourcePack(info)
+	})
+	return connect.NewResponse(&pb.SendResourcePackResponse{Players: n}), nil
+}
+
+// audience returns the online players selected by the audience.
+func (s *Service) audience(a *pb.Audience) ([]proxy.Player, error) {
+	if len(a.GetPlayers()) == 0 && len(a.GetServers()) == 0 && !a.GetAll() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("audience must select players, servers or all"))
+	}
+	var players []proxy.Player
+	if a.GetAll() {
+		players = s.p.Players()
+	} else {
+		seen := map[uuid.UUID]bool{}
+		add := func(p proxy.Player) {
+			if !seen[p.ID()] {
+				seen[p.ID()] = true
+				players = append(players, p)
+			}
+		}
+		for _, name := range a.GetPlayers() {
+			if p := s.player(name); p != nil {
+				add(p)
+			}
+		}
+		for _, name := range a.GetServers() {
+			if svr := s.p.Server(name); svr != nil {
+				svr.Players().Range(func(p proxy.Player) bool {
+					add(p)
+					return true
+				})
+			}
+		}
+	}
+	if len(players) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no player of the audience is online"))
+	}
+	return players, nil
+}
+
+// forEachPlayer calls fn for each player and returns the number of players it succeeded for.
+func forEachPlayer(players []proxy.Player, fn func(proxy.Player) error) int32 {
+	var n int32
+	for _, p := range players {
+		if fn(p) == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// parseMessage parses a JSON, MiniMessage-like or legacy text message of a request field.
+func parseMessage(field, s string) (component.Component, error) {
+	c, err := componentutil.ParseMessage(version.MaximumVersion.Protocol, s)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("could not parse %s: %v", field, err))
+	}
+	return c, nil
+}
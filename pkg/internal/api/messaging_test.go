@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/edition/java/bossbar"
+	"go.minekube.com/gate/pkg/gate/proto"
+	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestMessagingRequiresAudience(t *testing.T) {
+	s := &Service{}
+	_, err := s.SendMessage(context.Background(), connect.NewRequest(&pb.SendMessageRequest{Message: "<red>Hello"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = s.ShowBossBar(context.Background(), connect.NewRequest(&pb.ShowBossBarRequest{
+		Audience: &pb.Audience{},
+		Name:     "Event",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestHideBossBarNotFound(t *testing.T) {
+	s := NewService(nil, nil)
+	_, err := s.HideBossBar(context.Background(), connect.NewRequest(&pb.HideBossBarRequest{Id: "invalid"}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = s.HideBossBar(context.Background(), connect.NewRequest(&pb.HideBossBarRequest{
+		Id: "550e8400-e29b-41d4-a716-446655440000",
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+type bossBarTestViewer struct {
+	id  uuid.UUID
+	ctx context.Context
+}
+
+func (v *bossBarTestViewer) ID() uuid.UUID                  { return v.id }
+func (v *bossBarTestViewer) Context() context.Context       { return v.ctx }
+func (v *bossBarTestViewer) WritePacket(proto.Packet) error { return nil }
+
+func TestPruneBossBars(t *testing.T) {
+	s := NewService(nil, nil)
+	ctx, disconnect := context.WithCancel(context.Background())
+	defer disconnect()
+	newBar := func() bossbar.BossBar {
+		bar := bossbar.New(&component.Text{Content: "Event"}, bossbar.MaxProgress, bossbar.RedColor, bossbar.ProgressOverlay)
+		s.bossBars[bar.ID()] = bar
+		return bar
+	}
+	shown := newBar()
+	require.NoError(t, shown.AddViewer(&bossBarTestViewer{id: uuid.New(), ctx: ctx}))
+	hidden := newBar()
+
+	s.bossBarsMu.Lock()
+	s.pruneBossBars()
+	s.bossBarsMu.Unlock()
+	require.Contains(t, s.bossBars, shown.ID())
+	require.NotContains(t, s.bossBars, hidden.ID(), "boss bars without viewers are forgotten")
+
+	disconnect()
+	require.Eventually(t, func() bool {
+		s.bossBarsMu.Lock()
+		defer s.bossBarsMu.Unlock()
+		s.pruneBossBars()
+		return len(s.bossBars) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -2,9 +2,14 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
@@ -31,21 +36,30 @@ type Server struct {
 
 func (s *Server) Start(ctx context.Context) error {
 	log := logr.FromContextOrDiscard(ctx)
-	log.Info("starting api service", "bind", s.cfg.Bind)
+	log.Info("starting api service", "bind", s.cfg.Bind,
+		"tls", s.cfg.TLS.Enabled(), "auth", s.cfg.Auth.Enabled())
 
 	otelInterceptor, err := otelconnect.NewInterceptor()
 	if err != nil {
 		return err
 	}
+	authInterceptor := newAuthInterceptor(s.cfg.Auth, log.WithName("audit"))
+
+	tlsConfig, err := s.tlsConfig()
+	if err != nil {
+		return err
+	}
 
 	mux := http.NewServeMux()
-	mux.Handle(gatev1connect.NewGateServiceHandler(s.h, connect.WithInterceptors(otelInterceptor)))
+	mux.Handle(gatev1connect.NewGateServiceHandler(s.h,
+		connect.WithInterceptors(otelInterceptor, authInterceptor)))
 
 	hs := &http.Server{
 		Addr: s.cfg.Bind,
-		Handler: h2c.NewHandler(mux, &http2.Server{
+		Handler: h2c.NewHandler(withClientCert(withoutDeadlines(mux, gatev1connect.GateServiceWatchEventsProcedure)), &http2.Server{
 			IdleTimeout: time.Second * 30,
 		}),
+		TLSConfig:         tlsConfig,
 		ReadTimeout:       time.Second * 5,
 		ReadHeaderTimeout: time.Second * 5,
 		WriteTimeout:      time.Second * 10,
@@ -61,11 +75,59 @@ func (s *Server) Start(ctx context.Context) error {
 		defer cancel()
 		return hs.Shutdown(stopCtx)
 	})
-	eg.Go(func() error { return ignoreClosed(hs.ListenAndServe()) })
+	eg.Go(func() error {
+		if tlsConfig != nil {
+			// Certificates are already loaded into the TLS config
+			return ignoreClosed(hs.ListenAndServeTLS("", ""))
+		}
+		return ignoreClosed(hs.ListenAndServe())
+	})
 
 	return eg.Wait()
 }
 
+// tlsConfig returns the TLS config of the server or nil if TLS is disabled.
+func (s *Server) tlsConfig() (*tls.Config, error) {
+	c := s.cfg.TLS
+	if !c.Enabled() {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading api tls certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading api tls client CA file: %w", err)
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in api tls client CA file %q", c.ClientCAFile)
+		}
+		// Clients may still authenticate with a bearer token instead
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+// withoutDeadlines clears the read and write deadlines of the server for the
+// streaming RPC procedures, which stay open until the client cancels them.
+func withoutDeadlines(h http.Handler, procedures ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(procedures, r.URL.Path) {
+			rc := http.NewResponseController(w)
+			_ = rc.SetReadDeadline(time.Time{})
+			_ = rc.SetWriteDeadline(time.Time{})
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
 func ignoreClosed(err error) error {
 	if errors.Is(err, http.ErrServerClosed) {
 		return nil
@@ -4,17 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"go.minekube.com/common/minecraft/component"
 	"go.minekube.com/common/minecraft/key"
 
+	"go.minekube.com/gate/pkg/command"
+	"go.minekube.com/gate/pkg/edition/java/bossbar"
 	"go.minekube.com/gate/pkg/edition/java/cookie"
 	"go.minekube.com/gate/pkg/edition/java/proxy"
 	pb "go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1"
 	"go.minekube.com/gate/pkg/internal/api/gen/minekube/gate/v1/gatev1connect"
 	"go.minekube.com/gate/pkg/util/componentutil"
 	"go.minekube.com/gate/pkg/util/netutil"
+	"go.minekube.com/gate/pkg/util/punishment"
 	"go.minekube.com/gate/pkg/util/uuid"
 )
 
@@ -26,16 +32,42 @@ type ConfigHandler interface {
 	ApplyConfig(context.Context, *pb.ApplyConfigRequest) (*pb.ApplyConfigResponse, error)
 }
 
-func NewService(p *proxy.Proxy, configHandler ConfigHandler) *Service {
-	return &Service{
+// PermissionHandler defines methods for managing the built-in permission provider
+type PermissionHandler interface {
+	GetPermissions(context.Context, *pb.GetPermissionsRequest) (*pb.GetPermissionsResponse, error)
+	CheckPermission(context.Context, *pb.CheckPermissionRequest) (*pb.CheckPermissionResponse, error)
+	SetPermissionGroup(context.Context, *pb.SetPermissionGroupRequest) (*pb.SetPermissionGroupResponse, error)
+	DeletePermissionGroup(context.Context, *pb.DeletePermissionGroupRequest) (*pb.DeletePermissionGroupResponse, error)
+	SetPlayerPermissions(context.Context, *pb.SetPlayerPermissionsRequest) (*pb.SetPlayerPermissionsResponse, error)
+}
+
+// ServiceOption configures optional handlers of a Service.
+type ServiceOption func(*Service)
+
+// WithPermissionHandler sets the handler of the permission methods.
+func WithPermissionHandler(h PermissionHandler) ServiceOption {
+	return func(s *Service) { s.permissionHandler = h }
+}
+
+func NewService(p *proxy.Proxy, configHandler ConfigHandler, opts ...ServiceOption) *Service {
+	s := &Service{
 		p:             p,
 		configHandler: configHandler,
+		bossBars:      map[uuid.UUID]bossbar.BossBar{},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 type Service struct {
-	p             *proxy.Proxy
-	configHandler ConfigHandler
+	p                 *proxy.Proxy
+	configHandler     ConfigHandler
+	permissionHandler PermissionHandler
+
+	bossBarsMu sync.Mutex
+	bossBars   map[uuid.UUID]bossbar.BossBar // shown by ShowBossBar
 }
 
 var _ gatev1connect.GateServiceHandler = (*Service)(nil)
@@ -54,6 +86,11 @@ func (s *Service) ListPlayers(ctx context.Context, c *connect.Request[pb.ListPla
 			}
 		}
 	}
+	if c.Msg.PlayerIsBedrock != nil {
+		players = slices.DeleteFunc(players, func(p proxy.Player) bool {
+			return (p.BedrockData() != nil) != c.Msg.GetPlayerIsBedrock()
+		})
+	}
 	return connect.NewResponse(&pb.ListPlayersResponse{
 		Players: PlayersToProto(players),
 	}), nil
@@ -291,3 +328,336 @@ func (s *Service) ApplyConfig(ctx context.Context, c *connect.Request[pb.ApplyCo
 	}
 	return connect.NewResponse(response), nil
 }
+
+func (s *Service) GetPermissions(ctx context.Context, c *connect.Request[pb.GetPermissionsRequest]) (*connect.Response[pb.GetPermissionsResponse], error) {
+	if s.permissionHandler == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permission handler not configured"))
+	}
+	resp, err := s.permissionHandler.GetPermissions(ctx, c.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) CheckPermission(ctx context.Context, c *connect.Request[pb.CheckPermissionRequest]) (*connect.Response[pb.CheckPermissionResponse], error) {
+	if s.permissionHandler == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permission handler not configured"))
+	}
+	resp, err := s.permissionHandler.CheckPermission(ctx, c.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) SetPermissionGroup(ctx context.Context, c *connect.Request[pb.SetPermissionGroupRequest]) (*connect.Response[pb.SetPermissionGroupResponse], error) {
+	if s.permissionHandler == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permission handler not configured"))
+	}
+	resp, err := s.permissionHandler.SetPermissionGroup(ctx, c.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) DeletePermissionGroup(ctx context.Context, c *connect.Request[pb.DeletePermissionGroupRequest]) (*connect.Response[pb.DeletePermissionGroupResponse], error) {
+	if s.permissionHandler == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permission handler not configured"))
+	}
+	resp, err := s.permissionHandler.DeletePermissionGroup(ctx, c.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) SetPlayerPermissions(ctx context.Context, c *connect.Request[pb.SetPlayerPermissionsRequest]) (*connect.Response[pb.SetPlayerPermissionsResponse], error) {
+	if s.permissionHandler == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("permission handler not configured"))
+	}
+	resp, err := s.permissionHandler.SetPlayerPermissions(ctx, c.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) ListQueues(ctx context.Context, c *connect.Request[pb.ListQueuesRequest]) (*connect.Response[pb.ListQueuesResponse], error) {
+	servers := c.Msg.Servers
+	if len(servers) == 0 {
+		servers = s.p.Queues().Servers()
+	}
+	var queues []*pb.Queue
+	for _, server := range servers {
+		entries := s.p.Queues().Entries(server)
+		if len(entries) == 0 {
+			continue
+		}
+		queues = append(queues, &pb.Queue{
+			Server:  entries[0].Server.ServerInfo().Name(),
+			Entries: QueueEntriesToProto(entries),
+		})
+	}
+	return connect.NewResponse(&pb.ListQueuesResponse{Queues: queues}), nil
+}
+
+func (s *Service) EnqueuePlayer(ctx context.Context, c *connect.Request[pb.EnqueuePlayerRequest]) (*connect.Response[pb.EnqueuePlayerResponse], error) {
+	player := s.player(c.Msg.Player)
+	if player == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+	}
+	server := s.p.Server(c.Msg.Server)
+	if server == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("server not found"))
+	}
+
+	var (
+		entry proxy.QueueEntry
+		err   error
+	)
+	if c.Msg.Priority != nil {
+		entry, err = s.p.Queues().EnqueueWithPriority(player, server, int(c.Msg.GetPriority()))
+	} else {
+		entry, err = s.p.Queues().Enqueue(player, server)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&pb.EnqueuePlayerResponse{Entry: QueueEntryToProto(entry)}), nil
+}
+
+func (s *Service) DequeuePlayer(ctx context.Context, c *connect.Request[pb.DequeuePlayerRequest]) (*connect.Response[pb.DequeuePlayerResponse], error) {
+	player := s.player(c.Msg.Player)
+	if player == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+	}
+	if !s.p.Queues().Dequeue(player) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player is not queued"))
+	}
+	return connect.NewResponse(&pb.DequeuePlayerResponse{}), nil
+}
+
+func (s *Service) ClearQueue(ctx context.Context, c *connect.Request[pb.ClearQueueRequest]) (*connect.Response[pb.ClearQueueResponse], error) {
+	removed := s.p.Queues().Clear(c.Msg.Server)
+	return connect.NewResponse(&pb.ClearQueueResponse{Removed: int32(removed)}), nil
+}
+
+func (s *Service) ListPunishments(ctx context.Context, c *connect.Request[pb.ListPunishmentsRequest]) (*connect.Response[pb.ListPunishmentsResponse], error) {
+	store := s.p.Punishments().Store()
+	if store == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, proxy.ErrPunishmentsDisabled)
+	}
+	var typ punishment.Type
+	if c.Msg.Type != pb.PunishmentType_PUNISHMENT_TYPE_UNSPECIFIED {
+		var err error
+		if typ, err = punishmentTypeFromProto(c.Msg.Type); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	return connect.NewResponse(&pb.ListPunishmentsResponse{
+		Punishments: PunishmentsToProto(store.List(typ)),
+	}), nil
+}
+
+func (s *Service) AddPunishment(ctx context.Context, c *connect.Request[pb.AddPunishmentRequest]) (*connect.Response[pb.AddPunishmentResponse], error) {
+	req := c.Msg
+	typ, err := punishmentTypeFromProto(req.Type)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	p := punishment.Punishment{
+		Type:   typ,
+		Name:   req.Username,
+		IP:     req.Ip,
+		Reason: req.Reason,
+		Issuer: req.Issuer,
+	}
+	if p.Issuer == "" {
+		p.Issuer = "api"
+	}
+	if req.Player != "" {
+		if id, err := uuid.Parse(req.Player); err == nil {
+			p.Player = id.String()
+		} else if player := s.p.PlayerByName(req.Player); player != nil {
+			p.Player = player.ID().String()
+			if p.Name == "" {
+				p.Name = player.Username()
+			}
+		} else {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+		}
+	}
+	if p.Player == "" && p.Name == "" && p.IP == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("one of player, username or ip must be set"))
+	}
+	if p.IP != "" {
+		if _, err = punishment.ParseIP(p.IP); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ip: %w", err))
+		}
+	}
+	if req.DurationSeconds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("duration must not be negative"))
+	}
+	if req.DurationSeconds > 0 {
+		p.Expires = time.Now().Add(time.Duration(req.DurationSeconds) * time.Second)
+	}
+
+	p, err = s.p.Punishments().Punish(p)
+	if err != nil {
+		return nil, punishmentErr(err)
+	}
+	return connect.NewResponse(&pb.AddPunishmentResponse{Punishment: PunishmentToProto(p)}), nil
+}
+
+func (s *Service) RemovePunishment(ctx context.Context, c *connect.Request[pb.RemovePunishmentRequest]) (*connect.Response[pb.RemovePunishmentResponse], error) {
+	req := c.Msg
+	store := s.p.Punishments().Store()
+	if store == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, proxy.ErrPunishmentsDisabled)
+	}
+
+	var removed []punishment.Punishment
+	if req.Id != "" {
+		p, ok, err := store.RevokeID(req.Id)
+		if err != nil {
+			return nil, punishmentErr(err)
+		}
+		if ok {
+			removed = append(removed, p)
+		}
+	} else {
+		typ, err := punishmentTypeFromProto(req.Type)
+		if err != nil || req.Target == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("either id or type and target must be set"))
+		}
+		if removed, err = store.Revoke(typ, req.Target); err != nil {
+			return nil, punishmentErr(err)
+		}
+	}
+	if len(removed) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no matching punishment found"))
+	}
+	return connect.NewResponse(&pb.RemovePunishmentResponse{Punishments: PunishmentsToProto(removed)}), nil
+}
+
+func punishmentErr(err error) error {
+	if errors.Is(err, proxy.ErrPunishmentsDisabled) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func (s *Service) ListChatChannels(ctx context.Context, c *connect.Request[pb.ListChatChannelsRequest]) (*connect.Response[pb.ListChatChannelsResponse], error) {
+	cc := s.p.ChatChannels()
+	names := cc.Channels()
+	if names == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, proxy.ErrChatChannelsDisabled)
+	}
+	channels := make([]*pb.ChatChannel, 0, len(names))
+	for _, name := range names {
+		ch, _ := cc.Channel(name)
+		channels = append(channels, ChatChannelToProto(name, ch, cc.Members(name)))
+	}
+	return connect.NewResponse(&pb.ListChatChannelsResponse{Channels: channels}), nil
+}
+
+func (s *Service) SendChatChannelMessage(ctx context.Context, c *connect.Request[pb.SendChatChannelMessageRequest]) (*connect.Response[pb.SendChatChannelMessageResponse], error) {
+	req := c.Msg
+	if req.Channel == "" || req.Message == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("channel and message must be set"))
+	}
+	var sender command.Source
+	if req.Player != "" {
+		player := s.player(req.Player)
+		if player == nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("player not found"))
+		}
+		sender = player
+	}
+	recipients, err := s.p.ChatChannels().Send(req.Channel, sender, req.Message)
+	if err != nil {
+		switch {
+		case errors.Is(err, proxy.ErrChatChannelsDisabled):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, proxy.ErrChatChannelNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, proxy.ErrChatChannelPermission):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SendChatChannelMessageResponse{Recipients: int32(recipients)}), nil
+}
+
+func (s *Service) GetMaintenance(ctx context.Context, c *connect.Request[pb.GetMaintenanceRequest]) (*connect.Response[pb.GetMaintenanceResponse], error) {
+	m := s.p.Maintenance()
+	return connect.NewResponse(&pb.GetMaintenanceResponse{
+		Enabled: m.Enabled(),
+		Servers: m.Servers(),
+	}), nil
+}
+
+func (s *Service) SetMaintenance(ctx context.Context, c *connect.Request[pb.SetMaintenanceRequest]) (*connect.Response[pb.SetMaintenanceResponse], error) {
+	req := c.Msg
+	m := s.p.Maintenance()
+	if req.Server == "" {
+		kicked := m.SetEnabled(req.Enabled)
+		return connect.NewResponse(&pb.SetMaintenanceResponse{Kicked: int32(kicked)}), nil
+	}
+	if err := m.SetServerEnabled(req.Server, req.Enabled); err != nil {
+		if errors.Is(err, proxy.ErrMaintenanceServerNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.SetMaintenanceResponse{}), nil
+}
+
+func (s *Service) ListWhitelist(ctx context.Context, c *connect.Request[pb.ListWhitelistRequest]) (*connect.Response[pb.ListWhitelistResponse], error) {
+	store := s.p.Whitelist().Store()
+	if store == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, proxy.ErrWhitelistDisabled)
+	}
+	return connect.NewResponse(&pb.ListWhitelistResponse{
+		Entries: WhitelistEntriesToProto(store.List()),
+	}), nil
+}
+
+func (s *Service) AddWhitelistEntry(ctx context.Context, c *connect.Request[pb.AddWhitelistEntryRequest]) (*connect.Response[pb.AddWhitelistEntryResponse], error) {
+	if c.Msg.Player == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("player must be set"))
+	}
+	entry, err := s.p.Whitelist().Add(c.Msg.Player)
+	if err != nil {
+		return nil, whitelistErr(err)
+	}
+	return connect.NewResponse(&pb.AddWhitelistEntryResponse{Entry: WhitelistEntryToProto(entry)}), nil
+}
+
+func (s *Service) RemoveWhitelistEntry(ctx context.Context, c *connect.Request[pb.RemoveWhitelistEntryRequest]) (*connect.Response[pb.RemoveWhitelistEntryResponse], error) {
+	removed, err := s.p.Whitelist().Remove(c.Msg.Player)
+	if err != nil {
+		return nil, whitelistErr(err)
+	}
+	if len(removed) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("player is not whitelisted"))
+	}
+	return connect.NewResponse(&pb.RemoveWhitelistEntryResponse{Entries: WhitelistEntriesToProto(removed)}), nil
+}
+
+func whitelistErr(err error) error {
+	if errors.Is(err, proxy.ErrWhitelistDisabled) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// player returns the online player by username or ID or nil if not found.
+func (s *Service) player(usernameOrID string) proxy.Player {
+	if id, err := uuid.Parse(usernameOrID); err == nil {
+		return s.p.Player(id)
+	}
+	return s.p.PlayerByName(usernameOrID)
+}
@@ -77,3 +77,18 @@ func TestServiceConfigMethodsRequireHandler(t *testing.T) {
 	_, err = service.ApplyConfig(context.Background(), connect.NewRequest(&pb.ApplyConfigRequest{}))
 	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
 }
+
+func TestServicePermissionMethodsRequireHandler(t *testing.T) {
+	service := NewService(nil, nil)
+
+	_, err := service.GetPermissions(context.Background(), connect.NewRequest(&pb.GetPermissionsRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	_, err = service.CheckPermission(context.Background(), connect.NewRequest(&pb.CheckPermissionRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	_, err = service.SetPermissionGroup(context.Background(), connect.NewRequest(&pb.SetPermissionGroupRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	_, err = service.DeletePermissionGroup(context.Background(), connect.NewRequest(&pb.DeletePermissionGroupRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	_, err = service.SetPlayerPermissions(context.Background(), connect.NewRequest(&pb.SetPlayerPermissionsRequest{}))
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
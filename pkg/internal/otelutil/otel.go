@@ -6,15 +6,19 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/honeycombio/otel-config-go/otelconfig"
 	"go.minekube.com/gate/pkg/version"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 )
 
 // Init initializes the OpenTelemetry SDK with the OTLP exporter and the corresponding trace and meter providers.
-// It also starts an HTTP server for Prometheus metrics.
-func Init(ctx context.Context) (clean func(), err error) {
+// If prom is not nil, metrics are also collected for its Prometheus scrape endpoint.
+func Init(ctx context.Context, prom *Prometheus) (clean func(), err error) {
 	// default service name
 	serviceName := os.Getenv("OTEL_SERVICE_NAME")
 	if serviceName == "" {
@@ -22,30 +26,74 @@ func Init(ctx context.Context) (clean func(), err error) {
 	}
 
 	log := logr.FromContextOrDiscard(ctx).WithName("otel")
-	eitherEnabled := os.Getenv("OTEL_METRICS_ENABLED") == "true" || os.Getenv("OTEL_TRACES_ENABLED") == "true"
+	metricsEnabled := os.Getenv("OTEL_METRICS_ENABLED") == "true"
+	eitherEnabled := metricsEnabled || os.Getenv("OTEL_TRACES_ENABLED") == "true"
 
 	otelShutdown, err := otelconfig.ConfigureOpenTelemetry(
 		otelconfig.WithServiceName(serviceName),
 		otelconfig.WithServiceVersion(version.String()),
 		otelconfig.WithLogger(&logger{log}),
-		otelconfig.WithMetricsEnabled(os.Getenv("OTEL_METRICS_ENABLED") == "true"),
+		// With Prometheus the meter provider is set up below to share it with the OTLP exporter.
+		otelconfig.WithMetricsEnabled(metricsEnabled && prom == nil),
 		otelconfig.WithTracesEnabled(os.Getenv("OTEL_TRACES_ENABLED") == "true"),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	shutdownMetrics := func(context.Context) error { return nil }
+	if prom != nil {
+		var readers []sdkmetric.Reader
+		if metricsEnabled {
+			exporter, err := newOTLPMetricExporter(ctx)
+			if err != nil {
+				otelShutdown()
+				return nil, err
+			}
+			var readerOpts []sdkmetric.PeriodicReaderOption
+			if period, err := time.ParseDuration(os.Getenv("OTEL_EXPORTER_OTLP_METRICS_PERIOD")); err == nil && period > 0 {
+				readerOpts = append(readerOpts, sdkmetric.WithInterval(period))
+			}
+			readers = append(readers, sdkmetric.NewPeriodicReader(exporter, readerOpts...))
+		}
+		shutdownMetrics, err = prom.setupMeterProvider(serviceName, readers...)
+		if err != nil {
+			otelShutdown()
+			return nil, err
+		}
+	}
+
 	return func() {
 		if eitherEnabled {
 			log.Info("shutting down OpenTelemetry, trying to push remaining telemetry data...")
 		}
 		otelShutdown()
+		if err := shutdownMetrics(context.Background()); err != nil {
+			log.Error(err, "error shutting down meter provider")
+		}
 		if eitherEnabled {
 			log.Info("OpenTelemetry shutdown complete")
 		}
 	}, nil
 }
 
+// newOTLPMetricExporter returns the OTLP metric exporter for the protocol
+// configured by the standard OTEL_EXPORTER_OTLP_* environment variables.
+func newOTLPMetricExporter(ctx context.Context) (sdkmetric.Exporter, error) {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_METRICS_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	switch protocol {
+	case "", "grpc":
+		return otlpmetricgrpc.New(ctx)
+	case "http/protobuf":
+		return otlpmetrichttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP metrics protocol %q", protocol)
+	}
+}
+
 type logger struct {
 	logr.Logger
 }
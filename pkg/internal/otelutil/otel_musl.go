@@ -10,9 +10,21 @@ import (
 
 // Init is intentionally minimal for musl builds so the portable Linux binary
 // does not depend on host instrumentation packages that pull in libdl.
-func Init(context.Context) (func(), error) {
+// The Prometheus scrape endpoint is supported as it needs no OTLP exporter.
+func Init(ctx context.Context, prom *Prometheus) (func(), error) {
 	if os.Getenv("OTEL_METRICS_ENABLED") == "true" || os.Getenv("OTEL_TRACES_ENABLED") == "true" {
 		return nil, fmt.Errorf("OpenTelemetry is not available in the musl Linux build; use the standard glibc Linux build for OTEL support")
 	}
-	return func() {}, nil
+	if prom == nil {
+		return func() {}, nil
+	}
+	serviceName := os.Getenv("OTEL_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = "gate"
+	}
+	shutdown, err := prom.setupMeterProvider(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return func() { _ = shutdown(ctx) }, nil
 }
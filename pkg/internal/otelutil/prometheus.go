@@ -0,0 +1,66 @@
+package otelutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+
+	"go.minekube.com/gate/pkg/version"
+)
+
+// Prometheus collects the metrics of the global meter provider
+// to be scraped in the Prometheus text format without an OTel collector.
+// It is set up by passing it to Init.
+type Prometheus struct {
+	registry *prometheus.Registry
+}
+
+// NewPrometheus returns a new Prometheus also collecting Go runtime and process metrics.
+func NewPrometheus() *Prometheus {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return &Prometheus{registry: registry}
+}
+
+// Handler returns the http.Handler serving the metrics in the Prometheus text format.
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{})
+}
+
+// setupMeterProvider sets the global meter provider exporting to the Prometheus registry
+// and the given additional readers, e.g. an OTLP exporter.
+func (p *Prometheus) setupMeterProvider(serviceName string, readers ...sdkmetric.Reader) (shutdown func(context.Context) error, err error) {
+	exporter, err := otelprom.New(otelprom.WithRegisterer(p.registry))
+	if err != nil {
+		return nil, fmt.Errorf("error creating prometheus exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.String()),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error creating metrics resource: %w", err)
+	}
+	opts := []sdkmetric.Option{
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(exporter),
+	}
+	for _, r := range readers {
+		opts = append(opts, sdkmetric.WithReader(r))
+	}
+	provider := sdkmetric.NewMeterProvider(opts...)
+	otel.SetMeterProvider(provider)
+	return provider.Shutdown, nil
+}
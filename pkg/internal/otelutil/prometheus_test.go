@@ -0,0 +1,29 @@
+package otelutil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestPrometheusServesMeterProviderMetrics(t *testing.T) {
+	p := NewPrometheus()
+	shutdown, err := p.setupMeterProvider("gate-test")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = shutdown(t.Context()) })
+
+	counter, err := otel.Meter("test").Int64Counter("gate.test.requests")
+	require.NoError(t, err)
+	counter.Add(t.Context(), 3)
+
+	rec := httptest.NewRecorder()
+	p.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	require.Contains(t, body, "gate_test_requests_total")
+	require.Contains(t, body, `service_name="gate-test"`)
+	require.Contains(t, body, "go_goroutines")
+}
@@ -55,6 +55,19 @@ func doInternalEntity(e tablist.Entry, fn func(internalEntry)) {
 	}
 }
 
+// NewEntry creates an entry owned by the tab list using the entry type
+// matching the tab list's protocol version. It must still be added with Add.
+func NewEntry(tl InternalTabList, attrs EntryAttributes) tablist.Entry {
+	root := ResolveRoot(tl)
+	switch root.(type) {
+	case *LegacyTabList:
+		return &LegacyEntry{KeyedEntry: KeyedEntry{Entry: Entry{OwningTabList: root, EntryAttributes: attrs}}}
+	case *KeyedTabList:
+		return &KeyedEntry{Entry: Entry{OwningTabList: root, EntryAttributes: attrs}}
+	}
+	return &Entry{OwningTabList: root, EntryAttributes: attrs}
+}
+
 func (e *Entry) TabList() tablist.TabList {
 	return e.OwningTabList
 }
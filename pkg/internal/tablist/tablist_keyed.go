@@ -34,7 +34,7 @@ func (k *KeyedTabList) Add(entries ...tablist.Entry) error {
 			if entry == nil {
 				return fmt.Errorf("entry at index %d is nil", i)
 			}
-			if entry.TabList() != k {
+			if !k.owns(entry) {
 				return fmt.Errorf("entry %s at index %d is not from this tab list", entry.Profile(), i)
 			}
 			if k.TabList.hasEntry(entry.Profile().ID) {
@@ -62,6 +62,17 @@ func (k *KeyedTabList) Add(entries ...tablist.Entry) error {
 	return k.TabList.Viewer.Flush()
 }
 
+// owns returns true if the entry is from this tab list or the legacy tab list embedding it.
+func (k *KeyedTabList) owns(entry tablist.Entry) bool {
+	switch owner := entry.TabList().(type) {
+	case *KeyedTabList:
+		return owner == k
+	case *LegacyTabList:
+		return &owner.KeyedTabList == k
+	}
+	return false
+}
+
 func (k *KeyedTabList) RemoveAll(ids ...uuid.UUID) error {
 	toRemove := k.TabList.deleteEntries(ids...)
 	items := make([]legacytablist.PlayerListItemEntry, 0, len(toRemove))
@@ -0,0 +1,132 @@
+package componentutil
+
+import (
+	"strings"
+
+	"go.minekube.com/common/minecraft/component"
+
+	"go.minekube.com/gate/pkg/gate/proto"
+)
+
+// ParseMessage parses a component from a JSON text component,
+// MiniMessage-like text (see MiniMessageToLegacy) or legacy text.
+func ParseMessage(protocol proto.Protocol, s string) (component.Component, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") {
+		s = MiniMessageToLegacy(s)
+	}
+	return ParseComponent(protocol, s)
+}
+
+// miniTags are the MiniMessage tags supported by MiniMessageToLegacy
+// and their legacy formatting codes.
+var miniTags = map[string]byte{
+	"black":        '0',
+	"dark_blue":    '1',
+	"dark_green":   '2',
+	"dark_aqua":    '3',
+	"dark_red":     '4',
+	"dark_purple":  '5',
+	"gold":         '6',
+	"gray":         '7',
+	"grey":         '7',
+	"dark_gray":    '8',
+	"dark_grey":    '8',
+	"blue":         '9',
+	"green":        'a',
+	"aqua":         'b',
+	"red":          'c',
+	"light_purple": 'd',
+	"yellow":       'e',
+	"white":        'f',
+
+	"obfuscated":    'k',
+	"obf":           'k',
+	"bold":          'l',
+	"b":             'l',
+	"strikethrough": 'm',
+	"st":            'm',
+	"underlined":    'n',
+	"u":             'n',
+	"italic":        'o',
+	"i":             'o',
+	"em":            'o',
+}
+
+// isColorCode returns true if the legacy code is a color and not a decoration.
+func isColorCode(code byte) bool {
+	return code >= '0' && code <= '9' || code >= 'a' && code <= 'f'
+}
+
+// MiniMessageToLegacy converts the MiniMessage tags of s to legacy formatting codes.
+//
+// Supported are the named color tags (e.g. <red> or <color:red>), the decoration
+// tags (e.g. <bold> or <b>), their closing tags (e.g. </red>), <reset> and <newline>.
+// Other tags are kept as is and a tag is escaped with a backslash (\<red>).
+func MiniMessageToLegacy(s string) string {
+	var (
+		b     strings.Builder
+		open  []byte // codes of the open tags
+		style = func() {
+			b.WriteString("§r")
+			for i := len(open) - 1; i >= 0; i-- {
+				if isColorCode(open[i]) {
+					b.WriteString("§" + string(open[i]))
+					break
+				}
+			}
+			// Decorations must follow the color as colors reset them
+			for _, code := range open {
+				if !isColorCode(code) {
+					b.WriteString("§" + string(code))
+				}
+			}
+		}
+	)
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && s[i+1] == '<' {
+			b.WriteByte('<')
+			i++
+			continue
+		}
+		end := -1
+		if s[i] == '<' {
+			end = strings.IndexByte(s[i:], '>')
+		}
+		if end <= 1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		tag := strings.ToLower(s[i+1 : i+end])
+		name, closing := strings.CutPrefix(tag, "/")
+		if n, ok := strings.CutPrefix(name, "color:"); ok {
+			name = n
+		} else if n, ok = strings.CutPrefix(name, "c:"); ok {
+			name = n
+		}
+		code, known := miniTags[name]
+		switch {
+		case tag == "reset":
+			open = nil
+			style()
+		case tag == "newline" || tag == "br":
+			b.WriteByte('\n')
+		case known && closing:
+			for j := len(open) - 1; j >= 0; j-- {
+				if open[j] == code {
+					open = append(open[:j], open[j+1:]...)
+					break
+				}
+			}
+			style()
+		case known:
+			open = append(open, code)
+			style()
+		default:
+			b.WriteByte(s[i])
+			continue
+		}
+		i += end
+	}
+	return b.String()
+}
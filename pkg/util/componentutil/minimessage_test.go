@@ -0,0 +1,26 @@
+package componentutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiniMessageToLegacy(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Hello", "Hello"},
+		{"<red>Hello", "§r§cHello"},
+		{"<color:red>Hello</color:red> world", "§r§cHello§r world"},
+		{"<red><b>Hi</b> there</red>!", "§r§c§r§c§lHi§r§c there§r!"},
+		{"<bold><gold>Sale", "§r§l§r§6§lSale"},
+		{"<red>a<reset>b", "§r§ca§rb"},
+		{"line<newline>next", "line\nnext"},
+		{`\<red> <3 <unknown> <>`, "<red> <3 <unknown> <>"},
+		{"<RED>Ünïcode", "§r§cÜnïcode"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, MiniMessageToLegacy(tt.in), tt.in)
+	}
+}
@@ -0,0 +1,44 @@
+package configutil
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// DefaultRotationInterval is the interval of a Rotation without one.
+const DefaultRotationInterval = 10 * time.Second
+
+// Rotation selects one of multiple configured values, e.g. MOTDs,
+// either at random or in turns that change every interval.
+type Rotation struct {
+	Random   bool     `yaml:"random,omitempty" json:"random,omitempty"`     // Pick a random value each time.
+	Interval Duration `yaml:"interval,omitempty" json:"interval,omitempty"` // Time until the next value, default DefaultRotationInterval.
+}
+
+// Index returns the index of the value to use out of n values at the given time.
+// Returns -1 if n is zero.
+func (r Rotation) Index(n int, now time.Time) int {
+	switch {
+	case n <= 0:
+		return -1
+	case n == 1:
+		return 0
+	case r.Random:
+		return rand.IntN(n)
+	}
+	interval := time.Duration(r.Interval)
+	if interval <= 0 {
+		interval = DefaultRotationInterval
+	}
+	return int((now.UnixNano() / int64(interval)) % int64(n))
+}
+
+// Pick returns the value to use at the given time or the zero value if there are none.
+func Pick[T any](r Rotation, values []T, now time.Time) T {
+	var zero T
+	i := r.Index(len(values), now)
+	if i < 0 {
+		return zero
+	}
+	return values[i]
+}
@@ -0,0 +1,27 @@
+package configutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationIndex(t *testing.T) {
+	r := Rotation{Interval: Duration(time.Minute)}
+	start := time.Unix(0, 0).Add(3 * time.Minute)
+	require.Equal(t, -1, r.Index(0, start))
+	require.Equal(t, 0, r.Index(1, start))
+	require.Equal(t, 1, r.Index(2, start))
+	require.Equal(t, 1, r.Index(2, start.Add(59*time.Second)))
+	require.Equal(t, 0, r.Index(2, start.Add(time.Minute)))
+
+	r = Rotation{Random: true}
+	for range 10 {
+		i := r.Index(3, start)
+		require.True(t, i >= 0 && i < 3)
+	}
+
+	require.Equal(t, "b", Pick(Rotation{}, []string{"a", "b"}, time.Unix(0, 0).Add(DefaultRotationInterval)))
+	require.Empty(t, Pick[string](Rotation{}, nil, start))
+}
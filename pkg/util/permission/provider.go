@@ -0,0 +1,332 @@
+package permission
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"gopkg.in/yaml.v3"
+
+	"go.minekube.com/gate/pkg/util/configutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// DefaultGroup is the name of the group every player implicitly inherits.
+const DefaultGroup = "default"
+
+// Wildcard is the permission node suffix granting all sub-permissions,
+// e.g. "gate.command.*" grants "gate.command.server".
+// A sole "*" grants every permission.
+const Wildcard = "*"
+
+// Negation is the permission node prefix explicitly denying a permission,
+// e.g. "-gate.command.send".
+const Negation = "-"
+
+// Permissions defines permission groups and per-player permissions.
+// It is the file format of the built-in permission provider.
+//
+// Permission nodes are evaluated from most to least specific source:
+// the player's own nodes, then the player's groups in order (each group
+// before its parents) and finally the DefaultGroup.
+// The first source with a matching node decides, where an exact node
+// takes precedence over the longest matching wildcard node.
+type Permissions struct {
+	// Groups maps group names to groups.
+	Groups map[string]*Group `yaml:"groups,omitempty" json:"groups,omitempty"`
+	// Players maps player UUIDs to player specific permissions.
+	Players map[string]*Player `yaml:"players,omitempty" json:"players,omitempty"`
+}
+
+// Group is a named set of permission nodes.
+type Group struct {
+	// Parents are the groups this group inherits nodes from.
+	Parents []string `yaml:"parents,omitempty" json:"parents,omitempty"`
+	// Permissions are the permission nodes of the group.
+	Permissions []string `yaml:"permissions,omitempty" json:"permissions,omitempty"`
+}
+
+// Player are the permissions of a single player.
+type Player struct {
+	// Name is an optional note of the player's name for readability.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Groups are the groups the player is a member of.
+	Groups []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+	// Permissions are the player's own permission nodes overriding the groups.
+	Permissions []string `yaml:"permissions,omitempty" json:"permissions,omitempty"`
+}
+
+// Validate validates the Permissions.
+func (p *Permissions) Validate() (errs []error) {
+	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
+	if p == nil {
+		return nil
+	}
+	validNodes := func(owner string, nodes []string) {
+		for _, n := range nodes {
+			if strings.TrimSpace(strings.TrimPrefix(n, Negation)) == "" {
+				e("%s has an empty permission node", owner)
+			}
+		}
+	}
+	for name, g := range p.Groups {
+		if strings.TrimSpace(name) == "" {
+			e("group name must not be empty")
+		}
+		if g == nil {
+			continue
+		}
+		for _, parent := range g.Parents {
+			if _, ok := p.Groups[parent]; !ok {
+				e("group %q has unknown parent group %q", name, parent)
+			}
+		}
+		validNodes(fmt.Sprintf("group %q", name), g.Permissions)
+	}
+	for name := range p.Groups {
+		if cycle := p.cycle(name, nil); cycle != nil {
+			e("group %q has a cyclic inheritance: %s", name, strings.Join(cycle, " -> "))
+		}
+	}
+	for id, pl := range p.Players {
+		if _, err := uuid.Parse(id); err != nil {
+			e("invalid player uuid %q: %v", id, err)
+		}
+		if pl == nil {
+			continue
+		}
+		for _, g := range pl.Groups {
+			if _, ok := p.Groups[g]; !ok {
+				e("player %q is member of unknown group %q", id, g)
+			}
+		}
+		validNodes(fmt.Sprintf("player %q", id), pl.Permissions)
+	}
+	return errs
+}
+
+// cycle returns the inheritance path if group eventually inherits itself.
+func (p *Permissions) cycle(group string, path []string) []string {
+	if slices.Contains(path, group) {
+		return append(path, group)
+	}
+	g := p.Groups[group]
+	if g == nil {
+		return nil
+	}
+	path = append(path, group)
+	for _, parent := range g.Parents {
+		if c := p.cycle(parent, path); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// Value returns the TriState of a permission for the player with the given id.
+func (p *Permissions) Value(id uuid.UUID, permission string) TriState {
+	if p == nil {
+		return Undefined
+	}
+	permission = strings.ToLower(permission)
+	pl := p.Players[id.String()]
+	if pl != nil {
+		if v := matchNodes(pl.Permissions, permission); v != Undefined {
+			return v
+		}
+	}
+
+	visited := map[string]bool{}
+	var groups []string
+	if pl != nil {
+		groups = append(groups, pl.Groups...)
+	}
+	groups = append(groups, DefaultGroup)
+	for _, name := range groups {
+		if v := p.groupValue(name, permission, visited); v != Undefined {
+			return v
+		}
+	}
+	return Undefined
+}
+
+// groupValue evaluates a group before its parents, depth-first.
+func (p *Permissions) groupValue(name, permission string, visited map[string]bool) TriState {
+	if visited[name] {
+		return Undefined
+	}
+	visited[name] = true
+	g := p.Groups[name]
+	if g == nil {
+		return Undefined
+	}
+	if v := matchNodes(g.Permissions, permission); v != Undefined {
+		return v
+	}
+	for _, parent := range g.Parents {
+		if v := p.groupValue(parent, permission, visited); v != Undefined {
+			return v
+		}
+	}
+	return Undefined
+}
+
+// matchNodes returns the value of the most specific node matching permission.
+func matchNodes(nodes []string, permission string) TriState {
+	best, value := -1, Undefined
+	for _, node := range nodes {
+		v := True
+		if strings.HasPrefix(node, Negation) {
+			node, v = strings.TrimPrefix(node, Negation), False
+		}
+		node = strings.ToLower(strings.TrimSpace(node))
+
+		var specificity int
+		switch {
+		case node == permission:
+			specificity = len(node) + 1 // exact match beats any wildcard
+		case node == Wildcard:
+			specificity = 0
+		case strings.HasSuffix(node, "."+Wildcard) &&
+			strings.HasPrefix(permission, strings.TrimSuffix(node, Wildcard)):
+			specificity = len(node)
+		default:
+			continue
+		}
+		if specificity > best {
+			best, value = specificity, v
+		}
+	}
+	return value
+}
+
+// NodesFunc returns a Func for the permission nodes, matched like the nodes of a Group.
+func NodesFunc(nodes []string) Func {
+	return func(permission string) TriState {
+		return matchNodes(nodes, strings.ToLower(permission))
+	}
+}
+
+// Provider provides permissions from a Permissions definition
+// that can be replaced at runtime, e.g. when its file changed.
+// The zero value is ready to use and leaves all permissions undefined.
+type Provider struct {
+	perms atomic.Pointer[Permissions]
+}
+
+// NewProvider returns a new Provider using the given Permissions.
+func NewProvider(perms *Permissions) *Provider {
+	p := new(Provider)
+	p.Set(perms)
+	return p
+}
+
+// Set replaces the Permissions of the Provider.
+// Already created Funcs use the new Permissions from now on.
+// The Permissions must not be modified afterward.
+func (p *Provider) Set(perms *Permissions) {
+	p.perms.Store(perms)
+}
+
+// Permissions returns the current Permissions that must not be modified, or nil if none.
+func (p *Provider) Permissions() *Permissions {
+	return p.perms.Load()
+}
+
+// Value returns the TriState of a permission for the player with the given id.
+func (p *Provider) Value(id uuid.UUID, permission string) TriState {
+	return p.perms.Load().Value(id, permission)
+}
+
+// Func returns the permission Func for the player with the given id
+// falling back to fallback for undefined permissions if not nil.
+func (p *Provider) Func(id uuid.UUID, fallback Func) Func {
+	return func(permission string) TriState {
+		if v := p.Value(id, permission); v != Undefined || fallback == nil {
+			return v
+		}
+		return fallback(permission)
+	}
+}
+
+// LoadFile reads Permissions from a YAML (or JSON) file.
+// A non-existent file results in empty Permissions.
+func LoadFile(path string) (*Permissions, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Permissions{}, nil
+		}
+		return nil, err
+	}
+	perms := new(Permissions)
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(perms); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing permissions file %q: %w", path, err)
+	}
+	if err = errors.Join(perms.Validate()...); err != nil {
+		return nil, fmt.Errorf("invalid permissions file %q: %w", path, err)
+	}
+	perms.normalize()
+	return perms, nil
+}
+
+// SaveFile atomically writes Permissions as YAML to a file
+// keeping the file mode of an existing file.
+func SaveFile(path string, perms *Permissions) error {
+	return configutil.SaveYAMLFile(path, perms)
+}
+
+// normalize normalizes the player UUID keys to the dashed lower case form.
+func (p *Permissions) normalize() {
+	for id, pl := range p.Players {
+		parsed, err := uuid.Parse(id)
+		if err != nil || parsed.String() == id {
+			continue
+		}
+		delete(p.Players, id)
+		p.Players[parsed.String()] = pl
+	}
+}
+
+// Clone returns a deep copy of the Permissions.
+func (p *Permissions) Clone() *Permissions {
+	c := &Permissions{}
+	if p == nil {
+		return c
+	}
+	if p.Groups != nil {
+		c.Groups = make(map[string]*Group, len(p.Groups))
+		for name, g := range p.Groups {
+			if g == nil {
+				c.Groups[name] = &Group{}
+				continue
+			}
+			c.Groups[name] = &Group{
+				Parents:     slices.Clone(g.Parents),
+				Permissions: slices.Clone(g.Permissions),
+			}
+		}
+	}
+	if p.Players != nil {
+		c.Players = make(map[string]*Player, len(p.Players))
+		for id, pl := range p.Players {
+			if pl == nil {
+				c.Players[id] = &Player{}
+				continue
+			}
+			c.Players[id] = &Player{
+				Name:        pl.Name,
+				Groups:      slices.Clone(pl.Groups),
+				Permissions: slices.Clone(pl.Permissions),
+			}
+		}
+	}
+	return c
+}
@@ -0,0 +1,134 @@
+package permission
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestPermissions_Value(t *testing.T) {
+	admin, member, guest := uuid.New(), uuid.New(), uuid.New()
+	perms := &Permissions{
+		Groups: map[string]*Group{
+			DefaultGroup: {Permissions: []string{"gate.command.server"}},
+			"mod":        {Permissions: []string{"gate.command.*", "-gate.command.glist"}},
+			"admin":      {Parents: []string{"mod"}, Permissions: []string{"*"}},
+		},
+		Players: map[string]*Player{
+			admin.String():  {Groups: []string{"admin"}},
+			member.String(): {Groups: []string{"mod"}, Permissions: []string{"gate.command.glist", "-gate.command.send"}},
+		},
+	}
+	require.Empty(t, perms.Validate())
+
+	tests := []struct {
+		id         uuid.UUID
+		permission string
+		want       TriState
+	}{
+		{guest, "gate.command.server", True},
+		{guest, "gate.command.send", Undefined},
+		{member, "gate.command.send", False},         // player node beats group wildcard
+		{member, "gate.command.glist", True},         // player node beats group negation
+		{member, "gate.command.anything", True},      // group wildcard
+		{member, "GATE.COMMAND.ANYTHING", True},      // case-insensitive
+		{member, "other.permission", Undefined},      // no match
+		{admin, "gate.command.glist", True},          // own group decides before parent
+		{admin, "other.permission", True},            // sole wildcard
+		{uuid.New(), "gate.command.server", True},    // unknown players inherit default
+		{uuid.New(), "gate.command.send", Undefined}, // unknown players inherit default
+	}
+	for _, tt := range tests {
+		require.Equalf(t, tt.want, perms.Value(tt.id, tt.permission), "%s %s", tt.id, tt.permission)
+	}
+}
+
+func TestMatchNodes_MostSpecificWins(t *testing.T) {
+	nodes := []string{"*", "-gate.*", "gate.command.*", "-gate.command.send"}
+	require.Equal(t, True, matchNodes(nodes, "other"))
+	require.Equal(t, False, matchNodes(nodes, "gate.other"))
+	require.Equal(t, True, matchNodes(nodes, "gate.command.server"))
+	require.Equal(t, False, matchNodes(nodes, "gate.command.send"))
+	require.Equal(t, Undefined, matchNodes(nil, "gate"))
+}
+
+func TestNodesFunc(t *testing.T) {
+	fn := NodesFunc([]string{"gate.command.*", "-gate.command.send"})
+	require.Equal(t, True, fn("Gate.Command.Server"))
+	require.Equal(t, False, fn("gate.command.send"))
+	require.Equal(t, Undefined, fn("other"))
+}
+
+func TestPermissions_Validate(t *testing.T) {
+	perms := &Permissions{
+		Groups: map[string]*Group{
+			"a": {Parents: []string{"b"}},
+			"b": {Parents: []string{"a"}},
+			"c": {Parents: []string{"unknown"}, Permissions: []string{"-"}},
+		},
+		Players: map[string]*Player{
+			"not-a-uuid":        {},
+			uuid.New().String(): {Groups: []string{"missing"}},
+		},
+	}
+	// 2 cycles, unknown parent, empty node, bad uuid, unknown group
+	require.Len(t, perms.Validate(), 6)
+}
+
+func TestProvider_Func(t *testing.T) {
+	id := uuid.New()
+	p := NewProvider(&Permissions{Players: map[string]*Player{
+		id.String(): {Permissions: []string{"a"}},
+	}})
+	fn := p.Func(id, func(string) TriState { return False })
+	require.Equal(t, True, fn("a"))
+	require.Equal(t, False, fn("b"))
+
+	p.Set(nil)
+	require.Equal(t, False, fn("a"))
+}
+
+func TestLoadFile_SaveFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "permissions.yml")
+
+	perms, err := LoadFile(file)
+	require.NoError(t, err)
+	require.Empty(t, perms.Groups)
+
+	id := uuid.New()
+	require.NoError(t, os.WriteFile(file, []byte(`
+groups:
+  default:
+    permissions: [gate.command.server]
+players:
+  `+id.Undashed()+`:
+    name: Steve
+    permissions: [gate.command.send]
+`), 0o644))
+	perms, err = LoadFile(file)
+	require.NoError(t, err)
+	require.Equal(t, True, perms.Value(id, "gate.command.send"))
+	require.Equal(t, "Steve", perms.Players[id.String()].Name)
+
+	clone := perms.Clone()
+	clone.Groups["mod"] = &Group{Parents: []string{DefaultGroup}}
+	require.NotContains(t, perms.Groups, "mod")
+	require.NoError(t, SaveFile(file, clone))
+
+	loaded, err := LoadFile(file)
+	require.NoError(t, err)
+	require.Equal(t, clone, loaded)
+
+	require.NoError(t, os.WriteFile(file, []byte("groups:\n  a:\n    parents: [a]\n"), 0o644))
+	_, err = LoadFile(file)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(file, []byte("unknown: true\n"), 0o644))
+	_, err = LoadFile(file)
+	require.Error(t, err)
+}
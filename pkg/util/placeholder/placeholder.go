@@ -0,0 +1,177 @@
+// Package placeholder replaces {name} and {name:argument} placeholders in texts
+// and components with the values of registered providers.
+package placeholder
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.minekube.com/common/minecraft/component"
+)
+
+// ErrInvalidName is returned when registering a provider with an invalid placeholder name.
+// Names must be non-empty and only contain letters, digits, '_', '-' and '.'.
+var ErrInvalidName = errors.New("invalid placeholder name")
+
+// Provider returns the value of a placeholder in the context.
+// The argument is the text after the colon of {name:argument}, empty if there is none.
+// Returns false if the placeholder can't be resolved, leaving it unchanged.
+type Provider func(ctx *Context, arg string) (value string, ok bool)
+
+// Context is the context placeholders are resolved in.
+type Context struct {
+	// Subject is what the text is rendered for, e.g. a player, or nil.
+	// Providers should type assert the subjects they support.
+	Subject any
+	// Values are placeholder values known by the caller that take
+	// precedence over providers, keyed by name or "name:argument".
+	Values map[string]string
+}
+
+// Registry holds the placeholder providers.
+// A nil Registry only resolves the values of the context.
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+}
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{providers: map[string]Provider{}}
+}
+
+// Register registers the provider for the placeholder name,
+// replacing any provider previously registered for it.
+func (r *Registry) Register(name string, provider Provider) error {
+	if !validName(name) {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	if provider == nil {
+		return fmt.Errorf("provider for placeholder %q must not be nil", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers[name] = provider
+	return nil
+}
+
+// Unregister removes the provider of the placeholder name.
+// Returns false if no provider was registered.
+func (r *Registry) Unregister(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.providers[name]
+	delete(r.providers, name)
+	return ok
+}
+
+// Names returns the sorted names of the registered placeholders.
+func (r *Registry) Names() []string {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.providers))
+}
+
+// Resolve returns the value of the placeholder name with the argument.
+func (r *Registry) Resolve(ctx *Context, name, arg string) (string, bool) {
+	if ctx == nil {
+		ctx = &Context{}
+	}
+	key := name
+	if arg != "" {
+		key += ":" + arg
+	}
+	if v, ok := ctx.Values[key]; ok {
+		return v, true
+	}
+	if r == nil {
+		return "", false
+	}
+	r.mu.RLock()
+	provider, ok := r.providers[name]
+	r.mu.RUnlock()
+	if !ok {
+		return "", false
+	}
+	return provider(ctx, arg)
+}
+
+// Replace returns the text with all resolvable placeholders replaced.
+func (r *Registry) Replace(ctx *Context, text string) string {
+	if !strings.Contains(text, "{") {
+		return text
+	}
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(text, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(text[start+1:], '}')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+		inner := text[start+1 : end]
+		if next := strings.IndexByte(inner, '{'); next >= 0 {
+			// Unresolvable opening brace, continue at the nested one
+			b.WriteString(text[:start+1+next])
+			text = text[start+1+next:]
+			continue
+		}
+		name, arg, _ := strings.Cut(inner, ":")
+		value, ok := "", false
+		if validName(name) {
+			value, ok = r.Resolve(ctx, name, arg)
+		}
+		if ok {
+			b.WriteString(text[:start])
+			b.WriteString(value)
+		} else {
+			b.WriteString(text[:end+1])
+		}
+		text = text[end+1:]
+	}
+	b.WriteString(text)
+	return b.String()
+}
+
+// Component returns a copy of the component with the placeholders in the contents of
+// text components replaced. The component is returned as is if it has no text components.
+func (r *Registry) Component(ctx *Context, c component.Component) component.Component {
+	t, ok := c.(*component.Text)
+	if !ok || t == nil {
+		return c
+	}
+	cp := *t
+	cp.Content = r.Replace(ctx, t.Content)
+	if len(t.Extra) != 0 {
+		cp.Extra = make([]component.Component, len(t.Extra))
+		for i, extra := range t.Extra {
+			cp.Extra[i] = r.Component(ctx, extra)
+		}
+	}
+	return &cp
+}
+
+func validName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == '_', c == '-', c == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
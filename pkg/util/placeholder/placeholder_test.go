@@ -0,0 +1,66 @@
+package placeholder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.minekube.com/common/minecraft/component"
+)
+
+func TestRegistry_Replace(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("online", func(_ *Context, arg string) (string, bool) {
+		switch arg {
+		case "":
+			return "10", true
+		case "lobby":
+			return "3", true
+		}
+		return "", false
+	}))
+	require.NoError(t, r.Register("player", func(ctx *Context, _ string) (string, bool) {
+		name, ok := ctx.Subject.(string)
+		return name, ok
+	}))
+	require.ErrorIs(t, r.Register("in valid", nil), ErrInvalidName)
+	require.Equal(t, []string{"online", "player"}, r.Names())
+
+	ctx := &Context{Subject: "alice", Values: map[string]string{"max": "100", "online:hub": "7"}}
+	tests := []struct {
+		text, want string
+	}{
+		{"plain", "plain"},
+		{"{online}/{max}", "10/100"},
+		{"lobby: {online:lobby}, hub: {online:hub}", "lobby: 3, hub: 7"},
+		{"{online:unknown} {unknown} {}", "{online:unknown} {unknown} {}"},
+		{"hi {player}!", "hi alice!"},
+		{"{{player}} {online", "{alice} {online"},
+		{`{"text":"{player}"}`, `{"text":"alice"}`},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, r.Replace(ctx, tt.text), tt.text)
+	}
+
+	require.Equal(t, "hi {player}!", r.Replace(&Context{}, "hi {player}!"))
+	require.True(t, r.Unregister("player"))
+	require.False(t, r.Unregister("player"))
+	require.Equal(t, "hi {player}!", r.Replace(ctx, "hi {player}!"))
+
+	var nilRegistry *Registry
+	require.Equal(t, "100 {online}", nilRegistry.Replace(ctx, "{max} {online}"))
+}
+
+func TestRegistry_Component(t *testing.T) {
+	r := NewRegistry()
+	ctx := &Context{Values: map[string]string{"online": "5"}}
+	c := &component.Text{
+		Content: "Online: ",
+		Extra:   []component.Component{&component.Text{Content: "{online}"}},
+	}
+	got := r.Component(ctx, c).(*component.Text)
+	require.Equal(t, "5", got.Extra[0].(*component.Text).Content)
+	require.Equal(t, "{online}", c.Extra[0].(*component.Text).Content, "original must not be modified")
+
+	tr := &component.Translation{Key: "gui.ok"}
+	require.Same(t, tr, r.Component(ctx, tr))
+}
@@ -0,0 +1,454 @@
+// Package punishment provides a file-backed store of player bans and mutes
+// by UUID, username and IP address or range.
+package punishment
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"go.minekube.com/gate/pkg/util/configutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// Type is the type of a Punishment.
+type Type string
+
+// Punishment types.
+const (
+	// Ban denies the login of matching players.
+	Ban Type = "ban"
+	// Mute denies the chat messages of matching players.
+	Mute Type = "mute"
+)
+
+// Punishment is a ban or mute of players matching its player UUID, username or IP.
+// At least one of them must be set, a player matching any of them is punished.
+type Punishment struct {
+	// ID uniquely identifies the punishment, assigned by the Store.
+	ID   string `yaml:"id" json:"id"`
+	Type Type   `yaml:"type" json:"type"`
+	// Player is the UUID of the punished player.
+	Player string `yaml:"player,omitempty" json:"player,omitempty"`
+	// Name is the username of the punished player, matched case-insensitively.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// IP is the punished IP address or CIDR range, e.g. "10.0.0.0/8".
+	IP string `yaml:"ip,omitempty" json:"ip,omitempty"`
+	// Reason is shown to the player, either a legacy or JSON text component.
+	Reason string `yaml:"reason,omitempty" json:"reason,omitempty"`
+	// Issuer is a note of who issued the punishment.
+	Issuer  string    `yaml:"issuer,omitempty" json:"issuer,omitempty"`
+	Created time.Time `yaml:"created" json:"created"`
+	// Expires is when the punishment ends, the zero value never expires.
+	Expires time.Time `yaml:"expires,omitempty" json:"expires,omitempty"`
+}
+
+// Permanent returns true if the punishment never expires.
+func (p *Punishment) Permanent() bool {
+	return p.Expires.IsZero()
+}
+
+// Expired returns true if the punishment expired at the given time.
+func (p *Punishment) Expired(now time.Time) bool {
+	return !p.Permanent() && !now.Before(p.Expires)
+}
+
+// Matches returns true if the target matches the punishment's player UUID, username or IP.
+func (p *Punishment) Matches(t Target) bool {
+	if p.Player != "" && t.ID != uuid.Nil {
+		if id, err := uuid.Parse(p.Player); err == nil && id == t.ID {
+			return true
+		}
+	}
+	if p.Name != "" && t.Name != "" && strings.EqualFold(p.Name, t.Name) {
+		return true
+	}
+	if p.IP != "" && t.IP.IsValid() {
+		if prefix, err := ParseIP(p.IP); err == nil && prefix.Contains(t.IP.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Target is a player to check for punishments.
+// Unknown fields are left zero and never match.
+type Target struct {
+	ID   uuid.UUID
+	Name string
+	IP   netip.Addr
+}
+
+// ParseIP parses an IP address or CIDR range.
+// A single address is returned as the prefix of only that address.
+func ParseIP(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Punishments is the file format of the Store.
+type Punishments struct {
+	Punishments []*Punishment `yaml:"punishments,omitempty" json:"punishments,omitempty"`
+}
+
+// Validate validates the Punishments.
+func (p *Punishments) Validate() (errs []error) {
+	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
+	if p == nil {
+		return nil
+	}
+	ids := make(map[string]bool, len(p.Punishments))
+	for i, pu := range p.Punishments {
+		if pu == nil {
+			e("punishment %d must not be empty", i)
+			continue
+		}
+		if pu.ID == "" {
+			e("punishment %d has no id", i)
+		} else if ids[pu.ID] {
+			e("duplicate punishment id %q", pu.ID)
+		}
+		ids[pu.ID] = true
+		if err := pu.validate(); err != nil {
+			e("punishment %q: %w", pu.ID, err)
+		}
+	}
+	return errs
+}
+
+func (p *Punishment) validate() error {
+	switch p.Type {
+	case Ban, Mute:
+	default:
+		return fmt.Errorf("invalid type %q, must be %q or %q", p.Type, Ban, Mute)
+	}
+	if p.Player == "" && p.Name == "" && p.IP == "" {
+		return errors.New("at least one of player, name or ip must be set")
+	}
+	if p.Player != "" {
+		if _, err := uuid.Parse(p.Player); err != nil {
+			return fmt.Errorf("invalid player uuid %q: %w", p.Player, err)
+		}
+	}
+	if p.IP != "" {
+		if _, err := ParseIP(p.IP); err != nil {
+			return fmt.Errorf("invalid ip %q: %w", p.IP, err)
+		}
+	}
+	return nil
+}
+
+// LoadFile reads Punishments from a YAML (or JSON) file.
+// A non-existent file results in empty Punishments.
+func LoadFile(path string) (*Punishments, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Punishments{}, nil
+		}
+		return nil, err
+	}
+	p := new(Punishments)
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(p); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing punishments file %q: %w", path, err)
+	}
+	if err = errors.Join(p.Validate()...); err != nil {
+		return nil, fmt.Errorf("invalid punishments file %q: %w", path, err)
+	}
+	return p, nil
+}
+
+// SaveFile atomically writes Punishments as YAML to a file
+// keeping the file mode of an existing file.
+func SaveFile(path string, p *Punishments) error {
+	return configutil.SaveYAMLFile(path, p)
+}
+
+// Store is a file-backed store of punishments.
+// Modifications are persisted to the file immediately
+// and expired punishments are dropped when the file is written.
+type Store struct {
+	file string
+	now  func() time.Time // for testing
+
+	mu   sync.RWMutex
+	list []*Punishment // must not be modified, replaced on change
+}
+
+// Open loads the store from the punishments file.
+// A non-existent file is created on the first modification.
+func Open(file string) (*Store, error) {
+	s := &Store{file: file, now: time.Now}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// File returns the path of the store's punishments file.
+func (s *Store) File() string {
+	return s.file
+}
+
+// Reload reloads the punishments from the file, e.g. after it was edited.
+func (s *Store) Reload() error {
+	p, err := LoadFile(s.file)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.list = p.Punishments
+	s.mu.Unlock()
+	return nil
+}
+
+// Add adds and persists a punishment and returns it with its assigned ID.
+// The creation time is set if zero.
+func (s *Store) Add(p Punishment) (Punishment, error) {
+	if p.Created.IsZero() {
+		p.Created = s.now()
+	}
+	if p.Player != "" {
+		id, err := uuid.Parse(p.Player)
+		if err != nil {
+			return Punishment{}, fmt.Errorf("invalid player uuid %q: %w", p.Player, err)
+		}
+		p.Player = id.String()
+	}
+	if p.IP != "" {
+		prefix, err := ParseIP(p.IP)
+		if err != nil {
+			return Punishment{}, fmt.Errorf("invalid ip %q: %w", p.IP, err)
+		}
+		if prefix.IsSingleIP() {
+			p.IP = prefix.Addr().String()
+		} else {
+			p.IP = prefix.String()
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		p.ID = newID()
+		if !slices.ContainsFunc(s.list, func(o *Punishment) bool { return o.ID == p.ID }) {
+			break
+		}
+	}
+	if err := p.validate(); err != nil {
+		return Punishment{}, err
+	}
+	list := append(s.active(), &p)
+	if err := s.save(list); err != nil {
+		return Punishment{}, err
+	}
+	return p, nil
+}
+
+// Revoke removes and persists the removal of the active punishments of the type
+// whose player UUID, username or IP equals key and returns the removed punishments.
+func (s *Store) Revoke(typ Type, key string) ([]Punishment, error) {
+	if id, err := uuid.Parse(key); err == nil {
+		key = id.String()
+	} else if prefix, err := ParseIP(key); err == nil && prefix.IsSingleIP() {
+		key = prefix.Addr().String()
+	}
+	return s.remove(func(p *Punishment) bool {
+		return p.Type == typ && (p.Player == key || p.IP == key || strings.EqualFold(p.Name, key))
+	})
+}
+
+// RevokeID removes and persists the removal of the punishment with the given ID.
+// It returns false if no such punishment exists.
+func (s *Store) RevokeID(id string) (Punishment, bool, error) {
+	removed, err := s.remove(func(p *Punishment) bool { return p.ID == id })
+	if err != nil || len(removed) == 0 {
+		return Punishment{}, false, err
+	}
+	return removed[0], true, nil
+}
+
+func (s *Store) remove(match func(*Punishment) bool) ([]Punishment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var (
+		removed []Punishment
+		list    []*Punishment
+	)
+	for _, p := range s.active() {
+		if match(p) {
+			removed = append(removed, *p)
+		} else {
+			list = append(list, p)
+		}
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	if err := s.save(list); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// Find returns the active punishment of the type matching the target.
+// If multiple punishments match, the one ending last is returned.
+func (s *Store) Find(typ Type, t Target) (Punishment, bool) {
+	now := s.now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var found *Punishment
+	for _, p := range s.list {
+		if p.Type != typ || p.Expired(now) || !p.Matches(t) {
+			continue
+		}
+		if found == nil || p.Permanent() ||
+			!found.Permanent() && p.Expires.After(found.Expires) {
+			found = p
+		}
+	}
+	if found == nil {
+		return Punishment{}, false
+	}
+	return *found, true
+}
+
+// List returns the active punishments of the type or of all types if typ is empty,
+// ordered by creation time.
+func (s *Store) List(typ Type) []Punishment {
+	now := s.now()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var list []Punishment
+	for _, p := range s.list {
+		if (typ == "" || p.Type == typ) && !p.Expired(now) {
+			list = append(list, *p)
+		}
+	}
+	slices.SortStableFunc(list, func(a, b Punishment) int {
+		return a.Created.Compare(b.Created)
+	})
+	return list
+}
+
+// active returns a copy of the list without expired punishments. s.mu must be held.
+func (s *Store) active() []*Punishment {
+	now := s.now()
+	list := make([]*Punishment, 0, len(s.list)+1)
+	for _, p := range s.list {
+		if !p.Expired(now) {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// save persists and replaces the list. s.mu must be held.
+func (s *Store) save(list []*Punishment) error {
+	if err := SaveFile(s.file, &Punishments{Punishments: list}); err != nil {
+		return fmt.Errorf("error saving punishments file: %w", err)
+	}
+	s.list = list
+	return nil
+}
+
+func newID() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// ParseDuration parses a duration like time.ParseDuration that additionally
+// accepts days "d" and weeks "w", e.g. "1w2d", "12h" or "1d12h30m".
+func ParseDuration(s string) (time.Duration, error) {
+	orig := s
+	var d time.Duration
+	for s != "" {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			break
+		}
+		var unit time.Duration
+		switch {
+		case strings.HasPrefix(s[i:], "w"):
+			unit = 7 * 24 * time.Hour
+		case strings.HasPrefix(s[i:], "d"):
+			unit = 24 * time.Hour
+		default:
+			// Leave the remaining units to time.ParseDuration.
+			rest, err := time.ParseDuration(s)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration %q", orig)
+			}
+			return d + rest, nil
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		d += time.Duration(n) * unit
+		s = s[i+1:]
+	}
+	if s != "" || orig == "" {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+	return d, nil
+}
+
+// FormatDuration formats a duration rounded down to its largest unit of weeks,
+// days, hours, minutes and seconds and the next smaller unit, e.g. "2d 3h".
+func FormatDuration(d time.Duration) string {
+	units := []struct {
+		d    time.Duration
+		name string
+	}{
+		{7 * 24 * time.Hour, "w"},
+		{24 * time.Hour, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+	}
+	for i, u := range units {
+		n := d / u.d
+		if n == 0 {
+			continue
+		}
+		s := fmt.Sprintf("%d%s", n, u.name)
+		if i+1 < len(units) {
+			next := units[i+1]
+			if m := (d - n*u.d) / next.d; m > 0 {
+				s += fmt.Sprintf(" %d%s", m, next.name)
+			}
+		}
+		return s
+	}
+	return "0s"
+}
@@ -0,0 +1,140 @@
+package punishment
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestPunishment_Matches(t *testing.T) {
+	id := uuid.New()
+	tests := []struct {
+		p    Punishment
+		t    Target
+		want bool
+	}{
+		{Punishment{Player: id.String()}, Target{ID: id}, true},
+		{Punishment{Player: id.String()}, Target{ID: uuid.New()}, false},
+		{Punishment{Name: "Steve"}, Target{Name: "steve"}, true},
+		{Punishment{Name: "Steve"}, Target{Name: "Alex"}, false},
+		{Punishment{IP: "10.0.0.1"}, Target{IP: netip.MustParseAddr("10.0.0.1")}, true},
+		{Punishment{IP: "10.0.0.1"}, Target{IP: netip.MustParseAddr("::ffff:10.0.0.1")}, true},
+		{Punishment{IP: "10.0.0.0/8"}, Target{IP: netip.MustParseAddr("10.1.2.3")}, true},
+		{Punishment{IP: "10.0.0.0/8"}, Target{IP: netip.MustParseAddr("11.1.2.3")}, false},
+		{Punishment{IP: "2001:db8::/32"}, Target{IP: netip.MustParseAddr("2001:db8::1")}, true},
+		{Punishment{Name: "Steve"}, Target{}, false},
+	}
+	for _, tt := range tests {
+		require.Equalf(t, tt.want, tt.p.Matches(tt.t), "%+v %+v", tt.p, tt.t)
+	}
+}
+
+func TestStore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "punishments.yml")
+	s, err := Open(file)
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	id := uuid.New()
+	ban, err := s.Add(Punishment{Type: Ban, Player: id.String(), Name: "Steve", Reason: "griefing"})
+	require.NoError(t, err)
+	require.NotEmpty(t, ban.ID)
+	require.Equal(t, now, ban.Created)
+	_, err = s.Add(Punishment{Type: Ban, IP: "10.0.0.0/8", Expires: now.Add(time.Hour)})
+	require.NoError(t, err)
+	_, err = s.Add(Punishment{Type: Mute, Name: "Alex"})
+	require.NoError(t, err)
+
+	_, err = s.Add(Punishment{Type: Ban})
+	require.Error(t, err, "punishment without target")
+	_, err = s.Add(Punishment{Type: Ban, IP: "invalid"})
+	require.Error(t, err)
+
+	found, ok := s.Find(Ban, Target{Name: "STEVE"})
+	require.True(t, ok)
+	require.Equal(t, ban, found)
+	_, ok = s.Find(Mute, Target{ID: id})
+	require.False(t, ok)
+	_, ok = s.Find(Ban, Target{IP: netip.MustParseAddr("10.9.9.9")})
+	require.True(t, ok)
+	require.Len(t, s.List(""), 3)
+	require.Len(t, s.List(Mute), 1)
+
+	// Persisted to the file.
+	reopened, err := Open(file)
+	require.NoError(t, err)
+	reopened.now = s.now
+	require.Equal(t, s.List(""), reopened.List(""))
+
+	// Expired punishments are ignored.
+	now = now.Add(time.Hour)
+	_, ok = s.Find(Ban, Target{IP: netip.MustParseAddr("10.9.9.9")})
+	require.False(t, ok)
+	require.Len(t, s.List(Ban), 1)
+
+	removed, err := s.Revoke(Ban, id.String())
+	require.NoError(t, err)
+	require.Equal(t, []Punishment{ban}, removed)
+	_, ok = s.Find(Ban, Target{ID: id})
+	require.False(t, ok)
+
+	removed, err = s.Revoke(Ban, "steve")
+	require.NoError(t, err)
+	require.Empty(t, removed)
+
+	mute := s.List(Mute)[0]
+	revoked, ok, err := s.RevokeID(mute.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, mute, revoked)
+
+	reopened, err = Open(file)
+	require.NoError(t, err)
+	require.Empty(t, reopened.List(""))
+}
+
+func TestLoadFile_Invalid(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "punishments.yml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+punishments:
+  - id: a
+    type: kick
+    name: Steve
+`), 0o644))
+	_, err := LoadFile(file)
+	require.ErrorContains(t, err, "invalid type")
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"30s":     30 * time.Second,
+		"12h":     12 * time.Hour,
+		"1d":      24 * time.Hour,
+		"1w2d":    9 * 24 * time.Hour,
+		"1d12h":   36 * time.Hour,
+		"2d1h30m": 49*time.Hour + 30*time.Minute,
+	}
+	for s, want := range tests {
+		got, err := ParseDuration(s)
+		require.NoError(t, err, s)
+		require.Equal(t, want, got, s)
+	}
+	for _, s := range []string{"", "d", "1x", "1d2", "forever"} {
+		_, err := ParseDuration(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	require.Equal(t, "0s", FormatDuration(0))
+	require.Equal(t, "45s", FormatDuration(45*time.Second))
+	require.Equal(t, "2d 3h", FormatDuration(51*time.Hour+time.Minute))
+	require.Equal(t, "1w", FormatDuration(7*24*time.Hour+time.Minute))
+}
@@ -0,0 +1,235 @@
+// Package whitelist provides a file-backed list of players
+// allowed to join by UUID and username.
+package whitelist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"go.minekube.com/gate/pkg/util/configutil"
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+// Entry is a whitelisted player.
+// An entry with a UUID matches the player's UUID, so that it survives name changes,
+// otherwise it matches the username case-insensitively.
+type Entry struct {
+	// ID is the UUID of the player.
+	ID string `yaml:"id,omitempty" json:"id,omitempty"`
+	// Name is the username of the player.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+}
+
+// Matches returns true if the entry matches the player.
+func (e *Entry) Matches(id uuid.UUID, name string) bool {
+	if e.ID != "" {
+		entryID, err := uuid.Parse(e.ID)
+		return err == nil && entryID == id
+	}
+	return e.Name != "" && strings.EqualFold(e.Name, name)
+}
+
+// is returns true if the entry's UUID or username equals key.
+func (e *Entry) is(key string) bool {
+	if id, err := uuid.Parse(key); err == nil {
+		return e.ID == id.String()
+	}
+	return strings.EqualFold(e.Name, key)
+}
+
+func (e *Entry) validate() error {
+	if e.ID == "" && e.Name == "" {
+		return errors.New("at least one of id or name must be set")
+	}
+	if e.ID != "" {
+		if _, err := uuid.Parse(e.ID); err != nil {
+			return fmt.Errorf("invalid id %q: %w", e.ID, err)
+		}
+	}
+	return nil
+}
+
+// Whitelist is the file format of the Store.
+type Whitelist struct {
+	Players []*Entry `yaml:"players,omitempty" json:"players,omitempty"`
+}
+
+// Validate validates the Whitelist.
+func (w *Whitelist) Validate() (errs []error) {
+	e := func(m string, args ...any) { errs = append(errs, fmt.Errorf(m, args...)) }
+	if w == nil {
+		return nil
+	}
+	for i, entry := range w.Players {
+		if entry == nil {
+			e("player %d must not be empty", i)
+			continue
+		}
+		if err := entry.validate(); err != nil {
+			e("player %d: %w", i, err)
+		}
+	}
+	return errs
+}
+
+// LoadFile reads a Whitelist from a YAML (or JSON) file.
+// A non-existent file results in an empty Whitelist.
+func LoadFile(path string) (*Whitelist, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Whitelist{}, nil
+		}
+		return nil, err
+	}
+	w := new(Whitelist)
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(w); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing whitelist file %q: %w", path, err)
+	}
+	if err = errors.Join(w.Validate()...); err != nil {
+		return nil, fmt.Errorf("invalid whitelist file %q: %w", path, err)
+	}
+	return w, nil
+}
+
+// SaveFile atomically writes a Whitelist as YAML to a file
+// keeping the file mode of an existing file.
+func SaveFile(path string, w *Whitelist) error {
+	return configutil.SaveYAMLFile(path, w)
+}
+
+// Store is a file-backed whitelist.
+// Modifications are persisted to the file immediately.
+type Store struct {
+	file string
+
+	mu   sync.RWMutex
+	list []*Entry // must not be modified, replaced on change
+}
+
+// Open loads the store from the whitelist file.
+// A non-existent file is created on the first modification.
+func Open(file string) (*Store, error) {
+	s := &Store{file: file}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// File returns the path of the store's whitelist file.
+func (s *Store) File() string {
+	return s.file
+}
+
+// Reload reloads the whitelist from the file, e.g. after it was edited.
+func (s *Store) Reload() error {
+	w, err := LoadFile(s.file)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.list = w.Players
+	s.mu.Unlock()
+	return nil
+}
+
+// Add adds and persists an entry and returns it.
+// It replaces an entry of the same UUID and an entry of the same username without UUID,
+// e.g. to record the UUID of a player previously added by username.
+// Adding a username without UUID returns the existing entry of that username.
+func (s *Store) Add(e Entry) (Entry, error) {
+	if e.ID != "" {
+		id, err := uuid.Parse(e.ID)
+		if err != nil {
+			return Entry{}, fmt.Errorf("invalid id %q: %w", e.ID, err)
+		}
+		e.ID = id.String()
+	}
+	if err := e.validate(); err != nil {
+		return Entry{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e.ID == "" {
+		for _, o := range s.list {
+			if strings.EqualFold(o.Name, e.Name) {
+				return *o, nil
+			}
+		}
+	}
+	list := slices.DeleteFunc(slices.Clone(s.list), func(o *Entry) bool {
+		return (e.ID != "" && o.ID == e.ID) ||
+			(o.ID == "" && e.Name != "" && strings.EqualFold(o.Name, e.Name))
+	})
+	list = append(list, &e)
+	if err := s.save(list); err != nil {
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+// Remove removes and persists the removal of the entries whose UUID or username
+// equals key and returns the removed entries.
+func (s *Store) Remove(key string) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var (
+		removed []Entry
+		list    []*Entry
+	)
+	for _, e := range s.list {
+		if e.is(key) {
+			removed = append(removed, *e)
+		} else {
+			list = append(list, e)
+		}
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	if err := s.save(list); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// Allowed returns true if an entry matches the player.
+func (s *Store) Allowed(id uuid.UUID, name string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.ContainsFunc(s.list, func(e *Entry) bool {
+		return e.Matches(id, name)
+	})
+}
+
+// List returns the entries in the order they were added.
+func (s *Store) List() []Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	list := make([]Entry, 0, len(s.list))
+	for _, e := range s.list {
+		list = append(list, *e)
+	}
+	return list
+}
+
+// save persists and replaces the list. s.mu must be held.
+func (s *Store) save(list []*Entry) error {
+	if err := SaveFile(s.file, &Whitelist{Players: list}); err != nil {
+		return fmt.Errorf("error saving whitelist file: %w", err)
+	}
+	s.list = list
+	return nil
+}
@@ -0,0 +1,88 @@
+package whitelist
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.minekube.com/gate/pkg/util/uuid"
+)
+
+func TestEntry_Matches(t *testing.T) {
+	id := uuid.New()
+	tests := []struct {
+		e    Entry
+		id   uuid.UUID
+		name string
+		want bool
+	}{
+		{Entry{ID: id.String()}, id, "Steve", true},
+		{Entry{ID: id.String(), Name: "Steve"}, uuid.New(), "Steve", false},
+		{Entry{Name: "Steve"}, uuid.New(), "steve", true},
+		{Entry{Name: "Steve"}, uuid.New(), "Alex", false},
+		{Entry{}, uuid.Nil, "", false},
+	}
+	for _, tt := range tests {
+		require.Equalf(t, tt.want, tt.e.Matches(tt.id, tt.name), "%+v %s %s", tt.e, tt.id, tt.name)
+	}
+}
+
+func TestStore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "whitelist.yml")
+	s, err := Open(file)
+	require.NoError(t, err)
+	require.Empty(t, s.List())
+
+	id := uuid.New()
+	_, err = s.Add(Entry{Name: "Steve"})
+	require.NoError(t, err)
+	_, err = s.Add(Entry{Name: "alex"})
+	require.NoError(t, err)
+	require.True(t, s.Allowed(id, "steve"))
+
+	// Adding the UUID replaces the entry of the username
+	e, err := s.Add(Entry{ID: id.String(), Name: "Steve"})
+	require.NoError(t, err)
+	require.Equal(t, id.String(), e.ID)
+	require.Len(t, s.List(), 2)
+	require.True(t, s.Allowed(id, "Renamed"))
+	require.False(t, s.Allowed(uuid.New(), "Steve"))
+
+	// Adding the username again keeps the entry with UUID
+	e, err = s.Add(Entry{Name: "steve"})
+	require.NoError(t, err)
+	require.Equal(t, id.String(), e.ID)
+	require.Len(t, s.List(), 2)
+
+	_, err = s.Add(Entry{})
+	require.Error(t, err)
+	_, err = s.Add(Entry{ID: "invalid"})
+	require.Error(t, err)
+
+	// Persisted to the file
+	reopened, err := Open(file)
+	require.NoError(t, err)
+	require.Equal(t, s.List(), reopened.List())
+
+	removed, err := s.Remove(id.String())
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	removed, err = s.Remove("ALEX")
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	removed, err = s.Remove("nobody")
+	require.NoError(t, err)
+	require.Empty(t, removed)
+	require.Empty(t, s.List())
+
+	// Edited file
+	require.NoError(t, os.WriteFile(file, []byte("players:\n  - name: Notch\n"), 0o644))
+	require.NoError(t, s.Reload())
+	require.True(t, s.Allowed(uuid.New(), "notch"))
+
+	require.NoError(t, os.WriteFile(file, []byte("players:\n  - {}\n"), 0o644))
+	require.Error(t, s.Reload())
+	require.True(t, s.Allowed(uuid.New(), "notch"), "keeps the previous whitelist")
+}